@@ -4,11 +4,15 @@ import (
 	"log"
 
 	docs "github.com/School-meal-lover/backend/docs"
+	"github.com/School-meal-lover/backend/internal/auth"
+	"github.com/School-meal-lover/backend/internal/category"
 	"github.com/School-meal-lover/backend/internal/database"
 	"github.com/School-meal-lover/backend/internal/handlers"
 	"github.com/School-meal-lover/backend/internal/middleware"
+	"github.com/School-meal-lover/backend/internal/notification"
 	"github.com/School-meal-lover/backend/internal/repository"
 	"github.com/School-meal-lover/backend/internal/services"
+	"github.com/School-meal-lover/backend/internal/storage"
 	"github.com/joho/godotenv"
 
 	gin "github.com/gin-gonic/gin"
@@ -25,7 +29,7 @@ import (
 // @securityDefinitions.apikey BearerAuth
 // @in header
 // @name Authorization
-// @description 토큰 인증. 토큰은 환경변수 BEARER_TOKEN에서 설정.
+// @description API 키 또는 JWT 인증 (Bearer). 키와 역할은 환경변수 API_KEYS, BEARER_TOKEN, JWT_SECRET에서 설정.
 func main() {
 	err := godotenv.Load()
 	if err != nil {
@@ -39,24 +43,82 @@ func main() {
 
 	// 의존성 주입
 	mealRepo := repository.NewMealRepository(db)
+	notificationRepo := repository.NewNotificationRepository(db)
+	webhookRepo := repository.NewWebhookRepository(db)
+	imageRepo := repository.NewImageRepository(db)
+	apiKeyRepo := repository.NewAPIKeyRepository(db)
+	auditRepo := repository.NewAuditRepository(db)
+	uploadRepo := repository.NewUploadRepository(db)
+	mealTypeRepo := repository.NewMealTypeRepository(db)
+	closureRepo := repository.NewClosureRepository(db)
+	weekRepo := repository.NewWeekRepository(db)
+
+	// 인증 (API 키, JWT, SSO 세션)
+	sessionTokens := auth.NewJWTAuthenticatorFromEnv()
+	authenticator, err := auth.NewAuthenticatorFromEnv(apiKeyRepo, sessionTokens)
+	if err != nil {
+		log.Fatalf("Failed to configure authentication: %v", err)
+	}
+	oidcClient, err := auth.NewOIDCClientFromEnv(sessionTokens)
+	if err != nil {
+		log.Fatalf("Failed to configure OIDC login: %v", err)
+	}
+
+	// 업로드 파일 저장소
+	blobStore, err := storage.NewBlobStoreFromEnv()
+	if err != nil {
+		log.Fatalf("Failed to configure file storage: %v", err)
+	}
+
+	// 메뉴 분류 규칙
+	classifier, err := category.NewClassifierFromEnv()
+	if err != nil {
+		log.Fatalf("Failed to load category rules: %v", err)
+	}
 
 	// 서비스 초기화
-	mealService := services.NewMealService(mealRepo)
-	excelService := services.NewExcelService(mealRepo)
-	textService := services.NewTextService(mealRepo)
-	imageService := services.NewImageService()
+	notificationService := services.NewNotificationService(notificationRepo, notification.NewNotifiersFromEnv())
+	mealTypeService := services.NewMealTypeService(mealTypeRepo)
+	closureService := services.NewClosureService(closureRepo, mealTypeService)
+	mealService := services.NewMealService(mealRepo, closureService)
+	calendarService := services.NewCalendarService(mealRepo)
+	feedService := services.NewFeedService(mealRepo)
+	chatbotService := services.NewChatbotService(mealService, mealTypeService)
+	webhookService := services.NewWebhookService(webhookRepo)
+	menuImporter := services.NewMenuImporter(mealRepo, mealTypeService, closureService, classifier)
+	weekService := services.NewWeekService(mealRepo, weekRepo, notificationService, webhookService)
+	excelService := services.NewExcelService(menuImporter, mealTypeService)
+	textService := services.NewTextService(menuImporter, mealTypeService)
+	apiKeyService := services.NewAPIKeyService(apiKeyRepo)
+	auditService := services.NewAuditService(auditRepo)
+	documentService := services.NewDocumentService(menuImporter, mealTypeService)
+	uploadService := services.NewUploadService(uploadRepo, blobStore, excelService, textService, documentService)
+	imageService := services.NewImageService(imageRepo, mealRepo, blobStore, webhookService)
 
 	// 핸들러 초기화
 	mealHandler := handlers.NewMealHandler(mealService)
-	excelHandler := handlers.NewExcelHandler(excelService)
-	textHandler := handlers.NewTextHandler(textService)
-	imageHandler := handlers.NewImageHandler(imageService)
+	calendarHandler := handlers.NewCalendarHandler(calendarService)
+	feedHandler := handlers.NewFeedHandler(feedService)
+	chatbotHandler := handlers.NewChatbotHandler(chatbotService)
+	excelHandler := handlers.NewExcelHandler(excelService, uploadService, auditService)
+	textHandler := handlers.NewTextHandler(textService, uploadService, auditService)
+	imageHandler := handlers.NewImageHandler(imageService, auditService)
+	notificationHandler := handlers.NewNotificationHandler(notificationService)
+	webhookHandler := handlers.NewWebhookHandler(webhookService, auditService)
+	apiKeyHandler := handlers.NewAPIKeyHandler(apiKeyService, auditService)
+	auditHandler := handlers.NewAuditHandler(auditService)
+	uploadHandler := handlers.NewUploadHandler(uploadService, auditService)
+	documentHandler := handlers.NewDocumentHandler(documentService, uploadService, auditService)
+	authHandler := handlers.NewAuthHandler(oidcClient)
+	mealTypeHandler := handlers.NewMealTypeHandler(mealTypeService, auditService)
+	closureHandler := handlers.NewClosureHandler(closureService, auditService)
+	weekHandler := handlers.NewWeekHandler(weekService, auditService)
 
 	// CORS 미들웨어
 	router.Use(func(c *gin.Context) {
 		c.Header("Access-Control-Allow-Origin", "*")
 		c.Header("Access-Control-Allow-Methods", "GET, POST, OPTIONS, PUT, DELETE")
-		c.Header("Access-Control-Allow-Headers", "Content-Type, Authorization")
+		c.Header("Access-Control-Allow-Headers", "Content-Type, Authorization, X-Device-Token")
 		c.Header("Access-Control-Allow-Credentials", "true")
 
 		if c.Request.Method == "OPTIONS" {
@@ -71,14 +133,66 @@ func main() {
 	api := router.Group("/api/v1")
 	{
 		api.GET("/restaurants/:name", mealHandler.GetRestaurantMeals)
+		api.GET("/restaurants/:name/daily", mealHandler.GetRestaurantDailyMeals)
+		api.GET("/restaurants/:name/closures", closureHandler.ListClosures)
+		api.GET("/restaurants/:name/calendar.ics", calendarHandler.GetRestaurantCalendar)
+		api.GET("/restaurants/:name/feed.atom", feedHandler.GetRestaurantFeed)
+		api.GET("/restaurants/:name/meal-types", mealTypeHandler.ListMealTypes)
 
-		api.POST("/upload/excel", excelHandler.UploadAndProcessExcel)
+		// 관리자 콘솔 로그인 (OIDC)
+		api.GET("/auth/oidc/login", authHandler.StartLogin)
+		api.GET("/auth/oidc/callback", authHandler.Callback)
+		api.POST("/auth/logout", authHandler.Logout)
+		api.GET("/auth/me", middleware.Authenticate(authenticator), authHandler.Me)
 
-		// Bearer token 인증이 필요한 엔드포인트
-		api.POST("/upload/text", middleware.BearerTokenAuth(), textHandler.UploadText)
+		// 식단/이미지 업로드: admin, approver 또는 담당 식당 staff (식당은 핸들러에서 확인)
+		// 올린 식단은 draft로 저장되고 게시된 뒤에 조회 API에 나타납니다
+		uploads := api.Group("", middleware.Authenticate(authenticator), middleware.RequireRole(auth.RoleAdmin, auth.RoleApprover, auth.RoleStaff))
+		uploads.POST("/upload/excel", excelHandler.UploadAndProcessExcel)
+		uploads.POST("/upload/text", textHandler.UploadText)
+		uploads.POST("/upload/json", documentHandler.UploadDocument)
+		uploads.POST("/images/upload", imageHandler.UploadImageName)
+		uploads.POST("/images/upload/file", imageHandler.UploadImageFile)
+
+		api.GET("/schemas/week-menu.json", documentHandler.GetSchema)
 
-		api.POST("/images/upload", imageHandler.UploadImageName)
 		api.GET("/images/current", imageHandler.GetCurrentImageName)
+		api.GET("/images/history", imageHandler.GetImageHistory)
+		api.GET("/images/files/*key", imageHandler.GetImageFile)
+
+		api.POST("/notifications/subscribers", notificationHandler.Subscribe)
+		api.DELETE("/notifications/subscribers/:id", middleware.OptionalAuthenticate(authenticator), notificationHandler.Unsubscribe)
+
+		api.POST("/chatbot/kakao", chatbotHandler.KakaoSkill)
+		api.POST("/chatbot/slack", middleware.SlackSignatureAuth(), chatbotHandler.SlackCommand)
+
+		// 게시 흐름: 미리보기와 검토 요청은 업로드 역할, 게시/반려/게시 취소는 approver와 admin (식당은 핸들러에서 확인)
+		weeks := api.Group("/weeks", middleware.Authenticate(authenticator), middleware.RequireRole(auth.RoleAdmin, auth.RoleApprover, auth.RoleStaff))
+		weeks.GET("", weekHandler.ListWeeks)
+		weeks.GET("/:id/preview", weekHandler.PreviewWeek)
+		weeks.POST("/:id/submit", weekHandler.SubmitWeek)
+		weeks.POST("/:id/publish", middleware.RequireRole(auth.RoleAdmin, auth.RoleApprover), weekHandler.PublishWeek)
+		weeks.POST("/:id/reject", middleware.RequireRole(auth.RoleAdmin, auth.RoleApprover), weekHandler.RejectWeek)
+		weeks.POST("/:id/retract", middleware.RequireRole(auth.RoleAdmin, auth.RoleApprover), weekHandler.RetractWeek)
+
+		// 웹훅 관리: 조회는 모든 역할, 변경은 admin
+		webhooks := api.Group("/webhooks", middleware.Authenticate(authenticator))
+		webhooks.POST("", middleware.RequireRole(auth.RoleAdmin), webhookHandler.CreateSubscription)
+		webhooks.GET("", middleware.RequireRole(auth.RoleAdmin, auth.RoleApprover, auth.RoleStaff, auth.RoleReadOnly), webhookHandler.ListSubscriptions)
+		webhooks.DELETE("/:id", middleware.RequireRole(auth.RoleAdmin), webhookHandler.DeleteSubscription)
+		webhooks.GET("/:id/deliveries", middleware.RequireRole(auth.RoleAdmin, auth.RoleApprover, auth.RoleStaff, auth.RoleReadOnly), webhookHandler.GetDeliveries)
+
+		// 관리자 전용
+		admin := api.Group("/admin", middleware.Authenticate(authenticator), middleware.RequireRole(auth.RoleAdmin))
+		admin.POST("/api-keys", apiKeyHandler.IssueKey)
+		admin.GET("/api-keys", apiKeyHandler.ListKeys)
+		admin.DELETE("/api-keys/:id", apiKeyHandler.RevokeKey)
+		admin.GET("/audit", auditHandler.ListAuditLogs)
+		admin.GET("/weeks/:id/uploads", uploadHandler.ListWeekUploads)
+		admin.POST("/uploads/:id/reprocess", uploadHandler.ReprocessUpload)
+		admin.PUT("/meal-types/:restaurant/:code", mealTypeHandler.UpsertMealType)
+		admin.POST("/closures", closureHandler.CreateClosure)
+		admin.DELETE("/closures/:id", closureHandler.DeleteClosure)
 	}
 	// Set up Swagger
 	router.GET("/swagger/*any", ginSwagger.WrapHandler(swaggerFiles.Handler))
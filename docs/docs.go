@@ -15,38 +15,38 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
-        "/images/current": {
+        "/admin/api-keys": {
             "get": {
-                "description": "현재 저장된 이미지 이름을 조회합니다.",
-                "consumes": [
-                    "application/json"
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
                 ],
+                "description": "발급된 API 키 목록을 조회합니다. 원본 키와 해시는 포함되지 않습니다. admin 권한이 필요합니다.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Images"
-                ],
-                "summary": "현재 이미지 이름 조회",
-                "parameters": [
-                    {
-                        "enum": [
-                            1,
-                            2,
-                            3
-                        ],
-                        "type": "integer",
-                        "description": "레스토랑 번호",
-                        "name": "restaurant_name",
-                        "in": "query",
-                        "required": true
-                    }
+                    "Admin"
                 ],
+                "summary": "API 키 목록",
                 "responses": {
                     "200": {
-                        "description": "성공적으로 현재 이미지 이름 조회",
+                        "description": "조회 성공",
                         "schema": {
-                            "$ref": "#/definitions/models.ImageInfoResponse"
+                            "$ref": "#/definitions/models.APIKeyListResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - Invalid or missing token",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - admin role required",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
                         }
                     },
                     "500": {
@@ -56,11 +56,14 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/images/upload": {
+            },
             "post": {
-                "description": "이미지 이름을 업로드합니다.",
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "새 API 키를 발급합니다. scopes는 admin, approver, approver@RESTAURANT_1, staff@RESTAURANT_1, staff@RESTAURANT_2, read_only 중에서 지정합니다. 원본 키는 이 응답에서 한 번만 확인할 수 있고 서버에는 해시만 저장됩니다. admin 권한이 필요합니다.",
                 "consumes": [
                     "application/json"
                 ],
@@ -68,37 +71,43 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Images"
+                    "Admin"
                 ],
-                "summary": "이미지 이름 업로드",
+                "summary": "API 키 발급",
                 "parameters": [
                     {
-                        "enum": [
-                            1,
-                            2,
-                            3
-                        ],
-                        "type": "integer",
-                        "description": "레스토랑 번호",
-                        "name": "restaurant_name",
-                        "in": "query",
-                        "required": true
-                    },
-                    {
-                        "description": "업로드할 이미지 이름",
+                        "description": "키 정보",
                         "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/models.ImageUploadRequest"
+                            "$ref": "#/definitions/models.APIKeyCreateRequest"
                         }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "성공적으로 이미지 이름 업로드",
+                    "201": {
+                        "description": "발급 성공",
                         "schema": {
-                            "$ref": "#/definitions/models.ImageInfoResponse"
+                            "$ref": "#/definitions/models.APIKeyResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "잘못된 요청",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - Invalid or missing token",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - admin role required",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
                         }
                     },
                     "500": {
@@ -110,50 +119,48 @@ const docTemplate = `{
                 }
             }
         },
-        "/restaurants/{name}": {
-            "get": {
-                "description": "경로 파라미터로 받은 식당 이름과 쿼리로 받은 날짜를 기준으로 주간 식단을 조회합니다. Restaurant1은 평일만(월~금, 5일), Restaurant2는 주말 포함(월~일, 7일) 조회됩니다.",
-                "consumes": [
-                    "application/json"
+        "/admin/api-keys/{id}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
                 ],
+                "description": "API 키를 폐기합니다. 폐기된 키는 즉시 인증에 실패합니다. admin 권한이 필요합니다.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Meals"
+                    "Admin"
                 ],
-                "summary": "특정 식당의 주간 식단 조회",
+                "summary": "API 키 폐기",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "레스토랑 이름 (RESTAURANT_1: 평일만, RESTAURANT_2: 주말 포함)",
-                        "name": "name",
+                        "description": "API 키 ID",
+                        "name": "id",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "조회할 날짜 (YYYY-MM-DD 형식)",
-                        "name": "date",
-                        "in": "query",
-                        "required": true
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "성공적으로 식단 정보 조회",
+                    "204": {
+                        "description": "폐기 성공"
+                    },
+                    "401": {
+                        "description": "Unauthorized - Invalid or missing token",
                         "schema": {
-                            "$ref": "#/definitions/models.RestaurantMealsResponse"
+                            "$ref": "#/definitions/models.ErrorResponse"
                         }
                     },
-                    "400": {
-                        "description": "잘못된 요청 파라미터 (식당 이름 또는 날짜 형식 오류)",
+                    "403": {
+                        "description": "Forbidden - admin role required",
                         "schema": {
                             "$ref": "#/definitions/models.ErrorResponse"
                         }
                     },
                     "404": {
-                        "description": "해당 식당 또는 해당 날짜의 식단 정보를 찾을 수 없음",
+                        "description": "API 키를 찾을 수 없음",
                         "schema": {
                             "$ref": "#/definitions/models.ErrorResponse"
                         }
@@ -167,41 +174,117 @@ const docTemplate = `{
                 }
             }
         },
-        "/upload/excel": {
-            "post": {
-                "description": "파일을 업로드 해서 식단 데이터를 디비에 저장한다.",
-                "consumes": [
-                    "multipart/form-data"
+        "/admin/audit": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "업로드, 이미지 변경, 관리 작업 기록을 최신순으로 조회합니다. admin 권한이 필요합니다.",
+                "produces": [
+                    "application/json"
                 ],
                 "tags": [
-                    "excel"
+                    "Admin"
                 ],
-                "summary": "엑셀 처리 API",
+                "summary": "감사 로그 조회",
                 "parameters": [
                     {
-                        "type": "file",
-                        "description": "한국어 엑셀 파일",
-                        "name": "excel_ko",
-                        "in": "formData",
-                        "required": true
+                        "type": "string",
+                        "description": "요청한 사용자 (API 키 이름 또는 이메일)",
+                        "name": "actor",
+                        "in": "query"
                     },
                     {
-                        "type": "file",
-                        "description": "영어 엑셀 파일",
-                        "name": "excel_en",
-                        "in": "formData",
-                        "required": true
+                        "enum": [
+                            "upload.excel",
+                            "upload.text",
+                            "upload.json",
+                            "upload.reprocess",
+                            "image.upload",
+                            "image.upload_file",
+                            "api_key.issue",
+                            "api_key.revoke",
+                            "webhook.create",
+                            "webhook.delete",
+                            "meal_type.update",
+                            "closure.create",
+                            "closure.delete",
+                            "week.submit",
+                            "week.reject",
+                            "week.publish",
+                            "week.retract"
+                        ],
+                        "type": "string",
+                        "description": "동작",
+                        "name": "action",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "식당 (RESTAURANT_1, RESTAURANT_2)",
+                        "name": "restaurant",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "주차 ID",
+                        "name": "week_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "이 시각 이후 (RFC3339 또는 YYYY-MM-DD)",
+                        "name": "since",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "이 시각 이전 (RFC3339 또는 YYYY-MM-DD)",
+                        "name": "until",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "최대 개수 (기본 100, 최대 500)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "건너뛸 개수",
+                        "name": "offset",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Excel file processed successfully",
+                        "description": "조회 성공",
                         "schema": {
-                            "$ref": "#/definitions/handlers.DualExcelProcessResponse"
+                            "$ref": "#/definitions/models.AuditLogListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "잘못된 조회 조건",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - Invalid or missing token",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - admin role required",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
                         }
                     },
                     "500": {
-                        "description": "Failed to process Excel file",
+                        "description": "서버 내부 오류 발생",
                         "schema": {
                             "$ref": "#/definitions/models.ErrorResponse"
                         }
@@ -209,44 +292,44 @@ const docTemplate = `{
                 }
             }
         },
-        "/upload/text": {
+        "/admin/closures": {
             "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "plain text로 한 주치 식단 데이터를 받아서 디비에 저장합니다. Bearer token 인증이 필요합니다.",
+                "description": "식당이 쉬는 날(meal_type 없음)이나 운영하지 않는 식사(meal_type 지정)를 기간으로 등록합니다. 등록된 휴무는 주간/하루 식단 응답에 closed 항목으로 표시되고, 휴무 기간의 식단을 업로드하면 경고가 붙습니다.",
                 "consumes": [
-                    "text/plain"
+                    "application/json"
                 ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "text"
+                    "Closures"
                 ],
-                "summary": "텍스트로 식단 데이터 업로드",
+                "summary": "식당 휴무 등록",
                 "parameters": [
                     {
-                        "description": "식단 텍스트 데이터",
-                        "name": "text",
+                        "description": "휴무 정보",
+                        "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/models.ClosureRequest"
                         }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "Text processed successfully",
+                    "201": {
+                        "description": "등록 성공",
                         "schema": {
-                            "$ref": "#/definitions/models.ExcelProcessResult"
+                            "$ref": "#/definitions/models.ClosureResponse"
                         }
                     },
                     "400": {
-                        "description": "Invalid request body or format",
+                        "description": "잘못된 요청",
                         "schema": {
                             "$ref": "#/definitions/models.ErrorResponse"
                         }
@@ -257,197 +340,3290 @@ const docTemplate = `{
                             "$ref": "#/definitions/models.ErrorResponse"
                         }
                     },
+                    "403": {
+                        "description": "권한 없음",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
                     "500": {
-                        "description": "Failed to process text",
+                        "description": "서버 내부 오류 발생",
                         "schema": {
                             "$ref": "#/definitions/models.ErrorResponse"
                         }
                     }
                 }
             }
-        }
-    },
-    "definitions": {
-        "handlers.DualExcelProcessResponse": {
-            "type": "object",
-            "properties": {
-                "result_en": {
-                    "$ref": "#/definitions/models.ExcelProcessResult"
-                },
-                "result_ko": {
-                    "$ref": "#/definitions/models.ExcelProcessResult"
-                },
-                "success": {
-                    "type": "boolean"
-                }
-            }
         },
-        "models.DayMeals": {
-            "type": "object",
-            "properties": {
-                "date": {
-                    "type": "string"
-                },
-                "day_of_week": {
-                    "type": "string"
-                },
-                "meals": {
-                    "type": "object",
-                    "additionalProperties": {
-                        "$ref": "#/definitions/models.MealInfo"
+        "/admin/closures/{id}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Closures"
+                ],
+                "summary": "식당 휴무 삭제",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "휴무 ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "삭제 성공"
+                    },
+                    "401": {
+                        "description": "Unauthorized - Invalid or missing token",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "권한 없음",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "휴무를 찾을 수 없음",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "서버 내부 오류 발생",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
                     }
                 }
             }
         },
-        "models.ErrorResponse": {
-            "type": "object",
-            "properties": {
-                "error": {
-                    "type": "string",
-                    "example": "Failed to process Excel file: file not found"
-                },
-                "success": {
+        "/admin/meal-types/{restaurant}/{code}": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "식당의 식사 종류를 추가하거나 수정합니다. 새 코드(예: Late_snack)를 추가하면 텍스트/JSON 업로드에서 바로 쓸 수 있고, 엑셀 행 범위를 지정하면 엑셀 업로드에서도 읽습니다.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "MealTypes"
+                ],
+                "summary": "식사 종류 추가/수정",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "식당 이름 (RESTAURANT_1, RESTAURANT_2)",
+                        "name": "restaurant",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "example": "Late_snack",
+                        "description": "식사 종류 코드",
+                        "name": "code",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "식사 종류 설정",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.MealTypeRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "저장 성공",
+                        "schema": {
+                            "$ref": "#/definitions/models.MealTypeResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "잘못된 요청",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - Invalid or missing token",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "권한 없음",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "서버 내부 오류 발생",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/uploads/{id}/reprocess": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "보관된 원본 파일을 현재 파서로 다시 처리해 식단 데이터를 덮어씁니다. admin 권한이 필요합니다.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Admin"
+                ],
+                "summary": "원본 업로드 재처리",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "업로드 ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "재처리 성공",
+                        "schema": {
+                            "$ref": "#/definitions/models.ReprocessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "원본을 현재 파서로 처리할 수 없음",
+                        "schema": {
+                            "$ref": "#/definitions/models.ParseErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - Invalid or missing token",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - admin role required",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "업로드를 찾을 수 없음",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "재처리 실패",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/weeks/{id}/uploads": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "주차에 올라온 엑셀/텍스트 원본 업로드 기록을 최신순으로 조회합니다. admin 권한이 필요합니다.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Admin"
+                ],
+                "summary": "주차별 원본 업로드 목록",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "주차 ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "조회 성공",
+                        "schema": {
+                            "$ref": "#/definitions/models.UploadListResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - Invalid or missing token",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - admin role required",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "잘못된 주차 ID",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "서버 내부 오류 발생",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/auth/logout": {
+            "post": {
+                "description": "세션 쿠키를 삭제합니다.",
+                "tags": [
+                    "Auth"
+                ],
+                "summary": "로그아웃",
+                "responses": {
+                    "204": {
+                        "description": "로그아웃 성공"
+                    }
+                }
+            }
+        },
+        "/auth/me": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "세션 쿠키 또는 Bearer 토큰으로 인증된 사용자와 역할을 조회합니다.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Auth"
+                ],
+                "summary": "현재 사용자 정보",
+                "responses": {
+                    "200": {
+                        "description": "조회 성공",
+                        "schema": {
+                            "$ref": "#/definitions/models.AuthPrincipalResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - Invalid or missing token",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/auth/oidc/callback": {
+            "get": {
+                "description": "OIDC 제공자가 돌려보낸 인가 코드를 토큰으로 교환하고, ID 토큰의 역할 클레임에 맞는 세션 쿠키(grrrr_session)를 발급합니다.",
+                "tags": [
+                    "Auth"
+                ],
+                "summary": "SSO 로그인 콜백",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "인가 코드",
+                        "name": "code",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "로그인 시작 때 보낸 state",
+                        "name": "state",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "302": {
+                        "description": "로그인 전 경로로 이동"
+                    },
+                    "401": {
+                        "description": "로그인 실패 (state 불일치, 토큰 검증 실패, 역할 없음)",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "SSO 로그인이 설정되지 않음",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "502": {
+                        "description": "OIDC 제공자 오류",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/auth/oidc/login": {
+            "get": {
+                "description": "OpenID Connect 인가 코드 + PKCE 로그인을 시작합니다. 설정된 OIDC 제공자의 로그인 페이지로 이동합니다.",
+                "tags": [
+                    "Auth"
+                ],
+                "summary": "SSO 로그인 시작",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "로그인 후 돌아갈 경로 (같은 사이트의 /로 시작하는 경로만)",
+                        "name": "redirect",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "302": {
+                        "description": "OIDC 제공자로 이동"
+                    },
+                    "404": {
+                        "description": "SSO 로그인이 설정되지 않음",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "502": {
+                        "description": "OIDC 제공자 오류",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/chatbot/kakao": {
+            "post": {
+                "description": "카카오 i 오픈빌더 스킬 요청을 받아 \"오늘 1식당 점심\", \"내일 저녁\" 같은 발화를 해석하고 식단 카드로 응답합니다. 식당을 지정하지 않으면 모든 식당, 식사를 지정하지 않으면 하루 전체 식단을 보여줍니다.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Chatbot"
+                ],
+                "summary": "카카오톡 챗봇 스킬",
+                "parameters": [
+                    {
+                        "description": "스킬 요청",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.KakaoSkillRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "스킬 응답",
+                        "schema": {
+                            "$ref": "#/definitions/models.KakaoSkillResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "잘못된 요청",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/chatbot/slack": {
+            "post": {
+                "description": "슬랙 슬래시 커맨드(예: /menu tomorrow dinner restaurant 2)의 text를 해석해서 식단을 응답합니다. SLACK_SIGNING_SECRET이 설정되어 있으면 요청 서명을 검증합니다.",
+                "consumes": [
+                    "application/x-www-form-urlencoded"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Chatbot"
+                ],
+                "summary": "슬랙 슬래시 커맨드",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "커맨드 뒤에 입력한 문장",
+                        "name": "text",
+                        "in": "formData"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "슬랙 응답",
+                        "schema": {
+                            "$ref": "#/definitions/models.SlackCommandResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "서명 검증 실패",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/images/current": {
+            "get": {
+                "description": "해당 날짜에 유효한 이미지 이름을 조회합니다. date를 생략하면 오늘 날짜 기준입니다.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Images"
+                ],
+                "summary": "현재 이미지 이름 조회",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "레스토랑 이름 (RESTAURANT_1, RESTAURANT_2, 대소문자 관계없음. 이전 번호 1, 2도 허용)",
+                        "name": "restaurant_name",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "기준 날짜 (YYYY-MM-DD)",
+                        "name": "date",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "성공적으로 현재 이미지 이름 조회",
+                        "schema": {
+                            "$ref": "#/definitions/models.ImageInfoResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "잘못된 요청",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "해당 날짜에 유효한 이미지가 없음",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "서버 내부 오류 발생",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/images/files/{key}": {
+            "get": {
+                "description": "업로드한 이미지 원본 또는 썸네일 파일을 내려줍니다.",
+                "produces": [
+                    "image/jpeg",
+                    "image/png",
+                    "image/gif"
+                ],
+                "tags": [
+                    "Images"
+                ],
+                "summary": "이미지 파일 조회",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "이미지 키 (응답의 image_url, thumbnail_url 경로)",
+                        "name": "key",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "이미지 파일",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "404": {
+                        "description": "이미지를 찾을 수 없음",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "서버 내부 오류 발생",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/images/history": {
+            "get": {
+                "description": "식당별 이미지 업로드 기록을 최신순으로 조회합니다.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Images"
+                ],
+                "summary": "이미지 업로드 기록 조회",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "레스토랑 이름 (RESTAURANT_1, RESTAURANT_2, 대소문자 관계없음. 이전 번호 1, 2도 허용)",
+                        "name": "restaurant_name",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "이미지 업로드 기록",
+                        "schema": {
+                            "$ref": "#/definitions/models.ImageHistoryResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "잘못된 요청",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "서버 내부 오류 발생",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/images/upload": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "이미지 이름을 업로드합니다. date가 속한 주차에 연결되며, 생략하면 오늘 날짜 기준입니다.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Images"
+                ],
+                "summary": "이미지 이름 업로드",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "레스토랑 이름 (RESTAURANT_1, RESTAURANT_2, 대소문자 관계없음. 이전 번호 1, 2도 허용)",
+                        "name": "restaurant_name",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "기준 날짜 (YYYY-MM-DD)",
+                        "name": "date",
+                        "in": "query"
+                    },
+                    {
+                        "description": "업로드할 이미지 이름",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.ImageUploadRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "성공적으로 이미지 이름 업로드",
+                        "schema": {
+                            "$ref": "#/definitions/models.ImageInfoResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "잘못된 요청",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "인증 실패",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "해당 식당에 대한 권한 없음",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "서버 내부 오류 발생",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/images/upload/file": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "식단 사진 파일을 업로드합니다. 형식은 파일 내용으로 판별하며 JPEG, PNG, GIF만 허용합니다. 썸네일이 함께 생성됩니다.",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Images"
+                ],
+                "summary": "이미지 파일 업로드",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "레스토랑 이름 (RESTAURANT_1, RESTAURANT_2, 대소문자 관계없음. 이전 번호 1, 2도 허용)",
+                        "name": "restaurant_name",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "기준 날짜 (YYYY-MM-DD)",
+                        "name": "date",
+                        "in": "query"
+                    },
+                    {
+                        "type": "file",
+                        "description": "이미지 파일",
+                        "name": "image",
+                        "in": "formData",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "성공적으로 이미지 업로드",
+                        "schema": {
+                            "$ref": "#/definitions/models.ImageInfoResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "잘못된 요청",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "인증 실패",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "해당 식당에 대한 권한 없음",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "413": {
+                        "description": "파일 크기 초과",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "서버 내부 오류 발생",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/notifications/subscribers": {
+            "post": {
+                "description": "디바이스 토큰과 즐겨찾기 메뉴를 등록합니다. 같은 디바이스 토큰으로 다시 요청하면 설정을 덮어씁니다. 새 식단이 업로드되면 즐겨찾기 메뉴가 포함된 경우 알림을 보내고, restaurant를 지정한 경우 이미 게시된 날짜의 식단이 바뀌면 변경 알림을 보냅니다.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Notifications"
+                ],
+                "summary": "푸시 알림 구독",
+                "parameters": [
+                    {
+                        "description": "구독 정보",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.NotificationSubscribeRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "구독 성공",
+                        "schema": {
+                            "$ref": "#/definitions/models.NotificationSubscriberResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "잘못된 요청",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "서버 내부 오류 발생",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/notifications/subscribers/{id}": {
+            "delete": {
+                "description": "등록된 구독자와 즐겨찾기 메뉴를 삭제합니다. 앱은 X-Device-Token 헤더에 구독할 때 쓴 디바이스 토큰을 보내고, admin 키로는 토큰 없이 삭제할 수 있습니다.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Notifications"
+                ],
+                "summary": "푸시 알림 구독 해지",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "구독자 ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "구독한 디바이스 토큰 (admin 키가 없을 때 필수)",
+                        "name": "X-Device-Token",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "구독 해지 성공"
+                    },
+                    "401": {
+                        "description": "디바이스 토큰 또는 admin 키가 없음",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "구독자를 찾을 수 없음",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "서버 내부 오류 발생",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/restaurants/{name}": {
+            "get": {
+                "description": "경로 파라미터로 받은 식당 이름과 쿼리로 받은 날짜를 기준으로 주간 식단을 조회합니다. Restaurant1은 평일만(월~금, 5일), Restaurant2는 주말 포함(월~일, 7일) 조회됩니다. 등록된 휴무일은 closed 항목으로, 공휴일은 holiday가 붙은 항목으로 포함됩니다.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Meals"
+                ],
+                "summary": "특정 식당의 주간 식단 조회",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "레스토랑 이름 (RESTAURANT_1: 평일만, RESTAURANT_2: 주말 포함)",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "조회할 날짜 (YYYY-MM-DD 형식)",
+                        "name": "date",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "성공적으로 식단 정보 조회",
+                        "schema": {
+                            "$ref": "#/definitions/models.RestaurantMealsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "잘못된 요청 파라미터 (식당 이름 또는 날짜 형식 오류)",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "해당 식당 또는 해당 날짜의 식단 정보를 찾을 수 없음",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "서버 내부 오류 발생",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/restaurants/{name}/calendar.ics": {
+            "get": {
+                "description": "식당의 최근 4주 및 예정된 식단을 iCalendar(.ics) 형식으로 제공합니다. 각 식사는 배식 시간을 시작/종료 시각으로 하는 VEVENT이며, 설명에 메뉴 목록이 들어갑니다. 캘린더 앱에서 URL로 구독할 수 있습니다.",
+                "produces": [
+                    "text/calendar"
+                ],
+                "tags": [
+                    "Meals"
+                ],
+                "summary": "식단 캘린더 구독 (iCalendar)",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "레스토랑 이름 (RESTAURANT_1, RESTAURANT_2)",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "ko",
+                            "en"
+                        ],
+                        "type": "string",
+                        "default": "ko",
+                        "description": "언어 (ko, en)",
+                        "name": "lang",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "iCalendar 데이터",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "잘못된 식당 이름 또는 언어",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "서버 내부 오류 발생",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/restaurants/{name}/closures": {
+            "get": {
+                "description": "기간과 겹치는 식당 휴무(공휴일, 시험 기간, 방학 등)를 조회합니다. 기간을 지정하지 않으면 오늘부터 90일입니다.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Closures"
+                ],
+                "summary": "식당 휴무 목록",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "식당 이름 (RESTAURANT_1, RESTAURANT_2)",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "example": "2025-06-01",
+                        "description": "시작 날짜 (YYYY-MM-DD)",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "2025-08-31",
+                        "description": "종료 날짜 (YYYY-MM-DD)",
+                        "name": "to",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "조회 성공",
+                        "schema": {
+                            "$ref": "#/definitions/models.ClosureListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "잘못된 식당 이름 또는 날짜",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "서버 내부 오류 발생",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/restaurants/{name}/daily": {
+            "get": {
+                "description": "하루 식단을 식사 종류별로 조회합니다. 휴무일은 closed와 휴무 사유(closure)가 담긴 항목으로, 운영하지 않는 식사는 closed 식사 항목으로 응답합니다. 공휴일에는 holiday가 붙고, 휴무 등록과 식단이 모두 없으면 likely_closed로 표시됩니다.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Meals"
+                ],
+                "summary": "특정 식당의 하루 식단 조회",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "레스토랑 이름 (RESTAURANT_1, RESTAURANT_2)",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "조회할 날짜 (YYYY-MM-DD 형식, 기본값 오늘)",
+                        "name": "date",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "성공적으로 식단 정보 조회",
+                        "schema": {
+                            "$ref": "#/definitions/models.DailyMealsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "잘못된 요청 파라미터 (식당 이름 또는 날짜 형식 오류)",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "해당 날짜의 식단, 휴무, 공휴일 정보가 없음",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "서버 내부 오류 발생",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/restaurants/{name}/feed.atom": {
+            "get": {
+                "description": "식당의 최근 4주 및 예정된 식단을 Atom 피드로 제공합니다. 하루치 식단(모든 식사)이 하나의 항목이며, 해당 날짜의 식단이 업로드로 바뀌면 updated가 갱신됩니다.",
+                "produces": [
+                    "application/atom+xml"
+                ],
+                "tags": [
+                    "Meals"
+                ],
+                "summary": "일별 식단 Atom 피드",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "레스토랑 이름 (RESTAURANT_1, RESTAURANT_2)",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "ko",
+                            "en"
+                        ],
+                        "type": "string",
+                        "default": "ko",
+                        "description": "언어 (ko, en)",
+                        "name": "lang",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Atom 피드",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "잘못된 식당 이름 또는 언어",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "서버 내부 오류 발생",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/restaurants/{name}/meal-types": {
+            "get": {
+                "description": "식당에서 운영하는 식사 종류(코드, 한국어/영어 이름, 표시 순서, 배식 시간)를 표시 순서대로 조회합니다.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "MealTypes"
+                ],
+                "summary": "식당의 식사 종류 목록",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "식당 이름 (RESTAURANT_1, RESTAURANT_2)",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "조회 성공",
+                        "schema": {
+                            "$ref": "#/definitions/models.MealTypeListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "잘못된 식당 이름",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "서버 내부 오류 발생",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/schemas/week-menu.json": {
+            "get": {
+                "description": "POST /upload/json이 받는 문서의 JSON Schema (draft 2020-12). 식사 종류 코드는 현재 meal_types 설정을 따릅니다",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "json"
+                ],
+                "summary": "주간 식단 문서 JSON Schema",
+                "responses": {
+                    "200": {
+                        "description": "JSON Schema",
+                        "schema": {
+                            "type": "object"
+                        }
+                    },
+                    "500": {
+                        "description": "서버 내부 오류 발생",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/upload/excel": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "파일을 업로드 해서 식단 데이터를 디비에 저장한다. .xlsx, .xls(Excel 97-2003), .ods, .csv 파일을 받으며 요청 본문 크기는 UPLOAD_MAX_BYTES(기본 20MB)로 제한된다. 저장된 식단은 draft 주차에 들어가고, 게시된 뒤에 조회 API에 나타난다.",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "tags": [
+                    "excel"
+                ],
+                "summary": "엑셀 처리 API",
+                "parameters": [
+                    {
+                        "type": "file",
+                        "description": "한국어 엑셀 파일",
+                        "name": "excel_ko",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "file",
+                        "description": "영어 엑셀 파일",
+                        "name": "excel_en",
+                        "in": "formData",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Excel file processed successfully",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.DualExcelProcessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid Excel file",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - Invalid or missing token",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - Not allowed to upload for this restaurant",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "413": {
+                        "description": "Request body is too large",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to process Excel file",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/upload/json": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "한 주 식단 문서를 받아서 디비에 저장합니다. 문서는 GET /schemas/week-menu.json의 JSON Schema로 검사하며, 오류가 있으면 저장하지 않고 JSON Pointer 경로가 포함된 diagnostics로 400을 반환합니다. Content-Type이 application/yaml(또는 text/yaml)이면 YAML로 읽습니다. admin, approver 또는 해당 식당 staff 권한의 API 키나 JWT가 필요합니다. 저장된 식단은 draft 주차에 들어가고, 게시된 뒤에 조회 API에 나타납니다.",
+                "consumes": [
+                    "application/json",
+                    "application/yaml"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "json"
+                ],
+                "summary": "주간 식단 문서(JSON/YAML) 업로드",
+                "parameters": [
+                    {
+                        "description": "주간 식단 문서 (스키마: /api/v1/schemas/week-menu.json)",
+                        "name": "document",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "object"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Week document processed successfully (warnings included)",
+                        "schema": {
+                            "$ref": "#/definitions/models.ExcelProcessResult"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid document (schema diagnostics)",
+                        "schema": {
+                            "$ref": "#/definitions/models.ParseErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - Invalid or missing token",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - Not allowed to upload for this restaurant",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "413": {
+                        "description": "Request body is too large",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to process document",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/upload/text": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "plain text로 한 주치 식단 데이터를 받아서 디비에 저장합니다. admin, approver 또는 해당 식당 staff 권한의 API 키나 JWT가 필요합니다. 저장된 식단은 draft 주차에 들어가고, 게시된 뒤에 조회 API에 나타납니다. 형식 오류(알 수 없는 식사 종류, 주차 밖의 날짜, 요일 불일치, 중복된 날짜 등)가 하나라도 있으면 저장하지 않고 줄 번호가 포함된 diagnostics로 400을 반환합니다. 첫 줄에 \"#format v2\"를 쓰면 \"돈까스 | Pork Cutlet ; category=메인메뉴 ; price=5000 ; allergens=1,2,5\" 형식으로 영어 이름과 속성을 함께 보낼 수 있습니다.",
+                "consumes": [
+                    "text/plain"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "text"
+                ],
+                "summary": "텍스트로 식단 데이터 업로드",
+                "parameters": [
+                    {
+                        "description": "식단 텍스트 데이터",
+                        "name": "text",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Text processed successfully (warnings included)",
+                        "schema": {
+                            "$ref": "#/definitions/models.ExcelProcessResult"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid text format (line-numbered diagnostics)",
+                        "schema": {
+                            "$ref": "#/definitions/models.ParseErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - Invalid or missing token",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - Not allowed to upload for this restaurant",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "413": {
+                        "description": "Request body is too large",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to process text",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/webhooks": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "등록된 웹훅 구독 목록을 조회합니다. secret은 포함되지 않습니다.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Webhooks"
+                ],
+                "summary": "웹훅 구독 목록",
+                "responses": {
+                    "200": {
+                        "description": "조회 성공",
+                        "schema": {
+                            "$ref": "#/definitions/models.WebhookSubscriptionListResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - Invalid or missing token",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "서버 내부 오류 발생",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "식단 게시/변경, 이미지 변경 시 호출될 URL을 등록합니다. 이벤트 종류는 week.published, week.updated, image.updated 입니다. 요청 본문은 X-Grrrr-Signature 헤더에 HMAC-SHA256(secret, \"\u003cX-Grrrr-Timestamp\u003e.\u003cbody\u003e\") 서명(sha256=hex)과 함께 전송됩니다. secret을 비우면 서버가 생성하며 응답에서 한 번만 확인할 수 있습니다.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Webhooks"
+                ],
+                "summary": "웹훅 구독 등록",
+                "parameters": [
+                    {
+                        "description": "구독 정보",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.WebhookCreateRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "구독 등록 성공",
+                        "schema": {
+                            "$ref": "#/definitions/models.WebhookSubscriptionResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "잘못된 요청",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - Invalid or missing token",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "서버 내부 오류 발생",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/webhooks/{id}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Webhooks"
+                ],
+                "summary": "웹훅 구독 삭제",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "구독 ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "삭제 성공"
+                    },
+                    "401": {
+                        "description": "Unauthorized - Invalid or missing token",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "구독을 찾을 수 없음",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "서버 내부 오류 발생",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/webhooks/{id}/deliveries": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "구독별 최근 100건의 전송 기록(상태, 시도 횟수, 응답 코드, 본문)을 조회합니다.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Webhooks"
+                ],
+                "summary": "웹훅 전송 기록",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "구독 ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "조회 성공",
+                        "schema": {
+                            "$ref": "#/definitions/models.WebhookDeliveryListResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - Invalid or missing token",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "구독을 찾을 수 없음",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "서버 내부 오류 발생",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/weeks": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "식당과 게시 상태(draft, in_review, published, retracted)로 주차를 조회합니다. 담당 식당이 있는 키는 그 식당의 주차만 조회합니다.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Weeks"
+                ],
+                "summary": "주차 목록",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "식당 이름 (RESTAURANT_1, RESTAURANT_2)",
+                        "name": "restaurant",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "draft",
+                            "in_review",
+                            "published",
+                            "retracted"
+                        ],
+                        "type": "string",
+                        "description": "게시 상태",
+                        "name": "status",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "조회 성공",
+                        "schema": {
+                            "$ref": "#/definitions/models.WeekListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "잘못된 식당 이름 또는 상태",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - Invalid or missing token",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "권한 없음",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "서버 내부 오류 발생",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/weeks/{id}/preview": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "주차 식단과 같은 기간에 지금 게시된 식단을 함께 보여주고, 게시하면 바뀌는 메뉴를 알려줍니다.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Weeks"
+                ],
+                "summary": "게시 전 식단 미리보기",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "주차 ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "조회 성공",
+                        "schema": {
+                            "$ref": "#/definitions/models.WeekPreviewResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - Invalid or missing token",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "권한 없음",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "주차를 찾을 수 없음",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "서버 내부 오류 발생",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/weeks/{id}/publish": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "검토 중(in_review)이거나 내려간(retracted) 주차를 게시합니다. 같은 기간에 게시되어 있던 주차는 retracted가 되고, 알림과 웹훅이 전송됩니다. approver와 admin만 호출할 수 있습니다.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Weeks"
+                ],
+                "summary": "식단 게시",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "주차 ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "게시 성공",
+                        "schema": {
+                            "$ref": "#/definitions/models.WeekResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - Invalid or missing token",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "권한 없음",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "주차를 찾을 수 없음",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "게시할 수 없는 상태",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "서버 내부 오류 발생",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/weeks/{id}/reject": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "검토 중(in_review)인 주차를 draft로 되돌립니다. approver와 admin만 호출할 수 있습니다.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Weeks"
+                ],
+                "summary": "검토 반려",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "주차 ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "반려 성공",
+                        "schema": {
+                            "$ref": "#/definitions/models.WeekResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - Invalid or missing token",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "권한 없음",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "주차를 찾을 수 없음",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "검토 중이 아님",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "서버 내부 오류 발생",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/weeks/{id}/retract": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "게시된 주차를 조회 API에서 내립니다(retracted). 내린 주차는 다시 게시할 수 있습니다. approver와 admin만 호출할 수 있습니다.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Weeks"
+                ],
+                "summary": "게시 취소",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "주차 ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "게시 취소 성공",
+                        "schema": {
+                            "$ref": "#/definitions/models.WeekResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - Invalid or missing token",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "권한 없음",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "주차를 찾을 수 없음",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "게시된 주차가 아님",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "서버 내부 오류 발생",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/weeks/{id}/submit": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "draft 주차를 검토 중(in_review)으로 바꿉니다. 검토 중에 식단을 다시 올리면 draft로 돌아갑니다.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Weeks"
+                ],
+                "summary": "검토 요청",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "주차 ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "검토 요청 성공",
+                        "schema": {
+                            "$ref": "#/definitions/models.WeekResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - Invalid or missing token",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "권한 없음",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "주차를 찾을 수 없음",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "draft 상태가 아님",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "서버 내부 오류 발생",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "handlers.DualExcelProcessResponse": {
+            "type": "object",
+            "properties": {
+                "result_en": {
+                    "$ref": "#/definitions/models.ExcelProcessResult"
+                },
+                "result_ko": {
+                    "$ref": "#/definitions/models.ExcelProcessResult"
+                },
+                "success": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "models.APIKey": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "expires_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "last_used_at": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "prefix": {
+                    "type": "string"
+                },
+                "revoked_at": {
+                    "type": "string"
+                },
+                "scopes": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "models.APIKeyCreateRequest": {
+            "type": "object",
+            "required": [
+                "name",
+                "scopes"
+            ],
+            "properties": {
+                "expires_in_days": {
+                    "type": "integer",
+                    "example": 90
+                },
+                "name": {
+                    "type": "string",
+                    "example": "1식당 영양사"
+                },
+                "scopes": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "staff@RESTAURANT_1"
+                    ]
+                }
+            }
+        },
+        "models.APIKeyListResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.APIKey"
+                    }
+                },
+                "success": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "models.APIKeyResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/models.APIKey"
+                },
+                "key": {
+                    "description": "발급할 때 한 번만 내려주는 원본 키",
+                    "type": "string"
+                },
+                "success": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "models.AuditFile": {
+            "type": "object",
+            "properties": {
+                "name": {
+                    "type": "string"
+                },
+                "sha256": {
+                    "type": "string"
+                },
+                "size": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.AuditLog": {
+            "type": "object",
+            "properties": {
+                "action": {
+                    "type": "string"
+                },
+                "actor": {
+                    "type": "string"
+                },
+                "actor_role": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "endpoint": {
+                    "type": "string"
+                },
+                "files": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.AuditFile"
+                    }
+                },
+                "id": {
+                    "type": "string"
+                },
+                "method": {
+                    "type": "string"
+                },
+                "restaurant": {
+                    "$ref": "#/definitions/models.RestaurantType"
+                },
+                "summary": {
+                    "type": "object",
+                    "additionalProperties": true
+                },
+                "week_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.AuditLogListResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.AuditLog"
+                    }
+                },
+                "success": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "models.AuthPrincipal": {
+            "type": "object",
+            "properties": {
+                "restaurant": {
+                    "type": "string",
+                    "example": "RESTAURANT_1"
+                },
+                "role": {
+                    "type": "string",
+                    "example": "staff"
+                },
+                "subject": {
+                    "type": "string",
+                    "example": "dietitian@example.ac.kr"
+                }
+            }
+        },
+        "models.AuthPrincipalResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/models.AuthPrincipal"
+                },
+                "success": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "models.Closure": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "created_by": {
+                    "type": "string"
+                },
+                "end_date": {
+                    "type": "string",
+                    "example": "2025-06-06"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "meal_type": {
+                    "type": "string",
+                    "example": "Dinner"
+                },
+                "reason_en": {
+                    "type": "string",
+                    "example": "Memorial Day"
+                },
+                "reason_ko": {
+                    "type": "string",
+                    "example": "현충일"
+                },
+                "restaurant": {
+                    "$ref": "#/definitions/models.RestaurantType"
+                },
+                "start_date": {
+                    "type": "string",
+                    "example": "2025-06-06"
+                }
+            }
+        },
+        "models.ClosureInfo": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "string"
+                },
+                "reason_en": {
+                    "type": "string",
+                    "example": "Memorial Day"
+                },
+                "reason_ko": {
+                    "type": "string",
+                    "example": "현충일"
+                }
+            }
+        },
+        "models.ClosureListResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.Closure"
+                    }
+                },
+                "success": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "models.ClosureRequest": {
+            "type": "object",
+            "required": [
+                "restaurant",
+                "start_date"
+            ],
+            "properties": {
+                "end_date": {
+                    "description": "비우면 종료일은 시작일과 같습니다",
+                    "type": "string",
+                    "example": "2025-06-06"
+                },
+                "meal_type": {
+                    "description": "비우면 하루 전체 휴무",
+                    "type": "string",
+                    "example": "Dinner"
+                },
+                "reason_en": {
+                    "type": "string",
+                    "example": "Memorial Day"
+                },
+                "reason_ko": {
+                    "type": "string",
+                    "example": "현충일"
+                },
+                "restaurant": {
+                    "type": "string",
+                    "example": "RESTAURANT_1"
+                },
+                "start_date": {
+                    "type": "string",
+                    "example": "2025-06-06"
+                }
+            }
+        },
+        "models.ClosureResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/models.Closure"
+                },
+                "success": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "models.DailyMealsData": {
+            "type": "object",
+            "properties": {
+                "day": {
+                    "$ref": "#/definitions/models.DayMeals"
+                },
+                "restaurant": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.DailyMealsResponse": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "string"
+                },
+                "data": {
+                    "$ref": "#/definitions/models.DailyMealsData"
+                },
+                "error": {
+                    "type": "string"
+                },
+                "success": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "models.DayMeals": {
+            "type": "object",
+            "properties": {
+                "closed": {
+                    "description": "하루 전체 휴무인 날은 Closed와 휴무 사유를 함께 보냅니다",
+                    "type": "boolean"
+                },
+                "closure": {
+                    "$ref": "#/definitions/models.ClosureInfo"
+                },
+                "date": {
+                    "type": "string"
+                },
+                "day_of_week": {
+                    "type": "string"
+                },
+                "holiday": {
+                    "description": "공휴일이면 이름을 붙이고, 등록된 휴무와 식단이 모두 없으면 쉬는 날일 가능성이 높다고 표시합니다",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.HolidayInfo"
+                        }
+                    ]
+                },
+                "likely_closed": {
+                    "type": "boolean"
+                },
+                "meals": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "$ref": "#/definitions/models.MealInfo"
+                    }
+                }
+            }
+        },
+        "models.ErrorResponse": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string",
+                    "example": "Failed to process Excel file: file not found"
+                },
+                "success": {
+                    "type": "boolean",
+                    "example": false
+                }
+            }
+        },
+        "models.ExcelProcessResult": {
+            "type": "object",
+            "properties": {
+                "changes": {
+                    "description": "업로드 전 데이터와 비교한 메뉴 변경 내역",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.MenuChanges"
+                        }
+                    ]
+                },
+                "message": {
+                    "type": "string"
+                },
+                "restaurant_type": {
+                    "type": "string"
+                },
+                "success": {
+                    "type": "boolean"
+                },
+                "total_meals": {
+                    "type": "integer"
+                },
+                "total_menu_items": {
+                    "type": "integer"
+                },
+                "warnings": {
+                    "description": "처리는 됐지만 확인이 필요한 항목",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.ParseDiagnostic"
+                    }
+                },
+                "week_end_date": {
+                    "type": "string"
+                },
+                "week_id": {
+                    "type": "string"
+                },
+                "week_start_date": {
+                    "type": "string"
+                },
+                "week_status": {
+                    "description": "업로드한 식단은 draft 주차에 저장되고, 게시된 뒤에 조회 API에 나타납니다",
+                    "type": "string",
+                    "example": "draft"
+                }
+            }
+        },
+        "models.HolidayInfo": {
+            "type": "object",
+            "properties": {
+                "name": {
+                    "type": "string",
+                    "example": "현충일"
+                },
+                "name_en": {
+                    "type": "string",
+                    "example": "Memorial Day"
+                },
+                "substitute": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "models.ImageHistoryResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.ImageInfoResponse"
+                    }
+                },
+                "success": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "models.ImageInfoResponse": {
+            "type": "object",
+            "properties": {
+                "content_type": {
+                    "type": "string"
+                },
+                "image_date": {
+                    "type": "string"
+                },
+                "image_name": {
+                    "type": "string"
+                },
+                "image_url": {
+                    "type": "string"
+                },
+                "size_bytes": {
+                    "type": "integer"
+                },
+                "success": {
+                    "type": "boolean"
+                },
+                "thumbnail_url": {
+                    "type": "string"
+                },
+                "valid_from": {
+                    "type": "string"
+                },
+                "week_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.ImageUploadRequest": {
+            "type": "object",
+            "required": [
+                "image_name"
+            ],
+            "properties": {
+                "image_name": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.KakaoCarousel": {
+            "type": "object",
+            "properties": {
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.KakaoTextCard"
+                    }
+                },
+                "type": {
+                    "type": "string",
+                    "example": "textCard"
+                }
+            }
+        },
+        "models.KakaoOutput": {
+            "type": "object",
+            "properties": {
+                "carousel": {
+                    "$ref": "#/definitions/models.KakaoCarousel"
+                },
+                "simpleText": {
+                    "$ref": "#/definitions/models.KakaoSimpleText"
+                },
+                "textCard": {
+                    "$ref": "#/definitions/models.KakaoTextCard"
+                }
+            }
+        },
+        "models.KakaoQuickReply": {
+            "type": "object",
+            "properties": {
+                "action": {
+                    "type": "string",
+                    "example": "message"
+                },
+                "label": {
+                    "type": "string"
+                },
+                "messageText": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.KakaoSimpleText": {
+            "type": "object",
+            "properties": {
+                "text": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.KakaoSkillRequest": {
+            "type": "object",
+            "properties": {
+                "userRequest": {
+                    "type": "object",
+                    "properties": {
+                        "user": {
+                            "type": "object",
+                            "properties": {
+                                "id": {
+                                    "type": "string"
+                                }
+                            }
+                        },
+                        "utterance": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "models.KakaoSkillResponse": {
+            "type": "object",
+            "properties": {
+                "template": {
+                    "$ref": "#/definitions/models.KakaoTemplate"
+                },
+                "version": {
+                    "type": "string",
+                    "example": "2.0"
+                }
+            }
+        },
+        "models.KakaoTemplate": {
+            "type": "object",
+            "properties": {
+                "outputs": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.KakaoOutput"
+                    }
+                },
+                "quickReplies": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.KakaoQuickReply"
+                    }
+                }
+            }
+        },
+        "models.KakaoTextCard": {
+            "type": "object",
+            "properties": {
+                "description": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.MealInfo": {
+            "type": "object",
+            "properties": {
+                "closed": {
+                    "description": "운영하지 않는 식사 (식단이 올라와 있어도 휴무가 우선합니다)",
+                    "type": "boolean"
+                },
+                "closure": {
+                    "$ref": "#/definitions/models.ClosureInfo"
+                },
+                "label": {
+                    "description": "식사 종류 설정(meal_types)에 있는 표시 이름과 배식 시간",
+                    "type": "string",
+                    "example": "점심"
+                },
+                "label_en": {
+                    "type": "string",
+                    "example": "Lunch"
+                },
+                "meal_id": {
+                    "type": "string"
+                },
+                "meal_type": {
+                    "type": "string"
+                },
+                "menu_items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.MenuItemResponse"
+                    }
+                },
+                "serving_end": {
+                    "type": "string",
+                    "example": "13:30"
+                },
+                "serving_start": {
+                    "type": "string",
+                    "example": "11:30"
+                }
+            }
+        },
+        "models.MealType": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "string"
+                },
+                "display_order": {
+                    "type": "integer"
+                },
+                "excel_end_row": {
+                    "type": "integer"
+                },
+                "excel_start_row": {
+                    "description": "엑셀 식단표에서 메뉴를 읽을 행 범위 (없으면 엑셀에서 읽지 않음)",
+                    "type": "integer"
+                },
+                "label_en": {
+                    "type": "string"
+                },
+                "label_ko": {
+                    "type": "string"
+                },
+                "restaurant": {
+                    "$ref": "#/definitions/models.RestaurantType"
+                },
+                "serving_end": {
+                    "type": "string"
+                },
+                "serving_start": {
+                    "description": "\"HH:MM\"",
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.MealTypeListResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.MealType"
+                    }
+                },
+                "success": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "models.MealTypeRequest": {
+            "type": "object",
+            "required": [
+                "label_en",
+                "label_ko",
+                "serving_end",
+                "serving_start"
+            ],
+            "properties": {
+                "display_order": {
+                    "type": "integer",
+                    "example": 5
+                },
+                "excel_end_row": {
+                    "type": "integer"
+                },
+                "excel_start_row": {
+                    "type": "integer"
+                },
+                "label_en": {
+                    "type": "string",
+                    "example": "Late snack"
+                },
+                "label_ko": {
+                    "type": "string",
+                    "example": "야식"
+                },
+                "serving_end": {
+                    "type": "string",
+                    "example": "22:00"
+                },
+                "serving_start": {
+                    "type": "string",
+                    "example": "21:00"
+                }
+            }
+        },
+        "models.MealTypeResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/models.MealType"
+                },
+                "success": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "models.MealsSummary": {
+            "type": "object",
+            "properties": {
+                "total_days": {
+                    "type": "integer"
+                },
+                "total_meals": {
+                    "type": "integer"
+                },
+                "total_menu_items": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.MenuChanges": {
+            "type": "object",
+            "properties": {
+                "added": {
+                    "type": "integer"
+                },
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.MenuItemChange"
+                    }
+                },
+                "removed": {
+                    "type": "integer"
+                },
+                "updated": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.MenuItemChange": {
+            "type": "object",
+            "properties": {
+                "category": {
+                    "type": "string",
+                    "example": "메인메뉴"
+                },
+                "change": {
+                    "type": "string",
+                    "example": "added"
+                },
+                "date": {
+                    "type": "string",
+                    "example": "2025-05-26"
+                },
+                "meal_type": {
+                    "type": "string",
+                    "example": "Lunch_2"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "돈까스"
+                }
+            }
+        },
+        "models.MenuItemResponse": {
+            "type": "object",
+            "properties": {
+                "allergens": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "1",
+                        "2",
+                        "5"
+                    ]
+                },
+                "category": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "name_en": {
+                    "type": "string"
+                },
+                "price": {
+                    "type": "number"
+                }
+            }
+        },
+        "models.NotificationSubscribeRequest": {
+            "type": "object",
+            "required": [
+                "device_token",
+                "platform"
+            ],
+            "properties": {
+                "device_token": {
+                    "type": "string"
+                },
+                "favorite_dishes": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "돈까스",
+                        "제육볶음"
+                    ]
+                },
+                "platform": {
+                    "type": "string",
+                    "enum": [
+                        "fcm",
+                        "apns"
+                    ]
+                },
+                "restaurant": {
+                    "type": "string",
+                    "example": "RESTAURANT_1"
+                }
+            }
+        },
+        "models.NotificationSubscriber": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "device_token": {
+                    "type": "string"
+                },
+                "favorite_dishes": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "id": {
+                    "type": "string"
+                },
+                "platform": {
+                    "type": "string"
+                },
+                "restaurant": {
+                    "$ref": "#/definitions/models.RestaurantType"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.NotificationSubscriberResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/models.NotificationSubscriber"
+                },
+                "success": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "models.ParseDiagnostic": {
+            "type": "object",
+            "properties": {
+                "line": {
+                    "type": "integer",
+                    "example": 12
+                },
+                "message": {
+                    "type": "string",
+                    "example": "unknown meal type: Lunch_3 (expected Breakfast, Lunch_1, Lunch_2, Dinner)"
+                },
+                "path": {
+                    "type": "string",
+                    "example": "/days/0/meals/Lunch_1/2/price"
+                },
+                "severity": {
+                    "type": "string",
+                    "enum": [
+                        "error",
+                        "warning"
+                    ],
+                    "example": "error"
+                }
+            }
+        },
+        "models.ParseErrorResponse": {
+            "type": "object",
+            "properties": {
+                "diagnostics": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.ParseDiagnostic"
+                    }
+                },
+                "error": {
+                    "type": "string",
+                    "example": "2 errors (first at line 12: unknown meal type: Lunch_3)"
+                },
+                "success": {
                     "type": "boolean",
                     "example": false
                 }
             }
         },
-        "models.ExcelProcessResult": {
+        "models.ReprocessResponse": {
+            "type": "object",
+            "properties": {
+                "results": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.ExcelProcessResult"
+                    }
+                },
+                "success": {
+                    "type": "boolean"
+                },
+                "upload": {
+                    "$ref": "#/definitions/models.Upload"
+                }
+            }
+        },
+        "models.RestaurantMealsData": {
             "type": "object",
             "properties": {
-                "message": {
+                "meals_by_day": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.DayMeals"
+                    }
+                },
+                "restaurant": {
                     "type": "string"
                 },
-                "restaurant_type": {
+                "summary": {
+                    "$ref": "#/definitions/models.MealsSummary"
+                },
+                "week": {
+                    "$ref": "#/definitions/models.WeekInfo"
+                }
+            }
+        },
+        "models.RestaurantMealsResponse": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "string"
+                },
+                "data": {
+                    "$ref": "#/definitions/models.RestaurantMealsData"
+                },
+                "error": {
                     "type": "string"
                 },
                 "success": {
                     "type": "boolean"
+                }
+            }
+        },
+        "models.RestaurantType": {
+            "type": "string",
+            "enum": [
+                "RESTAURANT_1",
+                "RESTAURANT_2"
+            ],
+            "x-enum-varnames": [
+                "Restaurant1",
+                "Restaurant2"
+            ]
+        },
+        "models.SlackBlock": {
+            "type": "object",
+            "properties": {
+                "text": {
+                    "$ref": "#/definitions/models.SlackText"
                 },
-                "total_meals": {
-                    "type": "integer"
+                "type": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.SlackCommandResponse": {
+            "type": "object",
+            "properties": {
+                "blocks": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.SlackBlock"
+                    }
                 },
-                "total_menu_items": {
-                    "type": "integer"
+                "response_type": {
+                    "type": "string",
+                    "example": "in_channel"
                 },
-                "week_id": {
+                "text": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.SlackText": {
+            "type": "object",
+            "properties": {
+                "text": {
                     "type": "string"
                 },
-                "week_start_date": {
+                "type": {
                     "type": "string"
                 }
             }
         },
-        "models.ImageInfoResponse": {
+        "models.Upload": {
             "type": "object",
             "properties": {
-                "image_date": {
+                "created_at": {
                     "type": "string"
                 },
-                "image_name": {
+                "files": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.UploadFile"
+                    }
+                },
+                "id": {
+                    "type": "string"
+                },
+                "kind": {
+                    "type": "string"
+                },
+                "restaurant": {
+                    "$ref": "#/definitions/models.RestaurantType"
+                },
+                "uploaded_by": {
+                    "type": "string"
+                },
+                "week_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.UploadFile": {
+            "type": "object",
+            "properties": {
+                "file_name": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "role": {
+                    "type": "string"
+                },
+                "sha256": {
                     "type": "string"
                 },
+                "size_bytes": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.UploadListResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.Upload"
+                    }
+                },
                 "success": {
                     "type": "boolean"
                 }
             }
         },
-        "models.ImageUploadRequest": {
+        "models.WebhookCreateRequest": {
             "type": "object",
             "required": [
-                "image_name"
+                "event_types",
+                "url"
             ],
             "properties": {
-                "image_name": {
+                "event_types": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "week.published",
+                        "week.updated"
+                    ]
+                },
+                "secret": {
                     "type": "string"
+                },
+                "url": {
+                    "type": "string",
+                    "example": "https://signage.example.com/hooks/menu"
                 }
             }
         },
-        "models.MealInfo": {
+        "models.WebhookDelivery": {
             "type": "object",
             "properties": {
-                "meal_id": {
+                "attempts": {
+                    "type": "integer"
+                },
+                "created_at": {
                     "type": "string"
                 },
-                "meal_type": {
+                "delivered_at": {
                     "type": "string"
                 },
-                "menu_items": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/models.MenuItemResponse"
-                    }
+                "event_type": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "last_error": {
+                    "type": "string"
+                },
+                "payload": {
+                    "type": "string"
+                },
+                "response_status": {
+                    "type": "integer"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "subscription_id": {
+                    "type": "string"
                 }
             }
         },
-        "models.MealsSummary": {
+        "models.WebhookDeliveryListResponse": {
             "type": "object",
             "properties": {
-                "total_days": {
-                    "type": "integer"
-                },
-                "total_meals": {
-                    "type": "integer"
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.WebhookDelivery"
+                    }
                 },
-                "total_menu_items": {
-                    "type": "integer"
+                "success": {
+                    "type": "boolean"
                 }
             }
         },
-        "models.MenuItemResponse": {
+        "models.WebhookSubscription": {
             "type": "object",
             "properties": {
-                "category": {
+                "active": {
+                    "type": "boolean"
+                },
+                "created_at": {
                     "type": "string"
                 },
+                "event_types": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
                 "id": {
                     "type": "string"
                 },
-                "name": {
+                "secret": {
                     "type": "string"
                 },
-                "name_en": {
+                "updated_at": {
                     "type": "string"
                 },
-                "price": {
-                    "type": "number"
+                "url": {
+                    "type": "string"
                 }
             }
         },
-        "models.RestaurantMealsData": {
+        "models.WebhookSubscriptionListResponse": {
             "type": "object",
             "properties": {
-                "meals_by_day": {
+                "data": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/models.DayMeals"
+                        "$ref": "#/definitions/models.WebhookSubscription"
                     }
                 },
-                "restaurant": {
-                    "type": "string"
-                },
-                "summary": {
-                    "$ref": "#/definitions/models.MealsSummary"
+                "success": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "models.WebhookSubscriptionResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/models.WebhookSubscription"
                 },
-                "week": {
-                    "$ref": "#/definitions/models.WeekInfo"
+                "success": {
+                    "type": "boolean"
                 }
             }
         },
-        "models.RestaurantMealsResponse": {
+        "models.Week": {
             "type": "object",
             "properties": {
-                "code": {
+                "end_date": {
                     "type": "string"
                 },
-                "data": {
-                    "$ref": "#/definitions/models.RestaurantMealsData"
+                "id": {
+                    "type": "string"
                 },
-                "error": {
+                "published_at": {
                     "type": "string"
                 },
-                "success": {
-                    "type": "boolean"
+                "restaurant": {
+                    "$ref": "#/definitions/models.RestaurantType"
+                },
+                "start_date": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "status_changed_at": {
+                    "type": "string"
+                },
+                "status_changed_by": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
                 }
             }
         },
@@ -462,13 +3638,84 @@ const docTemplate = `{
                 },
                 "start_date": {
                     "type": "string"
+                },
+                "status": {
+                    "type": "string",
+                    "example": "published"
+                }
+            }
+        },
+        "models.WeekListResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.Week"
+                    }
+                },
+                "success": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "models.WeekPreview": {
+            "type": "object",
+            "properties": {
+                "changes": {
+                    "description": "게시하면 바뀌는 메뉴",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.MenuChanges"
+                        }
+                    ]
+                },
+                "draft": {
+                    "description": "검토 중인 식단 (draft, in_review)",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.RestaurantMealsData"
+                        }
+                    ]
+                },
+                "published": {
+                    "description": "같은 기간에 지금 게시된 식단 (겹치는 게시 주차마다 하나, 시작일 순)",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.RestaurantMealsData"
+                    }
+                },
+                "week": {
+                    "$ref": "#/definitions/models.Week"
+                }
+            }
+        },
+        "models.WeekPreviewResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/models.WeekPreview"
+                },
+                "success": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "models.WeekResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/models.Week"
+                },
+                "success": {
+                    "type": "boolean"
                 }
             }
         }
     },
     "securityDefinitions": {
         "BearerAuth": {
-            "description": "토큰 인증. Swagger UI에서 토큰만 입력하세요. 토큰은 환경변수 BEARER_TOKEN에서 설정.",
+            "description": "API 키 또는 JWT 인증 (Bearer). 키와 역할은 환경변수 API_KEYS, BEARER_TOKEN, JWT_SECRET에서 설정.",
             "type": "apiKey",
             "name": "Authorization",
             "in": "header"
@@ -479,9 +3726,9 @@ const docTemplate = `{
 // SwaggerInfo holds exported Swagger Info so clients can modify it
 var SwaggerInfo = &swag.Spec{
 	Version:          "1.0",
-	Host:             "localhost:8080",
+	Host:             "api.grrrr.me",
 	BasePath:         "/api/v1",
-	Schemes:          []string{"http", "https"},
+	Schemes:          []string{"https"},
 	Title:            "Grrrrr API",
 	Description:      "The server for Grrrrr application.",
 	InfoInstanceName: "swagger",
@@ -0,0 +1,111 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/School-meal-lover/backend/internal/models"
+)
+
+const (
+	apiKeyPrefix       = "grk_"
+	apiKeyLookupLength = len(apiKeyPrefix) + 8
+)
+
+// 발급된 API 키 저장소 (repository.APIKeyRepository)
+type APIKeyStore interface {
+	GetAPIKeyByPrefix(prefix string) (*models.APIKey, error)
+	TouchAPIKey(id string) error
+}
+
+// 데이터베이스에 해시로 저장된 API 키를 검증합니다
+type StoredKeyAuthenticator struct {
+	store APIKeyStore
+	now   func() time.Time
+}
+
+func NewStoredKeyAuthenticator(store APIKeyStore) *StoredKeyAuthenticator {
+	return &StoredKeyAuthenticator{store: store, now: time.Now}
+}
+
+func (a *StoredKeyAuthenticator) Authenticate(ctx context.Context, token string) (*Principal, error) {
+	if !strings.HasPrefix(token, apiKeyPrefix) || len(token) <= apiKeyLookupLength {
+		return nil, ErrInvalidCredentials
+	}
+
+	key, err := a.store.GetAPIKeyByPrefix(token[:apiKeyLookupLength])
+	if err != nil {
+		return nil, err
+	}
+	if key == nil {
+		return nil, ErrInvalidCredentials
+	}
+
+	expected, err := hex.DecodeString(key.KeyHash)
+	if err != nil {
+		return nil, fmt.Errorf("invalid stored hash for api key %s: %w", key.ID, err)
+	}
+	hash := sha256.Sum256([]byte(token))
+	if subtle.ConstantTimeCompare(hash[:], expected) != 1 {
+		return nil, ErrInvalidCredentials
+	}
+	if key.RevokedAt != nil || (key.ExpiresAt != nil && !a.now().Before(*key.ExpiresAt)) {
+		return nil, ErrInvalidCredentials
+	}
+
+	principal, err := PrincipalFromScopes(key.Name, key.Scopes)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidCredentials, err)
+	}
+	if err := a.store.TouchAPIKey(key.ID); err != nil {
+		log.Printf("Failed to record api key usage: %v", err)
+	}
+	return principal, nil
+}
+
+// 새 API 키를 만듭니다. 원본 키는 호출한 쪽에 한 번만 돌려주고 해시만 저장합니다
+func GenerateAPIKey() (key, prefix, hash string, err error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", "", "", fmt.Errorf("failed to generate api key: %w", err)
+	}
+	key = apiKeyPrefix + hex.EncodeToString(buf)
+	sum := sha256.Sum256([]byte(key))
+	return key, key[:apiKeyLookupLength], hex.EncodeToString(sum[:]), nil
+}
+
+// 권한 범위 목록에서 가장 높은 역할을 고릅니다 (admin > approver > staff > read_only)
+func PrincipalFromScopes(subject string, scopes []string) (*Principal, error) {
+	if len(scopes) == 0 {
+		return nil, fmt.Errorf("at least one scope is required")
+	}
+
+	principal := &Principal{Subject: subject}
+	rank := map[Role]int{RoleReadOnly: 1, RoleStaff: 2, RoleApprover: 3, RoleAdmin: 4}
+	for _, scope := range scopes {
+		role, restaurant, err := ParseRole(scope)
+		if err != nil {
+			return nil, err
+		}
+		if restaurant != "" && principal.Restaurant != "" && principal.Restaurant != restaurant {
+			return nil, fmt.Errorf("a key can be bound to only one restaurant")
+		}
+		if restaurant != "" {
+			principal.Restaurant = restaurant
+		}
+		if rank[role] > rank[principal.Role] {
+			principal.Role = role
+		}
+	}
+	if principal.Role != RoleStaff && principal.Role != RoleApprover {
+		principal.Restaurant = ""
+	}
+	return principal, nil
+}
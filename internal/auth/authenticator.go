@@ -0,0 +1,36 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"strings"
+)
+
+var ErrInvalidCredentials = errors.New("invalid credentials")
+
+// Authenticator는 Authorization 헤더의 토큰으로 요청 주체를 확인합니다
+// 자신이 처리할 수 없는 토큰이면 ErrInvalidCredentials를 돌려줍니다
+type Authenticator interface {
+	Authenticate(ctx context.Context, token string) (*Principal, error)
+}
+
+// 여러 Authenticator를 순서대로 시도합니다
+type Chain []Authenticator
+
+func (c Chain) Authenticate(ctx context.Context, token string) (*Principal, error) {
+	for _, authenticator := range c {
+		principal, err := authenticator.Authenticate(ctx, token)
+		if err == nil {
+			return principal, nil
+		}
+		if !errors.Is(err, ErrInvalidCredentials) {
+			return nil, err
+		}
+	}
+	return nil, ErrInvalidCredentials
+}
+
+// JWT는 점(.)으로 구분된 세 부분으로 이루어져 있습니다
+func looksLikeJWT(token string) bool {
+	return strings.Count(token, ".") == 2
+}
@@ -0,0 +1,40 @@
+package auth
+
+import (
+	"log"
+	"os"
+)
+
+// JWT_SECRET, JWT_ISSUER 설정으로 JWT 검증/발급기를 만듭니다 (설정이 없으면 nil)
+func NewJWTAuthenticatorFromEnv() *JWTAuthenticator {
+	secret := os.Getenv("JWT_SECRET")
+	if secret == "" {
+		return nil
+	}
+	return NewJWTAuthenticator(secret, os.Getenv("JWT_ISSUER"))
+}
+
+// 환경변수 설정과 발급된 API 키 저장소로 Authenticator를 구성합니다
+//   - store: /admin/api-keys로 발급한 키
+//   - API_KEYS: 역할이 지정된 고정 API 키 목록
+//   - BEARER_TOKEN: 이전 버전과 호환되는 관리자 토큰
+//   - tokens: 역할 클레임이 담긴 HS256 JWT (SSO 로그인 세션 포함)
+func NewAuthenticatorFromEnv(store APIKeyStore, tokens *JWTAuthenticator) (Authenticator, error) {
+	staticKeys, err := NewStaticKeyAuthenticator(os.Getenv("API_KEYS"))
+	if err != nil {
+		return nil, err
+	}
+	if token := os.Getenv("BEARER_TOKEN"); token != "" {
+		staticKeys.Add(token, Principal{Subject: "bearer-token", Role: RoleAdmin})
+	}
+
+	chain := Chain{NewStoredKeyAuthenticator(store), staticKeys}
+	if tokens != nil {
+		chain = append(chain, tokens)
+	}
+
+	if staticKeys.Len() == 0 && tokens == nil {
+		log.Println("API_KEYS, BEARER_TOKEN and JWT_SECRET are not set; only API keys issued through /admin/api-keys will be accepted")
+	}
+	return chain, nil
+}
@@ -0,0 +1,70 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// 역할 정보를 담은 JWT 클레임
+type Claims struct {
+	Role       string `json:"role"`
+	Restaurant string `json:"restaurant,omitempty"`
+	jwt.RegisteredClaims
+}
+
+// HS256으로 서명된 JWT를 검증합니다
+type JWTAuthenticator struct {
+	secret []byte
+	issuer string
+}
+
+func NewJWTAuthenticator(secret, issuer string) *JWTAuthenticator {
+	return &JWTAuthenticator{secret: []byte(secret), issuer: issuer}
+}
+
+func (a *JWTAuthenticator) Authenticate(ctx context.Context, token string) (*Principal, error) {
+	if !looksLikeJWT(token) {
+		return nil, ErrInvalidCredentials
+	}
+
+	options := []jwt.ParserOption{jwt.WithValidMethods([]string{"HS256"}), jwt.WithExpirationRequired()}
+	if a.issuer != "" {
+		options = append(options, jwt.WithIssuer(a.issuer))
+	}
+	claims := &Claims{}
+	_, err := jwt.ParseWithClaims(token, claims, func(*jwt.Token) (interface{}, error) {
+		return a.secret, nil
+	}, options...)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidCredentials, err)
+	}
+
+	roleValue := claims.Role
+	if claims.Restaurant != "" {
+		roleValue += "@" + claims.Restaurant
+	}
+	role, restaurant, err := ParseRole(roleValue)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidCredentials, err)
+	}
+	return &Principal{Subject: claims.Subject, Role: role, Restaurant: restaurant}, nil
+}
+
+// 역할을 담은 JWT를 발급합니다 (운영 도구와 세션 발급용)
+func (a *JWTAuthenticator) Issue(principal *Principal, ttl time.Duration) (string, error) {
+	now := time.Now()
+	claims := &Claims{
+		Role:       string(principal.Role),
+		Restaurant: string(principal.Restaurant),
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject:   principal.Subject,
+			Issuer:    a.issuer,
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(now.Add(ttl)),
+		},
+	}
+	return jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString(a.secret)
+}
@@ -0,0 +1,459 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const (
+	SessionCookieName   = "grrrr_session"
+	OIDCStateCookieName = "grrrr_oidc_state"
+
+	oidcStateAudience   = "grrrr-oidc-state"
+	oidcStateTTL        = 10 * time.Minute
+	defaultSessionTTL   = 8 * time.Hour
+	jwksRefreshInterval = time.Minute
+)
+
+// 로그인 시작 후 콜백까지 쿠키에 보관하는 값 (서명된 JWT)
+type oidcStateClaims struct {
+	State    string `json:"state"`
+	Nonce    string `json:"nonce"`
+	Verifier string `json:"verifier"`
+	Redirect string `json:"redirect"`
+	jwt.RegisteredClaims
+}
+
+type OIDCConfig struct {
+	Issuer       string
+	ClientID     string
+	ClientSecret string // 공개 클라이언트(PKCE만 사용)면 비워 둡니다
+	RedirectURL  string
+	Scopes       []string
+	RoleClaim    string            // 역할 판단에 쓸 클레임 (예: groups, roles)
+	RoleMapping  map[string]string // 클레임 값 -> 권한 범위, "*"는 기본값
+	SessionTTL   time.Duration
+}
+
+// OpenID Connect 인가 코드 + PKCE 로그인 클라이언트
+// 로그인에 성공하면 JWTAuthenticator로 세션 토큰을 발급합니다
+type OIDCClient struct {
+	config   OIDCConfig
+	sessions *JWTAuthenticator
+	client   *http.Client
+
+	mu            sync.Mutex
+	discovery     *oidcDiscovery
+	keys          map[string]*rsa.PublicKey
+	keysFetchedAt time.Time
+}
+
+type oidcDiscovery struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	JWKSURI               string `json:"jwks_uri"`
+}
+
+// 로그인 시작 결과: 사용자를 AuthorizationURL로 보내고 StateCookie를 설정합니다
+type OIDCLogin struct {
+	AuthorizationURL string
+	StateCookie      string
+}
+
+// 로그인 완료 결과
+type OIDCSession struct {
+	Principal *Principal
+	Token     string
+	ExpiresAt time.Time
+	Redirect  string
+}
+
+// OIDC_ISSUER가 없으면 nil을 돌려줍니다 (SSO 로그인 비활성화)
+func NewOIDCClientFromEnv(sessions *JWTAuthenticator) (*OIDCClient, error) {
+	issuer := os.Getenv("OIDC_ISSUER")
+	if issuer == "" {
+		return nil, nil
+	}
+	if sessions == nil {
+		return nil, errors.New("JWT_SECRET is required to issue sessions for OIDC login")
+	}
+
+	config := OIDCConfig{
+		Issuer:       strings.TrimRight(issuer, "/"),
+		ClientID:     os.Getenv("OIDC_CLIENT_ID"),
+		ClientSecret: os.Getenv("OIDC_CLIENT_SECRET"),
+		RedirectURL:  os.Getenv("OIDC_REDIRECT_URL"),
+		Scopes:       strings.Fields(os.Getenv("OIDC_SCOPES")),
+		RoleClaim:    os.Getenv("OIDC_ROLE_CLAIM"),
+		RoleMapping:  make(map[string]string),
+		SessionTTL:   defaultSessionTTL,
+	}
+	if config.ClientID == "" || config.RedirectURL == "" {
+		return nil, errors.New("OIDC_CLIENT_ID and OIDC_REDIRECT_URL are required when OIDC_ISSUER is set")
+	}
+	if len(config.Scopes) == 0 {
+		config.Scopes = []string{"openid", "email", "profile"}
+	}
+	if config.RoleClaim == "" {
+		config.RoleClaim = "groups"
+	}
+	// OIDC_ROLE_MAPPING 형식: "클레임값=권한범위" 항목을 쉼표로 구분 (예: "cafeteria-admins=admin,cafe1=staff@RESTAURANT_1,*=read_only")
+	for _, entry := range strings.Split(os.Getenv("OIDC_ROLE_MAPPING"), ",") {
+		value, scope, ok := strings.Cut(strings.TrimSpace(entry), "=")
+		if !ok {
+			continue
+		}
+		if _, _, err := ParseRole(scope); err != nil {
+			return nil, fmt.Errorf("invalid OIDC_ROLE_MAPPING entry %q: %w", entry, err)
+		}
+		config.RoleMapping[strings.TrimSpace(value)] = strings.TrimSpace(scope)
+	}
+	if value := os.Getenv("OIDC_SESSION_TTL"); value != "" {
+		ttl, err := time.ParseDuration(value)
+		if err != nil || ttl <= 0 {
+			return nil, fmt.Errorf("invalid OIDC_SESSION_TTL: %q", value)
+		}
+		config.SessionTTL = ttl
+	}
+	return NewOIDCClient(config, sessions), nil
+}
+
+func NewOIDCClient(config OIDCConfig, sessions *JWTAuthenticator) *OIDCClient {
+	return &OIDCClient{
+		config:   config,
+		sessions: sessions,
+		client:   &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+// 인가 요청 URL과 state/nonce/code_verifier를 담은 쿠키 값을 만듭니다
+func (o *OIDCClient) StartLogin(ctx context.Context, redirect string) (*OIDCLogin, error) {
+	discovery, err := o.getDiscovery(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	state, nonce, verifier := randomToken(), randomToken(), randomToken()
+	challenge := sha256.Sum256([]byte(verifier))
+
+	now := time.Now()
+	stateCookie, err := jwt.NewWithClaims(jwt.SigningMethodHS256, &oidcStateClaims{
+		State:    state,
+		Nonce:    nonce,
+		Verifier: verifier,
+		Redirect: safeRedirect(redirect),
+		RegisteredClaims: jwt.RegisteredClaims{
+			Audience:  jwt.ClaimStrings{oidcStateAudience},
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(now.Add(oidcStateTTL)),
+		},
+	}).SignedString(o.sessions.secret)
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign login state: %w", err)
+	}
+
+	authURL, err := url.Parse(discovery.AuthorizationEndpoint)
+	if err != nil {
+		return nil, fmt.Errorf("invalid authorization endpoint: %w", err)
+	}
+	query := authURL.Query()
+	query.Set("response_type", "code")
+	query.Set("client_id", o.config.ClientID)
+	query.Set("redirect_uri", o.config.RedirectURL)
+	query.Set("scope", strings.Join(o.config.Scopes, " "))
+	query.Set("state", state)
+	query.Set("nonce", nonce)
+	query.Set("code_challenge", base64.RawURLEncoding.EncodeToString(challenge[:]))
+	query.Set("code_challenge_method", "S256")
+	authURL.RawQuery = query.Encode()
+
+	return &OIDCLogin{AuthorizationURL: authURL.String(), StateCookie: stateCookie}, nil
+}
+
+// 콜백에서 state를 확인하고, 인가 코드를 토큰으로 교환한 뒤 ID 토큰을 검증해서 세션을 발급합니다
+func (o *OIDCClient) FinishLogin(ctx context.Context, stateCookie, state, code string) (*OIDCSession, error) {
+	claims := &oidcStateClaims{}
+	_, err := jwt.ParseWithClaims(stateCookie, claims, func(*jwt.Token) (interface{}, error) {
+		return o.sessions.secret, nil
+	}, jwt.WithValidMethods([]string{"HS256"}), jwt.WithAudience(oidcStateAudience), jwt.WithExpirationRequired())
+	if err != nil {
+		return nil, fmt.Errorf("%w: login state expired or invalid", ErrInvalidCredentials)
+	}
+	if state == "" || state != claims.State {
+		return nil, fmt.Errorf("%w: state mismatch", ErrInvalidCredentials)
+	}
+	if code == "" {
+		return nil, fmt.Errorf("%w: authorization code is missing", ErrInvalidCredentials)
+	}
+
+	idToken, err := o.exchangeCode(ctx, code, claims.Verifier)
+	if err != nil {
+		return nil, err
+	}
+	idClaims, err := o.verifyIDToken(ctx, idToken, claims.Nonce)
+	if err != nil {
+		return nil, err
+	}
+
+	principal, err := o.principalFromClaims(idClaims)
+	if err != nil {
+		return nil, err
+	}
+	token, err := o.sessions.Issue(principal, o.config.SessionTTL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to issue session: %w", err)
+	}
+	return &OIDCSession{
+		Principal: principal,
+		Token:     token,
+		ExpiresAt: time.Now().Add(o.config.SessionTTL),
+		Redirect:  claims.Redirect,
+	}, nil
+}
+
+func (o *OIDCClient) exchangeCode(ctx context.Context, code, verifier string) (string, error) {
+	discovery, err := o.getDiscovery(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	form := url.Values{}
+	form.Set("grant_type", "authorization_code")
+	form.Set("code", code)
+	form.Set("redirect_uri", o.config.RedirectURL)
+	form.Set("client_id", o.config.ClientID)
+	form.Set("code_verifier", verifier)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, discovery.TokenEndpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", fmt.Errorf("failed to build token request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+	if o.config.ClientSecret != "" {
+		req.SetBasicAuth(url.QueryEscape(o.config.ClientID), url.QueryEscape(o.config.ClientSecret))
+	}
+
+	var token struct {
+		IDToken string `json:"id_token"`
+	}
+	if err := o.doJSON(req, &token); err != nil {
+		return "", fmt.Errorf("failed to exchange authorization code: %w", err)
+	}
+	if token.IDToken == "" {
+		return "", errors.New("token response does not contain id_token")
+	}
+	return token.IDToken, nil
+}
+
+// ID 토큰 서명(RS256, JWKS)과 issuer, audience, 만료, nonce를 확인합니다
+func (o *OIDCClient) verifyIDToken(ctx context.Context, idToken, nonce string) (jwt.MapClaims, error) {
+	discovery, err := o.getDiscovery(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	claims := jwt.MapClaims{}
+	_, err = jwt.ParseWithClaims(idToken, claims, func(token *jwt.Token) (interface{}, error) {
+		kid, _ := token.Header["kid"].(string)
+		return o.getKey(ctx, kid)
+	}, jwt.WithValidMethods([]string{"RS256"}), jwt.WithIssuer(discovery.Issuer),
+		jwt.WithAudience(o.config.ClientID), jwt.WithExpirationRequired())
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid id_token: %v", ErrInvalidCredentials, err)
+	}
+	if tokenNonce, _ := claims["nonce"].(string); tokenNonce != nonce {
+		return nil, fmt.Errorf("%w: nonce mismatch", ErrInvalidCredentials)
+	}
+	return claims, nil
+}
+
+// 역할 클레임 값을 OIDC_ROLE_MAPPING으로 권한 범위에 대응시킵니다
+func (o *OIDCClient) principalFromClaims(claims jwt.MapClaims) (*Principal, error) {
+	subject, _ := claims["email"].(string)
+	if subject == "" {
+		subject, _ = claims["sub"].(string)
+	}
+
+	var scopes []string
+	for _, value := range claimValues(claims[o.config.RoleClaim]) {
+		if scope, ok := o.config.RoleMapping[value]; ok {
+			scopes = append(scopes, scope)
+		}
+	}
+	if len(scopes) == 0 {
+		if scope, ok := o.config.RoleMapping["*"]; ok {
+			scopes = append(scopes, scope)
+		}
+	}
+	if len(scopes) == 0 {
+		return nil, fmt.Errorf("%w: no role is mapped for %s", ErrInvalidCredentials, subject)
+	}
+
+	principal, err := PrincipalFromScopes(subject, scopes)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidCredentials, err)
+	}
+	return principal, nil
+}
+
+// 클레임은 문자열 하나이거나 문자열 배열일 수 있습니다
+func claimValues(value interface{}) []string {
+	switch v := value.(type) {
+	case string:
+		return strings.Fields(v)
+	case []interface{}:
+		var values []string
+		for _, item := range v {
+			if s, ok := item.(string); ok {
+				values = append(values, s)
+			}
+		}
+		return values
+	}
+	return nil
+}
+
+func (o *OIDCClient) getDiscovery(ctx context.Context) (*oidcDiscovery, error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	if o.discovery != nil {
+		return o.discovery, nil
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, o.config.Issuer+"/.well-known/openid-configuration", nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build discovery request: %w", err)
+	}
+	discovery := &oidcDiscovery{}
+	if err := o.doJSON(req, discovery); err != nil {
+		return nil, fmt.Errorf("failed to load OIDC discovery document: %w", err)
+	}
+	if strings.TrimRight(discovery.Issuer, "/") != o.config.Issuer {
+		return nil, fmt.Errorf("OIDC issuer mismatch: expected %s, got %s", o.config.Issuer, discovery.Issuer)
+	}
+	if discovery.AuthorizationEndpoint == "" || discovery.TokenEndpoint == "" || discovery.JWKSURI == "" {
+		return nil, errors.New("OIDC discovery document is missing endpoints")
+	}
+	o.discovery = discovery
+	return discovery, nil
+}
+
+// kid에 해당하는 서명 키 (모르는 kid면 키 교체로 보고 JWKS를 다시 받습니다)
+func (o *OIDCClient) getKey(ctx context.Context, kid string) (*rsa.PublicKey, error) {
+	o.mu.Lock()
+	key, ok := o.keys[kid]
+	stale := time.Since(o.keysFetchedAt) > jwksRefreshInterval
+	o.mu.Unlock()
+	if ok {
+		return key, nil
+	}
+	if !stale {
+		return nil, fmt.Errorf("unknown signing key: %q", kid)
+	}
+
+	discovery, err := o.getDiscovery(ctx)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, discovery.JWKSURI, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build JWKS request: %w", err)
+	}
+	var jwks struct {
+		Keys []struct {
+			Kty string `json:"kty"`
+			Kid string `json:"kid"`
+			Use string `json:"use"`
+			N   string `json:"n"`
+			E   string `json:"e"`
+		} `json:"keys"`
+	}
+	if err := o.doJSON(req, &jwks); err != nil {
+		return nil, fmt.Errorf("failed to load JWKS: %w", err)
+	}
+
+	keys := make(map[string]*rsa.PublicKey)
+	for _, jwk := range jwks.Keys {
+		if jwk.Kty != "RSA" || (jwk.Use != "" && jwk.Use != "sig") {
+			continue
+		}
+		n, errN := base64.RawURLEncoding.DecodeString(jwk.N)
+		e, errE := base64.RawURLEncoding.DecodeString(jwk.E)
+		if errN != nil || errE != nil || len(e) > 4 {
+			continue
+		}
+		keys[jwk.Kid] = &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(new(big.Int).SetBytes(e).Int64())}
+	}
+
+	o.mu.Lock()
+	o.keys = keys
+	o.keysFetchedAt = time.Now()
+	o.mu.Unlock()
+
+	if key, ok := keys[kid]; ok {
+		return key, nil
+	}
+	// kid가 없는 토큰은 키가 하나뿐일 때만 허용합니다
+	if kid == "" && len(keys) == 1 {
+		for _, key := range keys {
+			return key, nil
+		}
+	}
+	return nil, fmt.Errorf("unknown signing key: %q", kid)
+}
+
+func (o *OIDCClient) doJSON(req *http.Request, out interface{}) error {
+	resp, err := o.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode/100 != 2 {
+		return fmt.Errorf("%s responded with status %d: %.512s", req.URL.Host, resp.StatusCode, body)
+	}
+	return json.Unmarshal(body, out)
+}
+
+func randomToken() string {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		panic(fmt.Sprintf("crypto/rand failed: %v", err))
+	}
+	return base64.RawURLEncoding.EncodeToString(buf)
+}
+
+// 로그인 후 돌아갈 주소는 같은 사이트의 경로만 허용합니다 (open redirect 방지)
+func safeRedirect(redirect string) string {
+	if !strings.HasPrefix(redirect, "/") || strings.HasPrefix(redirect, "//") || strings.Contains(redirect, "\\") {
+		return "/"
+	}
+	return redirect
+}
+
+// 리다이렉트 주소가 https면 쿠키에 Secure 속성을 붙입니다
+func (o *OIDCClient) SecureCookies() bool {
+	return strings.HasPrefix(o.config.RedirectURL, "https://")
+}
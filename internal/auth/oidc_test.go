@@ -0,0 +1,320 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/School-meal-lover/backend/internal/models"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const (
+	testClientID    = "grrrr-admin"
+	testRedirectURL = "http://localhost:8080/api/v1/auth/oidc/callback"
+)
+
+// discovery, JWKS, 토큰 엔드포인트를 제공하는 테스트용 OIDC 제공자
+type mockProvider struct {
+	server *httptest.Server
+	key    *rsa.PrivateKey
+
+	mu    sync.Mutex
+	codes map[string]*mockGrant
+	// 다음에 발급하는 ID 토큰의 클레임을 바꿉니다
+	modifyClaims func(claims jwt.MapClaims)
+	pkceFailures int
+}
+
+// 인가 요청에서 받은 값 (토큰 교환 때 확인)
+type mockGrant struct {
+	nonce     string
+	challenge string
+	claims    jwt.MapClaims
+}
+
+func newMockProvider(t *testing.T) *mockProvider {
+	t.Helper()
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	p := &mockProvider{key: key, codes: map[string]*mockGrant{}}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]string{
+			"issuer":                 p.server.URL,
+			"authorization_endpoint": p.server.URL + "/authorize",
+			"token_endpoint":         p.server.URL + "/token",
+			"jwks_uri":               p.server.URL + "/jwks",
+		})
+	})
+	mux.HandleFunc("/jwks", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"keys": []map[string]string{{
+				"kty": "RSA",
+				"kid": "test-key",
+				"use": "sig",
+				"n":   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+				"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+			}},
+		})
+	})
+	mux.HandleFunc("/token", p.handleToken)
+	p.server = httptest.NewServer(mux)
+	t.Cleanup(p.server.Close)
+	return p
+}
+
+// 사용자가 로그인을 마친 것처럼 인가 코드를 발급합니다
+func (p *mockProvider) authorize(t *testing.T, authorizationURL string, claims jwt.MapClaims) (state, code string) {
+	t.Helper()
+	parsed, err := url.Parse(authorizationURL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	query := parsed.Query()
+	if query.Get("code_challenge_method") != "S256" || query.Get("client_id") != testClientID || query.Get("redirect_uri") != testRedirectURL {
+		t.Fatalf("unexpected authorization request: %s", authorizationURL)
+	}
+
+	code = randomToken()
+	p.mu.Lock()
+	p.codes[code] = &mockGrant{nonce: query.Get("nonce"), challenge: query.Get("code_challenge"), claims: claims}
+	p.mu.Unlock()
+	return query.Get("state"), code
+}
+
+func (p *mockProvider) handleToken(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil || r.Form.Get("grant_type") != "authorization_code" {
+		http.Error(w, `{"error":"invalid_request"}`, http.StatusBadRequest)
+		return
+	}
+	p.mu.Lock()
+	grant, ok := p.codes[r.Form.Get("code")]
+	delete(p.codes, r.Form.Get("code"))
+	modify := p.modifyClaims
+	p.mu.Unlock()
+	if !ok {
+		http.Error(w, `{"error":"invalid_grant"}`, http.StatusBadRequest)
+		return
+	}
+
+	verifier := sha256.Sum256([]byte(r.Form.Get("code_verifier")))
+	if base64.RawURLEncoding.EncodeToString(verifier[:]) != grant.challenge {
+		p.mu.Lock()
+		p.pkceFailures++
+		p.mu.Unlock()
+		http.Error(w, `{"error":"invalid_grant","error_description":"PKCE verification failed"}`, http.StatusBadRequest)
+		return
+	}
+
+	now := time.Now()
+	claims := jwt.MapClaims{
+		"iss":   p.server.URL,
+		"aud":   testClientID,
+		"sub":   "user-1",
+		"nonce": grant.nonce,
+		"iat":   now.Unix(),
+		"exp":   now.Add(time.Hour).Unix(),
+	}
+	for name, value := range grant.claims {
+		claims[name] = value
+	}
+	if modify != nil {
+		modify(claims)
+	}
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	token.Header["kid"] = "test-key"
+	idToken, err := token.SignedString(p.key)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	json.NewEncoder(w).Encode(map[string]string{"access_token": "access", "token_type": "Bearer", "id_token": idToken})
+}
+
+func newTestOIDCClient(p *mockProvider) (*OIDCClient, *JWTAuthenticator) {
+	sessions := NewJWTAuthenticator("session-secret", "grrrr")
+	client := NewOIDCClient(OIDCConfig{
+		Issuer:      p.server.URL,
+		ClientID:    testClientID,
+		RedirectURL: testRedirectURL,
+		Scopes:      []string{"openid", "email"},
+		RoleClaim:   "groups",
+		RoleMapping: map[string]string{"cafeteria-admins": "admin", "cafe1-staff": "staff@RESTAURANT_1"},
+		SessionTTL:  time.Hour,
+	}, sessions)
+	return client, sessions
+}
+
+var staffClaims = jwt.MapClaims{"email": "cook@example.ac.kr", "groups": []string{"cafe1-staff"}}
+
+func TestOIDCLogin(t *testing.T) {
+	provider := newMockProvider(t)
+	client, sessions := newTestOIDCClient(provider)
+	ctx := context.Background()
+
+	login, err := client.StartLogin(ctx, "/admin/weeks")
+	if err != nil {
+		t.Fatal(err)
+	}
+	state, code := provider.authorize(t, login.AuthorizationURL, staffClaims)
+
+	session, err := client.FinishLogin(ctx, login.StateCookie, state, code)
+	if err != nil {
+		t.Fatalf("FinishLogin: %v", err)
+	}
+	if session.Principal.Subject != "cook@example.ac.kr" || session.Principal.Role != RoleStaff || session.Principal.Restaurant != models.Restaurant1 {
+		t.Errorf("principal = %+v, want staff@RESTAURANT_1 cook@example.ac.kr", session.Principal)
+	}
+	if session.Redirect != "/admin/weeks" {
+		t.Errorf("redirect = %q, want /admin/weeks", session.Redirect)
+	}
+
+	// 발급한 세션 토큰은 JWT 인증으로 확인됩니다
+	principal, err := sessions.Authenticate(ctx, session.Token)
+	if err != nil || principal.Role != RoleStaff || principal.Restaurant != models.Restaurant1 {
+		t.Errorf("session token authenticates as %+v, %v", principal, err)
+	}
+}
+
+func TestOIDCStateMismatch(t *testing.T) {
+	provider := newMockProvider(t)
+	client, _ := newTestOIDCClient(provider)
+	ctx := context.Background()
+
+	login, err := client.StartLogin(ctx, "/")
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, code := provider.authorize(t, login.AuthorizationURL, staffClaims)
+
+	if _, err := client.FinishLogin(ctx, login.StateCookie, "forged-state", code); !errors.Is(err, ErrInvalidCredentials) {
+		t.Errorf("forged state: err = %v, want ErrInvalidCredentials", err)
+	}
+	if _, err := client.FinishLogin(ctx, "not-a-state-cookie", "forged-state", code); !errors.Is(err, ErrInvalidCredentials) {
+		t.Errorf("invalid state cookie: err = %v, want ErrInvalidCredentials", err)
+	}
+}
+
+func TestOIDCPKCEMismatch(t *testing.T) {
+	provider := newMockProvider(t)
+	client, _ := newTestOIDCClient(provider)
+	ctx := context.Background()
+
+	// 다른 로그인에서 발급된 인가 코드는 code_verifier가 맞지 않아 교환할 수 없습니다
+	first, err := client.StartLogin(ctx, "/")
+	if err != nil {
+		t.Fatal(err)
+	}
+	second, err := client.StartLogin(ctx, "/")
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, code := provider.authorize(t, first.AuthorizationURL, staffClaims)
+	secondState, _ := provider.authorize(t, second.AuthorizationURL, staffClaims)
+
+	if _, err := client.FinishLogin(ctx, second.StateCookie, secondState, code); err == nil {
+		t.Fatal("FinishLogin succeeded with a code issued for another login")
+	}
+	provider.mu.Lock()
+	defer provider.mu.Unlock()
+	if provider.pkceFailures != 1 {
+		t.Errorf("PKCE failures = %d, want 1", provider.pkceFailures)
+	}
+}
+
+func TestOIDCNonceMismatch(t *testing.T) {
+	provider := newMockProvider(t)
+	client, _ := newTestOIDCClient(provider)
+	ctx := context.Background()
+	provider.modifyClaims = func(claims jwt.MapClaims) { claims["nonce"] = "replayed-nonce" }
+
+	login, err := client.StartLogin(ctx, "/")
+	if err != nil {
+		t.Fatal(err)
+	}
+	state, code := provider.authorize(t, login.AuthorizationURL, staffClaims)
+
+	if _, err := client.FinishLogin(ctx, login.StateCookie, state, code); !errors.Is(err, ErrInvalidCredentials) {
+		t.Errorf("err = %v, want ErrInvalidCredentials", err)
+	}
+}
+
+func TestOIDCInvalidIDToken(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(claims jwt.MapClaims)
+	}{
+		{"expired", func(claims jwt.MapClaims) {
+			claims["iat"] = time.Now().Add(-2 * time.Hour).Unix()
+			claims["exp"] = time.Now().Add(-time.Hour).Unix()
+		}},
+		{"missing exp", func(claims jwt.MapClaims) { delete(claims, "exp") }},
+		{"wrong audience", func(claims jwt.MapClaims) { claims["aud"] = "another-client" }},
+		{"wrong issuer", func(claims jwt.MapClaims) { claims["iss"] = "https://evil.example.com" }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			provider := newMockProvider(t)
+			client, _ := newTestOIDCClient(provider)
+			ctx := context.Background()
+			provider.modifyClaims = tt.modify
+
+			login, err := client.StartLogin(ctx, "/")
+			if err != nil {
+				t.Fatal(err)
+			}
+			state, code := provider.authorize(t, login.AuthorizationURL, staffClaims)
+
+			if _, err := client.FinishLogin(ctx, login.StateCookie, state, code); !errors.Is(err, ErrInvalidCredentials) {
+				t.Errorf("err = %v, want ErrInvalidCredentials", err)
+			}
+		})
+	}
+}
+
+func TestOIDCUnmappedRole(t *testing.T) {
+	provider := newMockProvider(t)
+	client, _ := newTestOIDCClient(provider)
+	ctx := context.Background()
+
+	login, err := client.StartLogin(ctx, "/")
+	if err != nil {
+		t.Fatal(err)
+	}
+	state, code := provider.authorize(t, login.AuthorizationURL, jwt.MapClaims{"email": "guest@example.ac.kr", "groups": []string{"students"}})
+
+	if _, err := client.FinishLogin(ctx, login.StateCookie, state, code); !errors.Is(err, ErrInvalidCredentials) {
+		t.Errorf("err = %v, want ErrInvalidCredentials", err)
+	}
+}
+
+func TestSafeRedirect(t *testing.T) {
+	tests := map[string]string{
+		"/admin":             "/admin",
+		"":                   "/",
+		"https://evil.com":   "/",
+		"//evil.com":         "/",
+		"/\\evil.com":        "/",
+		"/weeks?status=open": "/weeks?status=open",
+	}
+	for redirect, want := range tests {
+		if got := safeRedirect(redirect); got != want {
+			t.Errorf("safeRedirect(%q) = %q, want %q", redirect, got, want)
+		}
+	}
+}
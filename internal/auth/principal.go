@@ -0,0 +1,88 @@
+// auth는 API 키와 JWT로 요청한 주체(Principal)와 역할을 확인합니다.
+package auth
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/School-meal-lover/backend/internal/models"
+)
+
+type Role string
+
+const (
+	RoleAdmin    Role = "admin"     // 모든 식당의 업로드와 관리 기능
+	RoleApprover Role = "approver"  // 식단 검토와 게시 (담당 식당을 지정하지 않으면 모든 식당)
+	RoleStaff    Role = "staff"     // 담당 식당의 업로드만
+	RoleReadOnly Role = "read_only" // 조회만
+)
+
+// 인증된 요청 주체
+type Principal struct {
+	Subject    string
+	Role       Role
+	Restaurant models.RestaurantType // staff, approver의 담당 식당
+}
+
+// 해당 식당의 식단과 이미지를 올릴 수 있는지
+func (p *Principal) CanManageRestaurant(restaurant models.RestaurantType) bool {
+	switch p.Role {
+	case RoleAdmin:
+		return true
+	case RoleApprover:
+		return p.Restaurant == "" || p.Restaurant == restaurant
+	case RoleStaff:
+		return p.Restaurant != "" && p.Restaurant == restaurant
+	}
+	return false
+}
+
+// 해당 식당의 식단을 게시하거나 내릴 수 있는지
+func (p *Principal) CanApproveRestaurant(restaurant models.RestaurantType) bool {
+	switch p.Role {
+	case RoleAdmin:
+		return true
+	case RoleApprover:
+		return p.Restaurant == "" || p.Restaurant == restaurant
+	}
+	return false
+}
+
+func (p *Principal) HasRole(roles ...Role) bool {
+	for _, role := range roles {
+		if p.Role == role {
+			return true
+		}
+	}
+	return false
+}
+
+// "admin", "read_only", "staff@RESTAURANT_1", "approver", "approver@RESTAURANT_1" 형식의 역할 문자열을 해석합니다
+func ParseRole(value string) (Role, models.RestaurantType, error) {
+	roleName, restaurantName, hasRestaurant := strings.Cut(strings.TrimSpace(value), "@")
+	role := Role(strings.ToLower(roleName))
+
+	switch role {
+	case RoleAdmin, RoleReadOnly:
+		if hasRestaurant {
+			return "", "", fmt.Errorf("role %s cannot be bound to a restaurant", role)
+		}
+		return role, "", nil
+	case RoleApprover:
+		if !hasRestaurant {
+			return role, "", nil
+		}
+		restaurant, ok := models.ParseRestaurantType(restaurantName)
+		if !ok {
+			return "", "", fmt.Errorf("unknown restaurant for approver role: %q", value)
+		}
+		return role, restaurant, nil
+	case RoleStaff:
+		restaurant, ok := models.ParseRestaurantType(restaurantName)
+		if !ok {
+			return "", "", fmt.Errorf("staff role requires a restaurant (staff@RESTAURANT_1): %q", value)
+		}
+		return role, restaurant, nil
+	}
+	return "", "", fmt.Errorf("unknown role: %q", value)
+}
@@ -0,0 +1,64 @@
+package auth
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/subtle"
+	"fmt"
+	"strings"
+)
+
+type staticKey struct {
+	hash      [sha256.Size]byte
+	principal Principal
+}
+
+// 환경변수로 설정한 고정 API 키 목록
+type StaticKeyAuthenticator struct {
+	keys []staticKey
+}
+
+// API_KEYS 형식: "이름:역할:키" 항목을 쉼표로 구분 (예: "ops:admin:s3cr3t,cafe1:staff@RESTAURANT_1:k3y")
+func NewStaticKeyAuthenticator(apiKeys string) (*StaticKeyAuthenticator, error) {
+	authenticator := &StaticKeyAuthenticator{}
+	for _, entry := range strings.Split(apiKeys, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		parts := strings.SplitN(entry, ":", 3)
+		if len(parts) != 3 || parts[0] == "" || parts[2] == "" {
+			return nil, fmt.Errorf("invalid API_KEYS entry (expected name:role:key): %q", parts[0])
+		}
+		role, restaurant, err := ParseRole(parts[1])
+		if err != nil {
+			return nil, fmt.Errorf("invalid API_KEYS entry %q: %w", parts[0], err)
+		}
+		authenticator.Add(parts[2], Principal{Subject: parts[0], Role: role, Restaurant: restaurant})
+	}
+	return authenticator, nil
+}
+
+func (a *StaticKeyAuthenticator) Add(key string, principal Principal) {
+	a.keys = append(a.keys, staticKey{hash: sha256.Sum256([]byte(key)), principal: principal})
+}
+
+func (a *StaticKeyAuthenticator) Len() int {
+	return len(a.keys)
+}
+
+// 해시끼리 상수 시간으로 비교해서 키 길이나 내용이 응답 시간으로 드러나지 않게 합니다
+func (a *StaticKeyAuthenticator) Authenticate(ctx context.Context, token string) (*Principal, error) {
+	hash := sha256.Sum256([]byte(token))
+	var matched *Principal
+	for i := range a.keys {
+		if subtle.ConstantTimeCompare(hash[:], a.keys[i].hash[:]) == 1 && matched == nil {
+			principal := a.keys[i].principal
+			matched = &principal
+		}
+	}
+	if matched == nil {
+		return nil, ErrInvalidCredentials
+	}
+	return matched, nil
+}
@@ -0,0 +1,145 @@
+package category
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/School-meal-lover/backend/internal/models"
+)
+
+// 분류를 정한 근거
+const (
+	SourceRule     = "rule"
+	SourceHistory  = "history"
+	SourcePosition = "position"
+)
+
+// 규칙 종류별 확신도
+const (
+	exactConfidence    = 1.0
+	suffixConfidence   = 0.9
+	containsConfidence = 0.75
+)
+
+// 위치 범위를 넘은 메뉴의 분류
+const Other = "기타"
+
+// 분류 결과 (위치로 정한 경우 확신도는 0)
+type Result struct {
+	Category   string
+	Confidence float64
+	Source     string
+}
+
+// 메뉴 이름별로 지금까지 쓰인 분류와 횟수
+type History map[string]map[string]int
+
+type Classifier struct {
+	fallback *RuleSet
+	rules    map[models.RestaurantType]*RuleSet
+}
+
+func NewClassifier(fallback *RuleSet, rules map[models.RestaurantType]*RuleSet) *Classifier {
+	if rules == nil {
+		rules = map[models.RestaurantType]*RuleSet{}
+	}
+	return &Classifier{fallback: fallback, rules: rules}
+}
+
+func (c *Classifier) ruleSet(restaurant models.RestaurantType) *RuleSet {
+	if set, ok := c.rules[restaurant]; ok {
+		return set
+	}
+	return c.fallback
+}
+
+// 이름 규칙과 이력 중 확신도가 높은 쪽으로 정하고, 둘 다 확신도가 낮으면 식사 안의 위치(0부터)로 정합니다
+func (c *Classifier) Classify(restaurant models.RestaurantType, mealType string, position int, name string, history History) Result {
+	set := c.ruleSet(restaurant)
+
+	best := set.match(Normalize(name))
+	if fromHistory := history.lookup(name); fromHistory.Confidence > best.Confidence {
+		best = fromHistory
+	}
+	if best.Category != "" && best.Confidence >= set.MinConfidence {
+		return best
+	}
+	return Result{Category: set.position(mealType, position), Source: SourcePosition}
+}
+
+var bracketPattern = regexp.MustCompile(`\([^)]*\)|（[^）]*）|\[[^\]]*\]|<[^>]*>`)
+
+// 규칙 비교용 이름: 괄호 안 설명(원산지 등), 공백, 표시 기호 제거
+func Normalize(name string) string {
+	name = bracketPattern.ReplaceAllString(name, "")
+	name = strings.Trim(name, "*★☆#")
+	return strings.Join(strings.Fields(name), "")
+}
+
+func (set *RuleSet) match(name string) Result {
+	var best Result
+	bestLength := 0
+	consider := func(category string, confidence float64, keyword string) {
+		length := len([]rune(keyword))
+		// 확신도가 같으면 긴 키워드, 그것도 같으면 먼저 나온 규칙
+		if confidence > best.Confidence || (confidence == best.Confidence && length > bestLength) {
+			best = Result{Category: category, Confidence: confidence, Source: SourceRule}
+			bestLength = length
+		}
+	}
+	if name == "" {
+		return best
+	}
+
+	for _, rule := range set.Rules {
+		for _, keyword := range rule.Exact {
+			if name == keyword {
+				consider(rule.Category, exactConfidence, keyword)
+			}
+		}
+		for _, keyword := range rule.Suffixes {
+			if strings.HasSuffix(name, keyword) {
+				consider(rule.Category, suffixConfidence, keyword)
+			}
+		}
+		for _, keyword := range rule.Contains {
+			if strings.Contains(name, keyword) {
+				consider(rule.Category, containsConfidence, keyword)
+			}
+		}
+	}
+	return best
+}
+
+// 가장 많이 쓰인 분류의 비율에 횟수가 적을수록 낮아지는 가중치(n/(n+1))를 곱합니다
+func (h History) lookup(name string) Result {
+	counts := h[strings.TrimSpace(name)]
+	total := 0
+	var top string
+	for category, count := range counts {
+		total += count
+		if count > counts[top] || (count == counts[top] && category < top) {
+			top = category
+		}
+	}
+	if total == 0 {
+		return Result{}
+	}
+	share := float64(counts[top]) / float64(total)
+	return Result{
+		Category:   top,
+		Confidence: share * float64(total) / float64(total+1),
+		Source:     SourceHistory,
+	}
+}
+
+func (set *RuleSet) position(mealType string, position int) string {
+	categories, ok := set.Positions[mealType]
+	if !ok {
+		categories = set.Positions["default"]
+	}
+	if position >= 0 && position < len(categories) {
+		return categories[position]
+	}
+	return Other
+}
@@ -0,0 +1,178 @@
+package category
+
+import (
+	"math"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/School-meal-lover/backend/internal/models"
+)
+
+func testRuleSet() *RuleSet {
+	return &RuleSet{
+		MinConfidence: 0.6,
+		Rules: []Rule{
+			{Category: "메인메뉴", Suffixes: []string{"볶음밥", "돈까스"}},
+			{Category: "밥", Exact: []string{"밥", "쌀밥"}, Suffixes: []string{"밥"}},
+			{Category: "국", Suffixes: []string{"국", "찌개"}},
+			{Category: "반찬", Suffixes: []string{"김치"}, Contains: []string{"김치"}},
+		},
+		Positions: map[string][]string{
+			"Breakfast": {"밥", "국", "반찬"},
+			"default":   {"밥", "국", "메인메뉴"},
+		},
+	}
+}
+
+func TestClassify(t *testing.T) {
+	tests := []struct {
+		name     string
+		mealType string
+		position int
+		history  History
+		want     Result
+	}{
+		// exact > suffix
+		{name: "쌀밥", want: Result{"밥", exactConfidence, SourceRule}},
+		// 같은 종류끼리는 긴 키워드 (볶음밥 > 밥), suffix > contains (김치)
+		{name: "김치볶음밥", want: Result{"메인메뉴", suffixConfidence, SourceRule}},
+		{name: "김치찌개", want: Result{"국", suffixConfidence, SourceRule}},
+		{name: "배추김치", want: Result{"반찬", suffixConfidence, SourceRule}},
+		{name: "김치전", want: Result{"반찬", containsConfidence, SourceRule}},
+		// 괄호 안 설명과 표시 기호는 비교하지 않습니다
+		{name: " 돈까스 (돼지고기:국내산) *", want: Result{"메인메뉴", suffixConfidence, SourceRule}},
+		// 규칙이 없으면 위치로 정합니다
+		{name: "오므라이스", mealType: "Lunch_2", position: 2, want: Result{"메인메뉴", 0, SourcePosition}},
+		{name: "과일", mealType: "Breakfast", position: 1, want: Result{"국", 0, SourcePosition}},
+		{name: "과일", mealType: "Breakfast", position: 3, want: Result{Other, 0, SourcePosition}},
+		// 이력: 3번 → 3/4
+		{name: "오므라이스", history: History{"오므라이스": {"메인메뉴": 3}}, want: Result{"메인메뉴", 0.75, SourceHistory}},
+		// 이력이 한 번뿐이면 1/2로 최소 확신도보다 낮습니다
+		{name: "오므라이스", position: 1, history: History{"오므라이스": {"메인메뉴": 1}}, want: Result{"국", 0, SourcePosition}},
+		// 이력이 규칙보다 확신도가 높으면 이력, 같으면 규칙
+		{name: "김치전", history: History{"김치전": {"메인메뉴": 9}}, want: Result{"메인메뉴", 0.9, SourceHistory}},
+		{name: "김치찌개", history: History{"김치찌개": {"반찬": 9}}, want: Result{"국", suffixConfidence, SourceRule}},
+	}
+	classifier := NewClassifier(testRuleSet(), nil)
+	for _, tt := range tests {
+		mealType := tt.mealType
+		if mealType == "" {
+			mealType = "Lunch_2"
+		}
+		got := classifier.Classify(models.Restaurant1, mealType, tt.position, tt.name, tt.history)
+		if got.Category != tt.want.Category || got.Source != tt.want.Source || math.Abs(got.Confidence-tt.want.Confidence) > 1e-9 {
+			t.Errorf("Classify(%s, %d, %q) = %+v, want %+v", mealType, tt.position, tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestClassifyMinConfidence(t *testing.T) {
+	set := testRuleSet()
+	set.MinConfidence = 0.8
+	classifier := NewClassifier(set, nil)
+
+	// contains(0.75)와 3번 쓴 이력(0.75)은 위치로, suffix(0.9)와 4번 쓴 이력(0.8)은 그대로 씁니다
+	if got := classifier.Classify(models.Restaurant1, "Breakfast", 2, "김치전", nil); got != (Result{"반찬", 0, SourcePosition}) {
+		t.Errorf("contains match = %+v, want the position", got)
+	}
+	if got := classifier.Classify(models.Restaurant1, "Breakfast", 0, "김치볶음밥", nil); got.Source != SourceRule {
+		t.Errorf("suffix match = %+v, want the rule", got)
+	}
+	history := History{"누룽지": {"밥": 3}}
+	if got := classifier.Classify(models.Restaurant1, "Breakfast", 1, "누룽지", history); got != (Result{"국", 0, SourcePosition}) {
+		t.Errorf("history 0.75 = %+v, want the position", got)
+	}
+	history["누룽지"]["밥"] = 4
+	if got := classifier.Classify(models.Restaurant1, "Breakfast", 1, "누룽지", history); got.Category != "밥" || got.Source != SourceHistory {
+		t.Errorf("history 0.8 = %+v, want the history", got)
+	}
+}
+
+func TestHistoryLookup(t *testing.T) {
+	tests := []struct {
+		counts   map[string]int
+		category string
+		// 가장 많이 쓰인 분류의 비율 × n/(n+1)
+		confidence float64
+	}{
+		{map[string]int{"밥": 1}, "밥", 1.0 / 2},
+		{map[string]int{"밥": 9}, "밥", 9.0 / 10},
+		{map[string]int{"밥": 3, "국": 1}, "밥", 3.0 / 4 * 4 / 5},
+		// 횟수가 같으면 이름 순
+		{map[string]int{"반찬": 2, "국": 2}, "국", 1.0 / 2 * 4 / 5},
+		{map[string]int{}, "", 0},
+	}
+	for _, tt := range tests {
+		got := History{"누룽지": tt.counts}.lookup(" 누룽지 ")
+		if got.Category != tt.category || math.Abs(got.Confidence-tt.confidence) > 1e-9 {
+			t.Errorf("lookup(%v) = %+v, want %s %v", tt.counts, got, tt.category, tt.confidence)
+		}
+	}
+}
+
+func TestClassifyRestaurantRules(t *testing.T) {
+	fallback := testRuleSet()
+	restaurant := &RuleSet{
+		Rules:     []Rule{{Category: "특식", Suffixes: []string{"돈까스"}}},
+		Positions: map[string][]string{"Lunch_2": {"특식"}},
+	}
+	classifier := NewClassifier(fallback, map[models.RestaurantType]*RuleSet{models.Restaurant1: restaurant.extend(fallback)})
+
+	tests := []struct {
+		restaurant models.RestaurantType
+		mealType   string
+		name       string
+		want       string
+	}{
+		// 식당 규칙이 기본 규칙보다 먼저입니다 (확신도와 키워드 길이가 같으면 먼저 나온 규칙)
+		{models.Restaurant1, "Lunch_2", "돈까스", "특식"},
+		{models.Restaurant2, "Lunch_2", "돈까스", "메인메뉴"},
+		// 기본 규칙도 그대로 씁니다
+		{models.Restaurant1, "Lunch_2", "쌀밥", "밥"},
+		// 위치는 지정한 식사만 덮어씁니다
+		{models.Restaurant1, "Lunch_2", "오므라이스", "특식"},
+		{models.Restaurant1, "Breakfast", "오므라이스", "밥"},
+		{models.Restaurant2, "Lunch_2", "오므라이스", "밥"},
+	}
+	for _, tt := range tests {
+		if got := classifier.Classify(tt.restaurant, tt.mealType, 0, tt.name, nil); got.Category != tt.want {
+			t.Errorf("Classify(%s, %s, %q) = %+v, want %s", tt.restaurant, tt.mealType, tt.name, got, tt.want)
+		}
+	}
+	// 식당 규칙에 최소 확신도가 없으면 기본 규칙의 값을 씁니다
+	if set := classifier.ruleSet(models.Restaurant1); set.MinConfidence != fallback.MinConfidence {
+		t.Errorf("min confidence = %v, want %v", set.MinConfidence, fallback.MinConfidence)
+	}
+}
+
+func TestNewClassifierFromEnv(t *testing.T) {
+	dir := t.TempDir()
+	rules := "min_confidence: 0.5\nrules:\n  - category: 특식\n    exact: [오믈렛]\n"
+	if err := os.WriteFile(filepath.Join(dir, "RESTAURANT_2.yaml"), []byte(rules), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("CATEGORY_RULES_DIR", dir)
+
+	classifier, err := NewClassifierFromEnv()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := classifier.Classify(models.Restaurant2, "Lunch", 5, "오믈렛", nil); got.Category != "특식" {
+		t.Errorf("restaurant 2 = %+v, want 특식", got)
+	}
+	if got := classifier.Classify(models.Restaurant1, "Lunch_2", 0, "오믈렛", nil); got.Source != SourcePosition {
+		t.Errorf("restaurant 1 = %+v, want the position", got)
+	}
+	// 내장 기본 규칙
+	if got := classifier.Classify(models.Restaurant2, "Lunch", 5, "김치볶음밥", nil); got.Category != "메인메뉴" {
+		t.Errorf("default rules = %+v, want 메인메뉴", got)
+	}
+
+	if err := os.WriteFile(filepath.Join(dir, "RESTAURANT_1.yaml"), []byte("rules:\n  - exact: [밥]\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := NewClassifierFromEnv(); err == nil {
+		t.Error("rule without category succeeded, want error")
+	}
+}
@@ -0,0 +1,109 @@
+package category
+
+import (
+	"embed"
+	"errors"
+	"fmt"
+	"io/fs"
+	"log"
+	"os"
+
+	"github.com/School-meal-lover/backend/internal/models"
+	"gopkg.in/yaml.v3"
+)
+
+//go:embed rules/*.yaml
+var embeddedRules embed.FS
+
+const defaultRuleFile = "default.yaml"
+
+// 분류 규칙 파일 (rules/default.yaml 참고)
+type RuleSet struct {
+	MinConfidence float64             `yaml:"min_confidence"`
+	Rules         []Rule              `yaml:"rules"`
+	Positions     map[string][]string `yaml:"positions"`
+}
+
+type Rule struct {
+	Category string   `yaml:"category"`
+	Exact    []string `yaml:"exact"`
+	Suffixes []string `yaml:"suffixes"`
+	Contains []string `yaml:"contains"`
+}
+
+// 식당별 규칙은 기본 규칙 앞에 붙이고, 지정한 위치 분류와 최소 확신도만 덮어씁니다
+func (set *RuleSet) extend(base *RuleSet) *RuleSet {
+	merged := &RuleSet{
+		MinConfidence: base.MinConfidence,
+		Rules:         append(append([]Rule{}, set.Rules...), base.Rules...),
+		Positions:     map[string][]string{},
+	}
+	if set.MinConfidence > 0 {
+		merged.MinConfidence = set.MinConfidence
+	}
+	for mealType, categories := range base.Positions {
+		merged.Positions[mealType] = categories
+	}
+	for mealType, categories := range set.Positions {
+		merged.Positions[mealType] = categories
+	}
+	return merged
+}
+
+// 내장 규칙을 읽고, CATEGORY_RULES_DIR이 있으면 그 폴더의 같은 이름 파일로 바꿉니다
+// (default.yaml은 기본 규칙, RESTAURANT_1.yaml 등은 식당별 규칙)
+func NewClassifierFromEnv() (*Classifier, error) {
+	builtin, err := fs.Sub(embeddedRules, "rules")
+	if err != nil {
+		return nil, err
+	}
+	sources := []fs.FS{builtin}
+	if dir := os.Getenv("CATEGORY_RULES_DIR"); dir != "" {
+		log.Printf("Loading category rules from %s", dir)
+		sources = append(sources, os.DirFS(dir))
+	}
+
+	fallback, err := loadRuleSet(sources, defaultRuleFile)
+	if err != nil {
+		return nil, err
+	}
+	if fallback == nil {
+		return nil, fmt.Errorf("missing category rule file %s", defaultRuleFile)
+	}
+
+	rules := map[models.RestaurantType]*RuleSet{}
+	for _, restaurant := range []models.RestaurantType{models.Restaurant1, models.Restaurant2} {
+		set, err := loadRuleSet(sources, string(restaurant)+".yaml")
+		if err != nil {
+			return nil, err
+		}
+		if set != nil {
+			rules[restaurant] = set.extend(fallback)
+		}
+	}
+	return NewClassifier(fallback, rules), nil
+}
+
+// 나중 소스에 있는 파일이 앞의 파일을 대신합니다 (어디에도 없으면 nil)
+func loadRuleSet(sources []fs.FS, name string) (*RuleSet, error) {
+	var set *RuleSet
+	for _, source := range sources {
+		data, err := fs.ReadFile(source, name)
+		if errors.Is(err, fs.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read category rules %s: %w", name, err)
+		}
+		set = &RuleSet{}
+		if err := yaml.Unmarshal(data, set); err != nil {
+			return nil, fmt.Errorf("invalid category rules %s: %w", name, err)
+		}
+		for _, rule := range set.Rules {
+			if rule.Category == "" {
+				return nil, fmt.Errorf("invalid category rules %s: rule without category", name)
+			}
+		}
+	}
+	return set, nil
+}
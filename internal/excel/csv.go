@@ -0,0 +1,42 @@
+package excel
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"unicode/utf8"
+
+	"golang.org/x/text/encoding/korean"
+)
+
+var utf8BOM = []byte{0xEF, 0xBB, 0xBF}
+
+// .csv는 시트가 하나인 스프레드시트로 읽습니다 (UTF-8이 아니면 CP949로 간주)
+func openCSV(filePath string) (Spreadsheet, error) {
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, err
+	}
+	data = bytes.TrimPrefix(data, utf8BOM)
+	if !utf8.Valid(data) {
+		// 한글 Windows Excel의 CSV 내보내기는 CP949(EUC-KR)입니다
+		data, err = korean.EUCKR.NewDecoder().Bytes(data)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode CSV: %w", err)
+		}
+	}
+
+	reader := csv.NewReader(bytes.NewReader(data))
+	reader.FieldsPerRecord = -1
+	reader.LazyQuotes = true
+	rows, err := reader.ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse CSV: %w", err)
+	}
+
+	name := strings.TrimSuffix(filepath.Base(filePath), filepath.Ext(filePath))
+	return &gridSpreadsheet{sheets: []*gridSheet{{name: name, rows: rows}}}, nil
+}
@@ -0,0 +1,162 @@
+package excel
+
+import (
+	"archive/zip"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+const (
+	odsTableNS  = "urn:oasis:names:tc:opendocument:xmlns:table:1.0"
+	odsTextNS   = "urn:oasis:names:tc:opendocument:xmlns:text:1.0"
+	odsOfficeNS = "urn:oasis:names:tc:opendocument:xmlns:office:1.0"
+	// 값이 있는 행/열 반복은 이 개수까지만 펼칩니다 (빈 칸 반복은 위치만 건너뜀)
+	odsMaxRepeat = 1024
+)
+
+// OpenDocument 스프레드시트(.ods)의 content.xml을 읽습니다
+func openODS(filePath string) (Spreadsheet, error) {
+	zr, err := zip.OpenReader(filePath)
+	if err != nil {
+		return nil, err
+	}
+	defer zr.Close()
+
+	for _, file := range zr.File {
+		if file.Name != "content.xml" {
+			continue
+		}
+		rc, err := file.Open()
+		if err != nil {
+			return nil, err
+		}
+		defer rc.Close()
+		return parseODSContent(rc)
+	}
+	return nil, fmt.Errorf("content.xml not found in .ods file")
+}
+
+func parseODSContent(r io.Reader) (Spreadsheet, error) {
+	decoder := xml.NewDecoder(r)
+	spreadsheet := &gridSpreadsheet{}
+
+	var (
+		sheet                *gridSheet
+		row, col             int
+		rowRepeat, colRepeat int
+		rowHasValue, inCell  bool
+		cellText             strings.Builder
+		paragraphs           int
+		annotationDepth      int
+		rowCells             map[int]string
+	)
+
+	for {
+		token, err := decoder.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse .ods content: %w", err)
+		}
+
+		switch t := token.(type) {
+		case xml.StartElement:
+			if t.Name.Space == odsOfficeNS && t.Name.Local == "annotation" {
+				annotationDepth++
+			}
+			if annotationDepth > 0 {
+				continue
+			}
+			switch {
+			case t.Name.Space == odsTableNS && t.Name.Local == "table":
+				sheet = &gridSheet{name: odsAttr(t, odsTableNS, "name")}
+				spreadsheet.sheets = append(spreadsheet.sheets, sheet)
+				row = 0
+			case t.Name.Space == odsTableNS && t.Name.Local == "table-row":
+				rowRepeat = odsRepeat(t, "number-rows-repeated")
+				col = 0
+				rowHasValue = false
+				rowCells = map[int]string{}
+			case t.Name.Space == odsTableNS && (t.Name.Local == "table-cell" || t.Name.Local == "covered-table-cell"):
+				colRepeat = odsRepeat(t, "number-columns-repeated")
+				inCell = true
+				cellText.Reset()
+				paragraphs = 0
+			case inCell && t.Name.Space == odsTextNS && t.Name.Local == "p":
+				if paragraphs > 0 {
+					cellText.WriteString("\n")
+				}
+				paragraphs++
+			case inCell && t.Name.Space == odsTextNS && t.Name.Local == "s":
+				count := 1
+				if value := odsAttr(t, odsTextNS, "c"); value != "" {
+					if parsed, err := strconv.Atoi(value); err == nil && parsed > 0 {
+						count = parsed
+					}
+				}
+				cellText.WriteString(strings.Repeat(" ", count))
+			case inCell && t.Name.Space == odsTextNS && t.Name.Local == "tab":
+				cellText.WriteString("\t")
+			case inCell && t.Name.Space == odsTextNS && t.Name.Local == "line-break":
+				cellText.WriteString("\n")
+			}
+
+		case xml.CharData:
+			if inCell && annotationDepth == 0 && paragraphs > 0 {
+				cellText.Write(t)
+			}
+
+		case xml.EndElement:
+			if t.Name.Space == odsOfficeNS && t.Name.Local == "annotation" {
+				annotationDepth--
+				continue
+			}
+			if annotationDepth > 0 || t.Name.Space != odsTableNS {
+				continue
+			}
+			switch t.Name.Local {
+			case "table-cell", "covered-table-cell":
+				inCell = false
+				if value := cellText.String(); value != "" {
+					for i := 0; i < min(colRepeat, odsMaxRepeat); i++ {
+						rowCells[col+i] = value
+					}
+					rowHasValue = true
+				}
+				col += colRepeat
+			case "table-row":
+				if sheet != nil && rowHasValue {
+					for i := 0; i < min(rowRepeat, odsMaxRepeat); i++ {
+						for c, value := range rowCells {
+							sheet.set(row+i, c, value)
+						}
+					}
+				}
+				row += rowRepeat
+			}
+		}
+	}
+	return spreadsheet, nil
+}
+
+func odsAttr(element xml.StartElement, space, local string) string {
+	for _, attr := range element.Attr {
+		if attr.Name.Space == space && attr.Name.Local == local {
+			return attr.Value
+		}
+	}
+	return ""
+}
+
+func odsRepeat(element xml.StartElement, local string) int {
+	if value := odsAttr(element, odsTableNS, local); value != "" {
+		if parsed, err := strconv.Atoi(value); err == nil && parsed > 0 {
+			return parsed
+		}
+	}
+	return 1
+}
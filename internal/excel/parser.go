@@ -2,38 +2,51 @@ package excel
 
 import (
 	"fmt"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
 
 	"github.com/School-meal-lover/backend/internal/models"
-
-	"github.com/xuri/excelize/v2"
 )
 
-type Parser struct{}
+type Parser struct {
+	// 연도가 없는 날짜를 해석할 기준 시각
+	now func() time.Time
+}
+
+// 식단표 레이아웃을 읽을 기본 시트 이름
+const layoutSheetName = "12"
 
 type ExcelFile struct {
-	*excelize.File
+	Spreadsheet
+	// 식당 이름, 날짜, 메뉴를 읽을 시트 ("12" 시트가 없으면 첫 번째 비어 있지 않은 시트)
+	layoutSheet string
 }
 
 func NewParser() *Parser {
-	return &Parser{}
+	return &Parser{now: time.Now}
 }
 
-// 엑셀 파일 열기
+// 엑셀 파일 열기 (.xlsx, .xls, .ods, .csv)
 func (p *Parser) OpenExcelFile(filePath string) (*ExcelFile, error) {
-	f, err := excelize.OpenFile(filePath)
+	spreadsheet, err := OpenSpreadsheet(filePath)
 	if err != nil {
 		return nil, fmt.Errorf("failed to open Excel file: %w", err)
 	}
 
-	return &ExcelFile{File: f}, nil
+	f := &ExcelFile{Spreadsheet: spreadsheet, layoutSheet: layoutSheetName}
+	if !slices.Contains(spreadsheet.GetSheetList(), layoutSheetName) {
+		if sheetName, err := p.GetFirstNonEmptySheet(f); err == nil {
+			f.layoutSheet = sheetName
+		}
+	}
+	return f, nil
 }
 
 // 레스토랑 이름 읽기
 func (p *Parser) ReadRestaurantName(f *ExcelFile) (string, error) {
-	cell, err := f.GetCellValue("12", "D2")
+	cell, err := f.GetCellValue(f.layoutSheet, "D2")
 	if err != nil {
 		return "", fmt.Errorf("failed to read cell D2: %w", err)
 	}
@@ -48,39 +61,66 @@ func (p *Parser) ReadRestaurantName(f *ExcelFile) (string, error) {
 
 // 주차 시작 날짜 읽기
 func (p *Parser) ReadWeekStartDate(f *ExcelFile) (time.Time, error) {
-	cell, err := f.GetCellValue("12", "D6")
+	cell, err := f.GetCellValue(f.layoutSheet, "D6")
 	if err != nil {
 		return time.Time{}, fmt.Errorf("failed to read cell D6: %w", err)
 	}
 
 	cell = strings.TrimSpace(cell)
 	if cell == "" {
-		return time.Time{}, fmt.Errorf("cell D6 (week start date) i s empty")
+		return time.Time{}, fmt.Errorf("cell D6 (week start date) is empty")
 	}
 
-	// 엑셀 날짜 형태: "Mon 5/26"
-	parts := strings.SplitN(cell, " ", 2)
-	if len(parts) < 2 {
-		return time.Time{}, fmt.Errorf("invalid date format in cell D6: %s, expected 'Day MM/DD'", cell)
+	_, date, err := p.parseDateCell(cell)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid date in cell D6: %w", err)
 	}
+	return date, nil
+}
 
-	dateParts := strings.SplitN(parts[1], "/", 2)
+// 날짜 셀 해석: "Mon 5/26" 또는 "Mon 5/26/2025"
+// 연도가 없으면 오늘과 가장 가까운 연도로 해석합니다 (연말/연초 대비)
+func (p *Parser) parseDateCell(cell string) (string, time.Time, error) {
+	parts := strings.Fields(cell)
+	if len(parts) != 2 {
+		return "", time.Time{}, fmt.Errorf("%q, expected 'Day MM/DD'", cell)
+	}
+
+	dateParts := strings.Split(parts[1], "/")
+	if len(dateParts) < 2 || len(dateParts) > 3 {
+		return "", time.Time{}, fmt.Errorf("%q, expected 'Day MM/DD'", cell)
+	}
 	month, err := strconv.Atoi(dateParts[0])
 	if err != nil {
-		return time.Time{}, fmt.Errorf("failed to parse month '%s' from cell D6: %w", dateParts[0], err)
+		return "", time.Time{}, fmt.Errorf("failed to parse month '%s': %w", dateParts[0], err)
 	}
 	day, err := strconv.Atoi(dateParts[1])
 	if err != nil {
-		return time.Time{}, fmt.Errorf("failed to parse day '%s' from cell D6: %w", dateParts[1], err)
+		return "", time.Time{}, fmt.Errorf("failed to parse day '%s': %w", dateParts[1], err)
 	}
-	fullDate := fmt.Sprintf("2025-%02d-%02d", month, day)
 
-	parsedDate, err := time.Parse("2006-01-02", fullDate)
-	if err != nil {
-		return time.Time{}, fmt.Errorf("failed to parse date %s: %w", fullDate, err)
+	today := p.now()
+	year := today.Year()
+	if len(dateParts) == 3 {
+		if year, err = strconv.Atoi(dateParts[2]); err != nil {
+			return "", time.Time{}, fmt.Errorf("failed to parse year '%s': %w", dateParts[2], err)
+		}
 	}
 
-	return parsedDate, nil
+	date := time.Date(year, time.Month(month), day, 0, 0, 0, 0, time.UTC)
+	// 2월 30일처럼 존재하지 않는 날짜는 거부
+	if month < 1 || month > 12 || date.Month() != time.Month(month) || date.Day() != day {
+		return "", time.Time{}, fmt.Errorf("%q is not a valid date", cell)
+	}
+	if len(dateParts) == 2 {
+		today = time.Date(today.Year(), today.Month(), today.Day(), 0, 0, 0, 0, time.UTC)
+		if date.Sub(today) > 183*24*time.Hour {
+			date = date.AddDate(-1, 0, 0)
+		} else if today.Sub(date) > 183*24*time.Hour {
+			date = date.AddDate(1, 0, 0)
+		}
+	}
+	return parts[0], date, nil
 }
 
 func (p *Parser) GetFirstNonEmptySheet(f *ExcelFile) (string, error) {
@@ -121,36 +161,14 @@ func (p *Parser) BuildDatesFromExcel(f *ExcelFile, sheetName string, restaurantT
 			continue
 		}
 
-		parts := strings.SplitN(cell, " ", 2)
-		if len(parts) < 2 {
-			continue
-		}
-
-		dateParts := strings.Split(parts[1], "/")
-		if len(dateParts) < 2 {
-			continue
-		}
-
-		month, err := strconv.Atoi(dateParts[0])
-		if err != nil {
-			continue
-		}
-
-		day, err := strconv.Atoi(dateParts[1])
-		if err != nil {
-			continue
-		}
-
-		formatted := fmt.Sprintf("2025-%02d-%02d", month, day)
-
-		date, err := time.Parse("2006-01-02", formatted)
+		dayOfWeek, date, err := p.parseDateCell(cell)
 		if err != nil {
 			continue
 		}
 
 		dates = append(dates, models.DateInfo{
 			Date:      date.Format("2006-01-02"),
-			DayOfWeek: parts[0],
+			DayOfWeek: dayOfWeek,
 			Col:       col,
 		})
 	}
@@ -163,7 +181,7 @@ func (p *Parser) ReadMenuItems(f *ExcelFile, col string, startRow, endRow int) (
 	var items []string
 
 	for rowIdx := startRow; rowIdx <= endRow; rowIdx++ {
-		cell, err := f.GetCellValue("12", fmt.Sprintf("%s%d", col, rowIdx))
+		cell, err := f.GetCellValue(f.layoutSheet, fmt.Sprintf("%s%d", col, rowIdx))
 		if err != nil {
 			continue // 에러가 있는 셀은 스킵
 		}
@@ -0,0 +1,76 @@
+package excel
+
+import (
+	"testing"
+	"time"
+
+	"github.com/School-meal-lover/backend/internal/models"
+)
+
+// 행 6에 날짜 셀이 있는 식단표
+func testExcelFile(dateCells ...string) *ExcelFile {
+	sheet := &gridSheet{name: layoutSheetName}
+	sheet.set(1, 3, "제1학생식당")
+	for i, cell := range dateCells {
+		sheet.set(5, 3+i, cell)
+	}
+	return &ExcelFile{Spreadsheet: &gridSpreadsheet{sheets: []*gridSheet{sheet}}, layoutSheet: layoutSheetName}
+}
+
+func testParser(now time.Time) *Parser {
+	return &Parser{now: func() time.Time { return now }}
+}
+
+func TestReadWeekStartDate(t *testing.T) {
+	tests := []struct {
+		cell string
+		now  time.Time
+		want time.Time
+	}{
+		{"Mon 5/26", time.Date(2025, time.May, 20, 9, 0, 0, 0, time.UTC), time.Date(2025, time.May, 26, 0, 0, 0, 0, time.UTC)},
+		{"Mon 6/1", time.Date(2026, time.May, 28, 9, 0, 0, 0, time.UTC), time.Date(2026, time.June, 1, 0, 0, 0, 0, time.UTC)},
+		// 연도가 없으면 업로드 시점과 가장 가까운 연도로 해석합니다
+		{"Mon 1/5", time.Date(2025, time.December, 30, 9, 0, 0, 0, time.UTC), time.Date(2026, time.January, 5, 0, 0, 0, 0, time.UTC)},
+		{"Mon 12/29", time.Date(2026, time.January, 2, 9, 0, 0, 0, time.UTC), time.Date(2025, time.December, 29, 0, 0, 0, 0, time.UTC)},
+		{"Mon 5/26/2025", time.Date(2026, time.October, 1, 9, 0, 0, 0, time.UTC), time.Date(2025, time.May, 26, 0, 0, 0, 0, time.UTC)},
+	}
+	for _, tt := range tests {
+		got, err := testParser(tt.now).ReadWeekStartDate(testExcelFile(tt.cell))
+		if err != nil || !got.Equal(tt.want) {
+			t.Errorf("ReadWeekStartDate(%q) = %s, %v, want %s", tt.cell, got.Format("2006-01-02"), err, tt.want.Format("2006-01-02"))
+		}
+	}
+}
+
+func TestReadWeekStartDateInvalid(t *testing.T) {
+	parser := testParser(time.Date(2025, time.May, 20, 9, 0, 0, 0, time.UTC))
+	for _, cell := range []string{"", "Mon 526", "Mon", "Mon 5/", "Mon 2/30", "Mon 13/1", "5/26"} {
+		if _, err := parser.ReadWeekStartDate(testExcelFile(cell)); err == nil {
+			t.Errorf("ReadWeekStartDate(%q) succeeded, want error", cell)
+		}
+	}
+}
+
+func TestBuildDatesFromExcel(t *testing.T) {
+	parser := testParser(time.Date(2025, time.December, 26, 9, 0, 0, 0, time.UTC))
+	f := testExcelFile("Mon 12/29", "Tue 12/30", "Wed 12/31", "Thu 1/1", "Fri 1/2", "Sat 1/3", "Sun 1/4")
+
+	dates, err := parser.BuildDatesFromExcel(f, layoutSheetName, models.Restaurant2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []string{"2025-12-29", "2025-12-30", "2025-12-31", "2026-01-01", "2026-01-02", "2026-01-03", "2026-01-04"}
+	if len(dates) != len(want) {
+		t.Fatalf("got %d dates, want %d", len(dates), len(want))
+	}
+	for i, date := range dates {
+		if date.Date != want[i] {
+			t.Errorf("dates[%d] = %s, want %s", i, date.Date, want[i])
+		}
+	}
+
+	dates, err = parser.BuildDatesFromExcel(f, layoutSheetName, models.Restaurant1)
+	if err != nil || len(dates) != 5 || dates[4].DayOfWeek != "Fri" || dates[4].Col != "H" {
+		t.Errorf("restaurant 1 dates = %+v, %v, want Mon..Fri", dates, err)
+	}
+}
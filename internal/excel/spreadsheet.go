@@ -0,0 +1,204 @@
+package excel
+
+import (
+	"archive/zip"
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/xuri/excelize/v2"
+)
+
+// 스프레드시트 파일 형식
+type Format string
+
+const (
+	FormatXLSX Format = "xlsx"
+	FormatXLS  Format = "xls"
+	FormatODS  Format = "ods"
+	FormatCSV  Format = "csv"
+)
+
+// 형식과 상관없이 시트의 셀 값을 문자열로 읽는 인터페이스
+type Spreadsheet interface {
+	GetSheetList() []string
+	GetRows(sheet string) ([][]string, error)
+	GetCellValue(sheet, cell string) (string, error)
+	Close() error
+}
+
+var (
+	zipMagic = []byte("PK\x03\x04")
+	// OLE2 복합 문서 (Excel 97-2003 .xls)
+	cfbMagic = []byte{0xD0, 0xCF, 0x11, 0xE0, 0xA1, 0xB1, 0x1A, 0xE1}
+)
+
+const odsMimeType = "application/vnd.oasis.opendocument.spreadsheet"
+
+// 파일 내용으로 스프레드시트 형식을 판별합니다 (CSV는 확장자가 .csv인 텍스트 파일)
+func DetectFormat(filePath string) (Format, error) {
+	f, err := os.Open(filePath)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	header := make([]byte, 8192)
+	n, err := io.ReadFull(f, header)
+	if err != nil && err != io.ErrUnexpectedEOF && err != io.EOF {
+		return "", err
+	}
+	header = header[:n]
+
+	switch {
+	case bytes.HasPrefix(header, cfbMagic):
+		return FormatXLS, nil
+	case bytes.HasPrefix(header, zipMagic):
+		return detectZipFormat(filePath)
+	case strings.EqualFold(filepath.Ext(filePath), ".csv") && n > 0 && bytes.IndexByte(header, 0) < 0:
+		return FormatCSV, nil
+	}
+	return "", fmt.Errorf("unsupported spreadsheet format")
+}
+
+// zip 안의 파일로 xlsx와 ods를 구분합니다
+func detectZipFormat(filePath string) (Format, error) {
+	zr, err := zip.OpenReader(filePath)
+	if err != nil {
+		return "", fmt.Errorf("invalid zip archive: %w", err)
+	}
+	defer zr.Close()
+
+	for _, file := range zr.File {
+		switch file.Name {
+		case "[Content_Types].xml":
+			return FormatXLSX, nil
+		case "mimetype":
+			rc, err := file.Open()
+			if err != nil {
+				return "", err
+			}
+			mimeType, err := io.ReadAll(io.LimitReader(rc, 128))
+			rc.Close()
+			if err != nil {
+				return "", err
+			}
+			if strings.TrimSpace(string(mimeType)) == odsMimeType {
+				return FormatODS, nil
+			}
+		}
+	}
+	return "", fmt.Errorf("zip archive is neither .xlsx nor .ods")
+}
+
+// 형식에 맞는 구현으로 스프레드시트를 엽니다
+func OpenSpreadsheet(filePath string) (Spreadsheet, error) {
+	format, err := DetectFormat(filePath)
+	if err != nil {
+		return nil, err
+	}
+
+	switch format {
+	case FormatXLSX:
+		f, err := excelize.OpenFile(filePath)
+		if err != nil {
+			return nil, err
+		}
+		return &xlsxSpreadsheet{file: f}, nil
+	case FormatXLS:
+		return openXLS(filePath)
+	case FormatODS:
+		return openODS(filePath)
+	default:
+		return openCSV(filePath)
+	}
+}
+
+// excelize로 읽는 .xlsx
+type xlsxSpreadsheet struct {
+	file *excelize.File
+}
+
+func (s *xlsxSpreadsheet) GetSheetList() []string {
+	return s.file.GetSheetList()
+}
+
+func (s *xlsxSpreadsheet) GetRows(sheet string) ([][]string, error) {
+	return s.file.GetRows(sheet)
+}
+
+func (s *xlsxSpreadsheet) GetCellValue(sheet, cell string) (string, error) {
+	return s.file.GetCellValue(sheet, cell)
+}
+
+func (s *xlsxSpreadsheet) Close() error {
+	return s.file.Close()
+}
+
+// 메모리에 모두 읽어 둔 시트 (.xls, .ods, .csv 공용)
+type gridSheet struct {
+	name string
+	rows [][]string
+}
+
+type gridSpreadsheet struct {
+	sheets []*gridSheet
+}
+
+func (s *gridSpreadsheet) sheet(name string) (*gridSheet, error) {
+	for _, sheet := range s.sheets {
+		if sheet.name == name {
+			return sheet, nil
+		}
+	}
+	return nil, fmt.Errorf("sheet %s does not exist", name)
+}
+
+func (s *gridSpreadsheet) GetSheetList() []string {
+	names := make([]string, 0, len(s.sheets))
+	for _, sheet := range s.sheets {
+		names = append(names, sheet.name)
+	}
+	return names
+}
+
+func (s *gridSpreadsheet) GetRows(name string) ([][]string, error) {
+	sheet, err := s.sheet(name)
+	if err != nil {
+		return nil, err
+	}
+	return sheet.rows, nil
+}
+
+func (s *gridSpreadsheet) GetCellValue(name, cell string) (string, error) {
+	sheet, err := s.sheet(name)
+	if err != nil {
+		return "", err
+	}
+	col, row, err := excelize.CellNameToCoordinates(cell)
+	if err != nil {
+		return "", err
+	}
+	if row > len(sheet.rows) || col > len(sheet.rows[row-1]) {
+		return "", nil
+	}
+	return sheet.rows[row-1][col-1], nil
+}
+
+func (s *gridSpreadsheet) Close() error {
+	return nil
+}
+
+// 0부터 시작하는 행/열 위치에 값을 넣습니다
+func (g *gridSheet) set(row, col int, value string) {
+	for len(g.rows) <= row {
+		g.rows = append(g.rows, nil)
+	}
+	for len(g.rows[row]) <= col {
+		g.rows[row] = append(g.rows[row], "")
+	}
+	g.rows[row][col] = value
+}
@@ -0,0 +1,132 @@
+package excel
+
+import (
+	"path/filepath"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/School-meal-lover/backend/internal/models"
+)
+
+// testdata의 식단표는 모두 제1학생식당 2025-05-26 주차입니다
+func openTestMenu(t *testing.T, name string) (*Parser, *ExcelFile) {
+	t.Helper()
+	parser := testParser(time.Date(2025, time.May, 20, 9, 0, 0, 0, time.UTC))
+	f, err := parser.OpenExcelFile(filepath.Join("testdata", name))
+	if err != nil {
+		t.Fatalf("OpenExcelFile(%s): %v", name, err)
+	}
+	t.Cleanup(func() { f.Close() })
+	return parser, f
+}
+
+func assertCells(t *testing.T, f *ExcelFile, sheet string, want map[string]string) {
+	t.Helper()
+	for cell, value := range want {
+		got, err := f.GetCellValue(sheet, cell)
+		if err != nil || got != value {
+			t.Errorf("%s!%s = %q, %v, want %q", sheet, cell, got, err, value)
+		}
+	}
+}
+
+func assertTestMenu(t *testing.T, parser *Parser, f *ExcelFile) {
+	t.Helper()
+	if name, err := parser.ReadRestaurantName(f); err != nil || name != "제1학생식당" {
+		t.Errorf("restaurant = %q, %v, want 제1학생식당", name, err)
+	}
+	start, err := parser.ReadWeekStartDate(f)
+	if err != nil || !start.Equal(time.Date(2025, time.May, 26, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("week start = %s, %v, want 2025-05-26", start.Format("2006-01-02"), err)
+	}
+	dates, err := parser.BuildDatesFromExcel(f, f.layoutSheet, models.Restaurant1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []string
+	for _, date := range dates {
+		got = append(got, date.DayOfWeek+" "+date.Date)
+	}
+	want := []string{"Mon 2025-05-26", "Tue 2025-05-27", "Wed 2025-05-28", "Thu 2025-05-29", "Fri 2025-05-30"}
+	if !slices.Equal(got, want) {
+		t.Errorf("dates = %v, want %v", got, want)
+	}
+}
+
+func TestDetectFormat(t *testing.T) {
+	tests := map[string]Format{
+		"menu.xls":       FormatXLS,
+		"menu.ods":       FormatODS,
+		"menu_utf8.csv":  FormatCSV,
+		"menu_cp949.csv": FormatCSV,
+	}
+	for name, want := range tests {
+		if got, err := DetectFormat(filepath.Join("testdata", name)); err != nil || got != want {
+			t.Errorf("DetectFormat(%s) = %q, %v, want %q", name, got, err, want)
+		}
+	}
+}
+
+func TestOpenXLS(t *testing.T) {
+	parser, f := openTestMenu(t, "menu.xls")
+	assertTestMenu(t, parser, f)
+	assertCells(t, f, "12", map[string]string{
+		// 날짜 서식("ddd m/d")이 적용된 MULRK, NUMBER, RK, FORMULA 셀
+		"D6": "Mon 5/26",
+		"E6": "Tue 5/27",
+		"F6": "Wed 5/28",
+		"G6": "Thu 5/29",
+		"H6": "Fri 5/30",
+		"D8": "쌀밥",
+		"D9": "김치찌개",
+		// 문자열 결과가 다음 STRING 레코드에 있는 FORMULA
+		"D10": "Kimchi stew",
+		// 일반 서식, 기본 날짜 서식(14), 소수 서식(2)
+		"D11": "5000",
+		"D12": "05-26-25",
+		"D13": "3.50",
+		"Z99": "",
+	})
+}
+
+func TestOpenODS(t *testing.T) {
+	parser, f := openTestMenu(t, "menu.ods")
+	if sheets := f.GetSheetList(); !slices.Equal(sheets, []string{"Sheet1", "12"}) {
+		t.Errorf("sheets = %v, want [Sheet1 12]", sheets)
+	}
+	assertTestMenu(t, parser, f)
+	assertCells(t, f, "12", map[string]string{
+		// 메모(annotation)는 셀 값에 넣지 않습니다
+		"D2": "제1학생식당",
+		"D6": "Mon 5/26",
+		// 반복된 셀
+		"D8": "쌀밥",
+		"E8": "쌀밥",
+		// 공백(text:s)과 여러 문단
+		"D9":  "김치 찌개\nKimchi  stew",
+		"D10": "",
+	})
+	// 빈 행 반복은 펼치지 않습니다
+	if rows, _ := f.GetRows("12"); len(rows) != 9 {
+		t.Errorf("got %d rows, want 9", len(rows))
+	}
+}
+
+func TestOpenCSV(t *testing.T) {
+	for _, name := range []string{"menu_utf8.csv", "menu_cp949.csv"} {
+		t.Run(name, func(t *testing.T) {
+			parser, f := openTestMenu(t, name)
+			sheet := name[:len(name)-len(".csv")]
+			if sheets := f.GetSheetList(); !slices.Equal(sheets, []string{sheet}) {
+				t.Errorf("sheets = %v, want [%s]", sheets, sheet)
+			}
+			assertTestMenu(t, parser, f)
+			assertCells(t, f, sheet, map[string]string{
+				"D2": "제1학생식당",
+				"D8": "쌀밥",
+				"D9": "김치찌개, 계란말이",
+			})
+		})
+	}
+}
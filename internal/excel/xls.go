@@ -0,0 +1,489 @@
+package excel
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"strconv"
+	"unicode/utf16"
+
+	"github.com/richardlehane/mscfb"
+	"github.com/xuri/excelize/v2"
+)
+
+// BIFF8 레코드 종류 (필요한 것만)
+const (
+	xlsRecordBOF        = 0x0809
+	xlsRecordEOF        = 0x000A
+	xlsRecordFilePass   = 0x002F
+	xlsRecordBoundSheet = 0x0085
+	xlsRecordSST        = 0x00FC
+	xlsRecordContinue   = 0x003C
+	xlsRecordLabelSST   = 0x00FD
+	xlsRecordLabel      = 0x0204
+	xlsRecordNumber     = 0x0203
+	xlsRecordRK         = 0x027E
+	xlsRecordMulRK      = 0x00BD
+	xlsRecordFormula    = 0x0006
+	xlsRecordString     = 0x0207
+	xlsRecordBoolErr    = 0x0205
+	xlsRecordFormat     = 0x041E
+	xlsRecordXF         = 0x00E0
+	xlsRecordDateMode   = 0x0022
+
+	xlsBIFF8Version = 0x0600
+)
+
+var errXLSTruncated = errors.New("truncated .xls record")
+
+type xlsRecord struct {
+	id     uint16
+	offset int
+	data   []byte
+}
+
+// Excel 97-2003(.xls, BIFF8) 통합 문서를 읽습니다
+func openXLS(filePath string) (Spreadsheet, error) {
+	f, err := os.Open(filePath)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	doc, err := mscfb.New(f)
+	if err != nil {
+		return nil, fmt.Errorf("invalid .xls file: %w", err)
+	}
+	for entry, err := doc.Next(); err == nil; entry, err = doc.Next() {
+		if entry.Name != "Workbook" && entry.Name != "Book" {
+			continue
+		}
+		stream, err := io.ReadAll(entry)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read workbook stream: %w", err)
+		}
+		return parseBIFF8(stream)
+	}
+	return nil, fmt.Errorf("workbook stream not found in .xls file")
+}
+
+func readXLSRecords(stream []byte) ([]xlsRecord, error) {
+	var records []xlsRecord
+	for pos := 0; pos+4 <= len(stream); {
+		id := binary.LittleEndian.Uint16(stream[pos:])
+		size := int(binary.LittleEndian.Uint16(stream[pos+2:]))
+		if pos+4+size > len(stream) {
+			return nil, errXLSTruncated
+		}
+		records = append(records, xlsRecord{id: id, offset: pos, data: stream[pos+4 : pos+4+size]})
+		pos += 4 + size
+	}
+	return records, nil
+}
+
+func parseBIFF8(stream []byte) (Spreadsheet, error) {
+	records, err := readXLSRecords(stream)
+	if err != nil {
+		return nil, err
+	}
+	if len(records) == 0 || records[0].id != xlsRecordBOF || len(records[0].data) < 2 ||
+		binary.LittleEndian.Uint16(records[0].data) != xlsBIFF8Version {
+		return nil, fmt.Errorf("only Excel 97-2003 (BIFF8) .xls files are supported")
+	}
+
+	spreadsheet := &gridSpreadsheet{}
+	sheetsByOffset := map[int]*gridSheet{}
+	numbers := &xlsNumberFormatter{formats: map[int]string{}}
+	defer numbers.close()
+	var sst []string
+	var current *gridSheet
+	// FORMULA 결과가 문자열이면 바로 다음 STRING 레코드에 값이 들어 있습니다
+	var pendingRow, pendingCol = -1, -1
+
+	for i := 0; i < len(records); i++ {
+		record := records[i]
+		data := record.data
+
+		switch record.id {
+		case xlsRecordFilePass:
+			return nil, fmt.Errorf("encrypted .xls files are not supported")
+
+		case xlsRecordBoundSheet:
+			if len(data) < 8 {
+				return nil, errXLSTruncated
+			}
+			// 워크시트(type 0)만 읽습니다
+			if data[5] != 0 {
+				continue
+			}
+			name, _, err := xlsShortString(data[6:])
+			if err != nil {
+				return nil, err
+			}
+			sheet := &gridSheet{name: name}
+			spreadsheet.sheets = append(spreadsheet.sheets, sheet)
+			sheetsByOffset[int(binary.LittleEndian.Uint32(data))] = sheet
+
+		case xlsRecordFormat:
+			if len(data) < 5 {
+				return nil, errXLSTruncated
+			}
+			code, err := xlsUnicodeString(data[2:])
+			if err != nil {
+				return nil, err
+			}
+			numbers.formats[int(binary.LittleEndian.Uint16(data))] = code
+
+		case xlsRecordXF:
+			if len(data) < 4 {
+				return nil, errXLSTruncated
+			}
+			numbers.xfs = append(numbers.xfs, int(binary.LittleEndian.Uint16(data[2:])))
+
+		case xlsRecordDateMode:
+			if len(data) < 2 {
+				return nil, errXLSTruncated
+			}
+			numbers.date1904 = binary.LittleEndian.Uint16(data) == 1
+
+		case xlsRecordSST:
+			segments := [][]byte{data}
+			for i+1 < len(records) && records[i+1].id == xlsRecordContinue {
+				i++
+				segments = append(segments, records[i].data)
+			}
+			if sst, err = parseXLSSST(segments); err != nil {
+				return nil, err
+			}
+
+		case xlsRecordBOF:
+			current = sheetsByOffset[record.offset]
+
+		case xlsRecordEOF:
+			current = nil
+		}
+
+		if current == nil {
+			continue
+		}
+
+		switch record.id {
+		case xlsRecordLabelSST:
+			if len(data) < 10 {
+				return nil, errXLSTruncated
+			}
+			index := int(binary.LittleEndian.Uint32(data[6:]))
+			if index < len(sst) {
+				current.set(xlsRow(data), xlsCol(data), sst[index])
+			}
+
+		case xlsRecordLabel:
+			if len(data) < 9 {
+				return nil, errXLSTruncated
+			}
+			value, err := xlsUnicodeString(data[6:])
+			if err != nil {
+				return nil, err
+			}
+			current.set(xlsRow(data), xlsCol(data), value)
+
+		case xlsRecordNumber:
+			if len(data) < 14 {
+				return nil, errXLSTruncated
+			}
+			value := math.Float64frombits(binary.LittleEndian.Uint64(data[6:]))
+			current.set(xlsRow(data), xlsCol(data), numbers.format(xlsXF(data), value))
+
+		case xlsRecordRK:
+			if len(data) < 10 {
+				return nil, errXLSTruncated
+			}
+			current.set(xlsRow(data), xlsCol(data), numbers.format(xlsXF(data), xlsRKValue(binary.LittleEndian.Uint32(data[6:]))))
+
+		case xlsRecordMulRK:
+			if len(data) < 6 {
+				return nil, errXLSTruncated
+			}
+			row, col := xlsRow(data), xlsCol(data)
+			for pos := 4; pos+6 <= len(data)-2; pos += 6 {
+				xf := int(binary.LittleEndian.Uint16(data[pos:]))
+				current.set(row, col, numbers.format(xf, xlsRKValue(binary.LittleEndian.Uint32(data[pos+2:]))))
+				col++
+			}
+
+		case xlsRecordFormula:
+			if len(data) < 14 {
+				return nil, errXLSTruncated
+			}
+			result := data[6:14]
+			if result[6] == 0xFF && result[7] == 0xFF {
+				switch result[0] {
+				case 0: // 문자열 (다음 STRING 레코드)
+					pendingRow, pendingCol = xlsRow(data), xlsCol(data)
+				case 1: // 논리값
+					current.set(xlsRow(data), xlsCol(data), strconv.FormatBool(result[2] != 0))
+				}
+				continue
+			}
+			value := math.Float64frombits(binary.LittleEndian.Uint64(result))
+			current.set(xlsRow(data), xlsCol(data), numbers.format(xlsXF(data), value))
+
+		case xlsRecordString:
+			if pendingRow < 0 {
+				continue
+			}
+			value, err := xlsUnicodeString(data)
+			if err != nil {
+				return nil, err
+			}
+			current.set(pendingRow, pendingCol, value)
+			pendingRow, pendingCol = -1, -1
+
+		case xlsRecordBoolErr:
+			if len(data) < 8 {
+				return nil, errXLSTruncated
+			}
+			if data[7] == 0 {
+				current.set(xlsRow(data), xlsCol(data), strconv.FormatBool(data[6] != 0))
+			}
+		}
+	}
+	return spreadsheet, nil
+}
+
+func xlsRow(data []byte) int {
+	return int(binary.LittleEndian.Uint16(data))
+}
+
+func xlsCol(data []byte) int {
+	return int(binary.LittleEndian.Uint16(data[2:]))
+}
+
+// 셀 레코드의 XF(서식) 인덱스
+func xlsXF(data []byte) int {
+	return int(binary.LittleEndian.Uint16(data[4:]))
+}
+
+// 숫자 셀을 XF가 가리키는 표시 형식으로 바꿉니다
+// "ddd m/d" 같은 날짜 서식도 .xlsx와 같은 결과가 나오도록 excelize로 서식을 적용합니다
+type xlsNumberFormatter struct {
+	// FORMAT 레코드의 서식 코드 (서식 번호별)
+	formats map[int]string
+	// XF 인덱스별 서식 번호
+	xfs      []int
+	date1904 bool
+
+	scratch *excelize.File
+	styles  map[int]int
+}
+
+func (n *xlsNumberFormatter) format(xf int, value float64) string {
+	if xf >= len(n.xfs) || n.xfs[xf] == 0 {
+		return formatXLSNumber(value)
+	}
+	formatted, err := n.formatWithExcelize(n.xfs[xf], value)
+	if err != nil {
+		return formatXLSNumber(value)
+	}
+	return formatted
+}
+
+func (n *xlsNumberFormatter) formatWithExcelize(numFmt int, value float64) (string, error) {
+	if n.scratch == nil {
+		n.scratch = excelize.NewFile()
+		n.styles = map[int]int{}
+		if err := n.scratch.SetWorkbookProps(&excelize.WorkbookPropsOptions{Date1904: &n.date1904}); err != nil {
+			return "", err
+		}
+	}
+	style, ok := n.styles[numFmt]
+	if !ok {
+		options := &excelize.Style{NumFmt: numFmt}
+		if code, ok := n.formats[numFmt]; ok {
+			options = &excelize.Style{CustomNumFmt: &code}
+		}
+		var err error
+		if style, err = n.scratch.NewStyle(options); err != nil {
+			return "", err
+		}
+		n.styles[numFmt] = style
+	}
+
+	const sheet, cell = "Sheet1", "A1"
+	if err := n.scratch.SetCellFloat(sheet, cell, value, -1, 64); err != nil {
+		return "", err
+	}
+	if err := n.scratch.SetCellStyle(sheet, cell, cell, style); err != nil {
+		return "", err
+	}
+	return n.scratch.GetCellValue(sheet, cell)
+}
+
+func (n *xlsNumberFormatter) close() {
+	if n.scratch != nil {
+		n.scratch.Close()
+	}
+}
+
+// RK 형식으로 압축된 숫자
+func xlsRKValue(rk uint32) float64 {
+	var value float64
+	if rk&0x02 != 0 {
+		value = float64(int32(rk) >> 2)
+	} else {
+		value = math.Float64frombits(uint64(rk&0xFFFFFFFC) << 32)
+	}
+	if rk&0x01 != 0 {
+		value /= 100
+	}
+	return value
+}
+
+func formatXLSNumber(value float64) string {
+	return strconv.FormatFloat(value, 'f', -1, 64)
+}
+
+// ShortXLUnicodeString: 글자 수(1바이트) + 옵션 + 글자
+func xlsShortString(data []byte) (string, int, error) {
+	if len(data) < 2 {
+		return "", 0, errXLSTruncated
+	}
+	return xlsChars(data[2:], int(data[0]), data[1]&0x01 != 0)
+}
+
+// XLUnicodeString: 글자 수(2바이트) + 옵션 + 글자
+func xlsUnicodeString(data []byte) (string, error) {
+	if len(data) < 3 {
+		return "", errXLSTruncated
+	}
+	value, _, err := xlsChars(data[3:], int(binary.LittleEndian.Uint16(data)), data[2]&0x01 != 0)
+	return value, err
+}
+
+// 압축(1바이트) 또는 UTF-16LE 글자를 읽고 사용한 바이트 수를 반환합니다
+func xlsChars(data []byte, count int, highByte bool) (string, int, error) {
+	if !highByte {
+		if len(data) < count {
+			return "", 0, errXLSTruncated
+		}
+		units := make([]uint16, count)
+		for i := range units {
+			units[i] = uint16(data[i])
+		}
+		return string(utf16.Decode(units)), count, nil
+	}
+	if len(data) < count*2 {
+		return "", 0, errXLSTruncated
+	}
+	units := make([]uint16, count)
+	for i := range units {
+		units[i] = binary.LittleEndian.Uint16(data[i*2:])
+	}
+	return string(utf16.Decode(units)), count * 2, nil
+}
+
+// SST와 이어지는 CONTINUE 레코드를 순서대로 읽는 리더
+// 문자열 글자가 레코드 경계에서 나뉘면 다음 레코드 첫 바이트에 압축 여부가 다시 들어 있습니다
+type xlsSegmentReader struct {
+	segments [][]byte
+	segment  int
+	pos      int
+}
+
+func (r *xlsSegmentReader) next() bool {
+	for r.segment < len(r.segments) && r.pos >= len(r.segments[r.segment]) {
+		r.segment++
+		r.pos = 0
+	}
+	return r.segment < len(r.segments)
+}
+
+func (r *xlsSegmentReader) bytes(n int) ([]byte, error) {
+	out := make([]byte, 0, n)
+	for len(out) < n {
+		if !r.next() {
+			return nil, errXLSTruncated
+		}
+		segment := r.segments[r.segment]
+		take := min(n-len(out), len(segment)-r.pos)
+		out = append(out, segment[r.pos:r.pos+take]...)
+		r.pos += take
+	}
+	return out, nil
+}
+
+func (r *xlsSegmentReader) chars(count int, highByte bool) (string, error) {
+	units := make([]uint16, 0, count)
+	for len(units) < count {
+		if r.pos >= len(r.segments[r.segment]) {
+			r.segment++
+			r.pos = 0
+			if r.segment >= len(r.segments) || len(r.segments[r.segment]) == 0 {
+				return "", errXLSTruncated
+			}
+			highByte = r.segments[r.segment][0]&0x01 != 0
+			r.pos = 1
+		}
+		segment := r.segments[r.segment]
+		if highByte {
+			if r.pos+2 > len(segment) {
+				return "", errXLSTruncated
+			}
+			units = append(units, binary.LittleEndian.Uint16(segment[r.pos:]))
+			r.pos += 2
+		} else {
+			units = append(units, uint16(segment[r.pos]))
+			r.pos++
+		}
+	}
+	return string(utf16.Decode(units)), nil
+}
+
+// 공유 문자열 테이블 (XLUnicodeRichExtendedString 목록)
+func parseXLSSST(segments [][]byte) ([]string, error) {
+	r := &xlsSegmentReader{segments: segments}
+	header, err := r.bytes(8)
+	if err != nil {
+		return nil, err
+	}
+	unique := int(binary.LittleEndian.Uint32(header[4:]))
+
+	values := make([]string, 0, min(unique, 1<<16))
+	for i := 0; i < unique; i++ {
+		head, err := r.bytes(3)
+		if err != nil {
+			return nil, err
+		}
+		count := int(binary.LittleEndian.Uint16(head))
+		flags := head[2]
+
+		var runs, extSize int
+		if flags&0x08 != 0 {
+			b, err := r.bytes(2)
+			if err != nil {
+				return nil, err
+			}
+			runs = int(binary.LittleEndian.Uint16(b))
+		}
+		if flags&0x04 != 0 {
+			b, err := r.bytes(4)
+			if err != nil {
+				return nil, err
+			}
+			extSize = int(binary.LittleEndian.Uint32(b))
+		}
+
+		value, err := r.chars(count, flags&0x01 != 0)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := r.bytes(runs*4 + extSize); err != nil {
+			return nil, err
+		}
+		values = append(values, value)
+	}
+	return values, nil
+}
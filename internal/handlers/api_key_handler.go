@@ -0,0 +1,106 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/School-meal-lover/backend/internal/models"
+	"github.com/School-meal-lover/backend/internal/services"
+	"github.com/gin-gonic/gin"
+)
+
+type APIKeyHandler struct {
+	apiKeyService *services.APIKeyService
+	auditService  *services.AuditService
+}
+
+func NewAPIKeyHandler(apiKeyService *services.APIKeyService, auditService *services.AuditService) *APIKeyHandler {
+	return &APIKeyHandler{apiKeyService: apiKeyService, auditService: auditService}
+}
+
+// @Summary      API 키 발급
+// @Description  새 API 키를 발급합니다. scopes는 admin, approver, approver@RESTAURANT_1, staff@RESTAURANT_1, staff@RESTAURANT_2, read_only 중에서 지정합니다. 원본 키는 이 응답에서 한 번만 확인할 수 있고 서버에는 해시만 저장됩니다. admin 권한이 필요합니다.
+// @Tags         Admin
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        data body models.APIKeyCreateRequest true "키 정보"
+// @Success      201 {object} models.APIKeyResponse "발급 성공"
+// @Failure      400 {object} models.ErrorResponse "잘못된 요청"
+// @Failure      401 {object} models.ErrorResponse "Unauthorized - Invalid or missing token"
+// @Failure      403 {object} models.ErrorResponse "Forbidden - admin role required"
+// @Failure      500 {object} models.ErrorResponse "서버 내부 오류 발생"
+// @Router       /admin/api-keys [post]
+func (h *APIKeyHandler) IssueKey(c *gin.Context) {
+	var req models.APIKeyCreateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{Success: false, Error: err.Error()})
+		return
+	}
+
+	key, plainKey, err := h.apiKeyService.IssueKey(&req)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		if services.IsValidationError(err) {
+			statusCode = http.StatusBadRequest
+		}
+		c.JSON(statusCode, models.ErrorResponse{Success: false, Error: err.Error()})
+		return
+	}
+	entry := newAuditLog(c, models.AuditAPIKeyIssue)
+	entry.Summary["api_key_id"] = key.ID
+	entry.Summary["name"] = key.Name
+	entry.Summary["prefix"] = key.Prefix
+	entry.Summary["scopes"] = key.Scopes
+	h.auditService.Record(entry)
+
+	c.JSON(http.StatusCreated, models.APIKeyResponse{Success: true, Data: key, Key: plainKey})
+}
+
+// @Summary      API 키 목록
+// @Description  발급된 API 키 목록을 조회합니다. 원본 키와 해시는 포함되지 않습니다. admin 권한이 필요합니다.
+// @Tags         Admin
+// @Produce      json
+// @Security     BearerAuth
+// @Success      200 {object} models.APIKeyListResponse "조회 성공"
+// @Failure      401 {object} models.ErrorResponse "Unauthorized - Invalid or missing token"
+// @Failure      403 {object} models.ErrorResponse "Forbidden - admin role required"
+// @Failure      500 {object} models.ErrorResponse "서버 내부 오류 발생"
+// @Router       /admin/api-keys [get]
+func (h *APIKeyHandler) ListKeys(c *gin.Context) {
+	keys, err := h.apiKeyService.ListKeys()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, models.ErrorResponse{Success: false, Error: err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, models.APIKeyListResponse{Success: true, Data: keys})
+}
+
+// @Summary      API 키 폐기
+// @Description  API 키를 폐기합니다. 폐기된 키는 즉시 인증에 실패합니다. admin 권한이 필요합니다.
+// @Tags         Admin
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path string true "API 키 ID"
+// @Success      204 "폐기 성공"
+// @Failure      401 {object} models.ErrorResponse "Unauthorized - Invalid or missing token"
+// @Failure      403 {object} models.ErrorResponse "Forbidden - admin role required"
+// @Failure      404 {object} models.ErrorResponse "API 키를 찾을 수 없음"
+// @Failure      500 {object} models.ErrorResponse "서버 내부 오류 발생"
+// @Router       /admin/api-keys/{id} [delete]
+func (h *APIKeyHandler) RevokeKey(c *gin.Context) {
+	revoked, err := h.apiKeyService.RevokeKey(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, models.ErrorResponse{Success: false, Error: err.Error()})
+		return
+	}
+	if !revoked {
+		c.JSON(http.StatusNotFound, models.ErrorResponse{Success: false, Error: "api key not found"})
+		return
+	}
+
+	entry := newAuditLog(c, models.AuditAPIKeyRevoke)
+	entry.Summary["api_key_id"] = c.Param("id")
+	h.auditService.Record(entry)
+
+	c.Status(http.StatusNoContent)
+}
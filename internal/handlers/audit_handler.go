@@ -0,0 +1,125 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/School-meal-lover/backend/internal/middleware"
+	"github.com/School-meal-lover/backend/internal/models"
+	"github.com/School-meal-lover/backend/internal/services"
+	"github.com/gin-gonic/gin"
+)
+
+type AuditHandler struct {
+	auditService *services.AuditService
+}
+
+func NewAuditHandler(auditService *services.AuditService) *AuditHandler {
+	return &AuditHandler{auditService: auditService}
+}
+
+// @Summary      감사 로그 조회
+// @Description  업로드, 이미지 변경, 관리 작업 기록을 최신순으로 조회합니다. admin 권한이 필요합니다.
+// @Tags         Admin
+// @Produce      json
+// @Security     BearerAuth
+// @Param        actor query string false "요청한 사용자 (API 키 이름 또는 이메일)"
+// @Param        action query string false "동작" Enums(upload.excel, upload.text, upload.json, upload.reprocess, image.upload, image.upload_file, api_key.issue, api_key.revoke, webhook.create, webhook.delete, meal_type.update, closure.create, closure.delete, week.submit, week.reject, week.publish, week.retract)
+// @Param        restaurant query string false "식당 (RESTAURANT_1, RESTAURANT_2)"
+// @Param        week_id query string false "주차 ID"
+// @Param        since query string false "이 시각 이후 (RFC3339 또는 YYYY-MM-DD)"
+// @Param        until query string false "이 시각 이전 (RFC3339 또는 YYYY-MM-DD)"
+// @Param        limit query int false "최대 개수 (기본 100, 최대 500)"
+// @Param        offset query int false "건너뛸 개수"
+// @Success      200 {object} models.AuditLogListResponse "조회 성공"
+// @Failure      400 {object} models.ErrorResponse "잘못된 조회 조건"
+// @Failure      401 {object} models.ErrorResponse "Unauthorized - Invalid or missing token"
+// @Failure      403 {object} models.ErrorResponse "Forbidden - admin role required"
+// @Failure      500 {object} models.ErrorResponse "서버 내부 오류 발생"
+// @Router       /admin/audit [get]
+func (h *AuditHandler) ListAuditLogs(c *gin.Context) {
+	filter := &models.AuditLogFilter{
+		Actor:  c.Query("actor"),
+		Action: c.Query("action"),
+		WeekID: c.Query("week_id"),
+	}
+	if name := c.Query("restaurant"); name != "" {
+		restaurant, ok := models.ParseRestaurantType(name)
+		if !ok {
+			c.JSON(http.StatusBadRequest, models.ErrorResponse{Success: false, Error: "unknown restaurant: " + name})
+			return
+		}
+		filter.Restaurant = restaurant
+	}
+
+	var err error
+	if filter.Since, err = parseAuditTime(c.Query("since")); err != nil {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{Success: false, Error: "invalid since: " + err.Error()})
+		return
+	}
+	if filter.Until, err = parseAuditTime(c.Query("until")); err != nil {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{Success: false, Error: "invalid until: " + err.Error()})
+		return
+	}
+	for name, target := range map[string]*int{"limit": &filter.Limit, "offset": &filter.Offset} {
+		if value := c.Query(name); value != "" {
+			if *target, err = strconv.Atoi(value); err != nil {
+				c.JSON(http.StatusBadRequest, models.ErrorResponse{Success: false, Error: "invalid " + name})
+				return
+			}
+		}
+	}
+
+	entries, err := h.auditService.List(filter)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		if services.IsValidationError(err) {
+			statusCode = http.StatusBadRequest
+		}
+		c.JSON(statusCode, models.ErrorResponse{Success: false, Error: err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, models.AuditLogListResponse{Success: true, Data: entries})
+}
+
+func parseAuditTime(value string) (*time.Time, error) {
+	if value == "" {
+		return nil, nil
+	}
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return &t, nil
+	}
+	t, err := time.Parse("2006-01-02", value)
+	if err != nil {
+		return nil, err
+	}
+	return &t, nil
+}
+
+// 요청 주체와 경로를 채운 감사 로그 항목
+func newAuditLog(c *gin.Context, action string) *models.AuditLog {
+	entry := &models.AuditLog{
+		Action:   action,
+		Method:   c.Request.Method,
+		Endpoint: c.Request.URL.Path,
+		Summary:  map[string]interface{}{},
+	}
+	if principal := middleware.CurrentPrincipal(c); principal != nil {
+		entry.Actor = principal.Subject
+		entry.ActorRole = string(principal.Role)
+		if principal.Restaurant != "" {
+			entry.ActorRole += "@" + string(principal.Restaurant)
+		}
+	}
+	return entry
+}
+
+// 업로드 결과의 집계 값
+func auditSummary(result *models.ExcelProcessResult) map[string]interface{} {
+	return map[string]interface{}{
+		"week_start_date":  result.WeekStartDate,
+		"total_meals":      result.TotalMeals,
+		"total_menu_items": result.TotalMenuItems,
+	}
+}
@@ -0,0 +1,121 @@
+package handlers
+
+import (
+	"errors"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/School-meal-lover/backend/internal/auth"
+	"github.com/School-meal-lover/backend/internal/middleware"
+	"github.com/School-meal-lover/backend/internal/models"
+	"github.com/gin-gonic/gin"
+)
+
+type AuthHandler struct {
+	oidcClient *auth.OIDCClient
+}
+
+// oidcClient가 nil이면 SSO 로그인 엔드포인트는 404로 응답합니다
+func NewAuthHandler(oidcClient *auth.OIDCClient) *AuthHandler {
+	return &AuthHandler{oidcClient: oidcClient}
+}
+
+// @Summary      SSO 로그인 시작
+// @Description  OpenID Connect 인가 코드 + PKCE 로그인을 시작합니다. 설정된 OIDC 제공자의 로그인 페이지로 이동합니다.
+// @Tags         Auth
+// @Param        redirect query string false "로그인 후 돌아갈 경로 (같은 사이트의 /로 시작하는 경로만)"
+// @Success      302 "OIDC 제공자로 이동"
+// @Failure      404 {object} models.ErrorResponse "SSO 로그인이 설정되지 않음"
+// @Failure      502 {object} models.ErrorResponse "OIDC 제공자 오류"
+// @Router       /auth/oidc/login [get]
+func (h *AuthHandler) StartLogin(c *gin.Context) {
+	if h.oidcClient == nil {
+		c.JSON(http.StatusNotFound, models.ErrorResponse{Success: false, Error: "SSO login is not configured"})
+		return
+	}
+
+	login, err := h.oidcClient.StartLogin(c.Request.Context(), c.Query("redirect"))
+	if err != nil {
+		log.Printf("Failed to start OIDC login: %v", err)
+		c.JSON(http.StatusBadGateway, models.ErrorResponse{Success: false, Error: "failed to contact identity provider"})
+		return
+	}
+
+	c.SetSameSite(http.SameSiteLaxMode)
+	c.SetCookie(auth.OIDCStateCookieName, login.StateCookie, int((10 * time.Minute).Seconds()), "/", "", h.oidcClient.SecureCookies(), true)
+	c.Redirect(http.StatusFound, login.AuthorizationURL)
+}
+
+// @Summary      SSO 로그인 콜백
+// @Description  OIDC 제공자가 돌려보낸 인가 코드를 토큰으로 교환하고, ID 토큰의 역할 클레임에 맞는 세션 쿠키(grrrr_session)를 발급합니다.
+// @Tags         Auth
+// @Param        code query string true "인가 코드"
+// @Param        state query string true "로그인 시작 때 보낸 state"
+// @Success      302 "로그인 전 경로로 이동"
+// @Failure      401 {object} models.ErrorResponse "로그인 실패 (state 불일치, 토큰 검증 실패, 역할 없음)"
+// @Failure      404 {object} models.ErrorResponse "SSO 로그인이 설정되지 않음"
+// @Failure      502 {object} models.ErrorResponse "OIDC 제공자 오류"
+// @Router       /auth/oidc/callback [get]
+func (h *AuthHandler) Callback(c *gin.Context) {
+	if h.oidcClient == nil {
+		c.JSON(http.StatusNotFound, models.ErrorResponse{Success: false, Error: "SSO login is not configured"})
+		return
+	}
+	if errorCode := c.Query("error"); errorCode != "" {
+		c.JSON(http.StatusUnauthorized, models.ErrorResponse{Success: false, Error: "login was not completed: " + errorCode})
+		return
+	}
+
+	stateCookie, _ := c.Cookie(auth.OIDCStateCookieName)
+	secure := h.oidcClient.SecureCookies()
+	c.SetSameSite(http.SameSiteLaxMode)
+	c.SetCookie(auth.OIDCStateCookieName, "", -1, "/", "", secure, true)
+
+	session, err := h.oidcClient.FinishLogin(c.Request.Context(), stateCookie, c.Query("state"), c.Query("code"))
+	if err != nil {
+		if errors.Is(err, auth.ErrInvalidCredentials) {
+			c.JSON(http.StatusUnauthorized, models.ErrorResponse{Success: false, Error: err.Error()})
+			return
+		}
+		log.Printf("Failed to finish OIDC login: %v", err)
+		c.JSON(http.StatusBadGateway, models.ErrorResponse{Success: false, Error: "failed to contact identity provider"})
+		return
+	}
+
+	maxAge := int(time.Until(session.ExpiresAt).Seconds())
+	c.SetCookie(auth.SessionCookieName, session.Token, maxAge, "/", "", secure, true)
+	c.Redirect(http.StatusFound, session.Redirect)
+}
+
+// @Summary      로그아웃
+// @Description  세션 쿠키를 삭제합니다.
+// @Tags         Auth
+// @Success      204 "로그아웃 성공"
+// @Router       /auth/logout [post]
+func (h *AuthHandler) Logout(c *gin.Context) {
+	secure := h.oidcClient != nil && h.oidcClient.SecureCookies()
+	c.SetSameSite(http.SameSiteLaxMode)
+	c.SetCookie(auth.SessionCookieName, "", -1, "/", "", secure, true)
+	c.Status(http.StatusNoContent)
+}
+
+// @Summary      현재 사용자 정보
+// @Description  세션 쿠키 또는 Bearer 토큰으로 인증된 사용자와 역할을 조회합니다.
+// @Tags         Auth
+// @Produce      json
+// @Security     BearerAuth
+// @Success      200 {object} models.AuthPrincipalResponse "조회 성공"
+// @Failure      401 {object} models.ErrorResponse "Unauthorized - Invalid or missing token"
+// @Router       /auth/me [get]
+func (h *AuthHandler) Me(c *gin.Context) {
+	principal := middleware.CurrentPrincipal(c)
+	c.JSON(http.StatusOK, models.AuthPrincipalResponse{
+		Success: true,
+		Data: &models.AuthPrincipal{
+			Subject:    principal.Subject,
+			Role:       string(principal.Role),
+			Restaurant: string(principal.Restaurant),
+		},
+	})
+}
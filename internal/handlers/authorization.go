@@ -0,0 +1,35 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/School-meal-lover/backend/internal/middleware"
+	"github.com/School-meal-lover/backend/internal/models"
+	"github.com/gin-gonic/gin"
+)
+
+// 요청 주체가 해당 식당의 데이터를 올릴 수 있는지 확인하고, 아니면 403으로 응답합니다
+func authorizeRestaurant(c *gin.Context, restaurant models.RestaurantType) bool {
+	principal := middleware.CurrentPrincipal(c)
+	if principal == nil || !principal.CanManageRestaurant(restaurant) {
+		c.JSON(http.StatusForbidden, models.ErrorResponse{
+			Success: false,
+			Error:   "not allowed to upload for " + string(restaurant),
+		})
+		return false
+	}
+	return true
+}
+
+// 요청 주체가 해당 식당의 식단을 게시하거나 내릴 수 있는지 확인하고, 아니면 403으로 응답합니다
+func authorizeApproval(c *gin.Context, restaurant models.RestaurantType) bool {
+	principal := middleware.CurrentPrincipal(c)
+	if principal == nil || !principal.CanApproveRestaurant(restaurant) {
+		c.JSON(http.StatusForbidden, models.ErrorResponse{
+			Success: false,
+			Error:   "not allowed to publish for " + string(restaurant),
+		})
+		return false
+	}
+	return true
+}
@@ -0,0 +1,51 @@
+package handlers
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/School-meal-lover/backend/internal/models"
+	"github.com/School-meal-lover/backend/internal/services"
+	"github.com/gin-gonic/gin"
+)
+
+type CalendarHandler struct {
+	calendarService *services.CalendarService
+}
+
+func NewCalendarHandler(calendarService *services.CalendarService) *CalendarHandler {
+	return &CalendarHandler{calendarService: calendarService}
+}
+
+// @Summary      식단 캘린더 구독 (iCalendar)
+// @Description  식당의 최근 4주 및 예정된 식단을 iCalendar(.ics) 형식으로 제공합니다. 각 식사는 배식 시간을 시작/종료 시각으로 하는 VEVENT이며, 설명에 메뉴 목록이 들어갑니다. 캘린더 앱에서 URL로 구독할 수 있습니다.
+// @Tags         Meals
+// @Produce      text/calendar
+// @Param        name path string true "레스토랑 이름 (RESTAURANT_1, RESTAURANT_2)"
+// @Param        lang query string false "언어 (ko, en)" Enums(ko, en) default(ko)
+// @Success      200 {string} string "iCalendar 데이터"
+// @Failure      400 {object} models.ErrorResponse "잘못된 식당 이름 또는 언어"
+// @Failure      500 {object} models.ErrorResponse "서버 내부 오류 발생"
+// @Router       /restaurants/{name}/calendar.ics [get]
+func (h *CalendarHandler) GetRestaurantCalendar(c *gin.Context) {
+	restaurant, ok := models.ParseRestaurantType(c.Param("name"))
+	if !ok {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{Success: false, Error: "Invalid restaurant name"})
+		return
+	}
+	lang := strings.ToLower(c.DefaultQuery("lang", services.LangKorean))
+	if lang != services.LangKorean && lang != services.LangEnglish {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{Success: false, Error: "lang must be ko or en"})
+		return
+	}
+
+	calendar, err := h.calendarService.GetRestaurantCalendar(restaurant, lang, time.Now())
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, models.ErrorResponse{Success: false, Error: "Failed to build calendar"})
+		return
+	}
+
+	c.Header("Content-Disposition", `inline; filename="`+strings.ToLower(string(restaurant))+`.ics"`)
+	c.Data(http.StatusOK, "text/calendar; charset=utf-8", []byte(calendar))
+}
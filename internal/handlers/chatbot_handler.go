@@ -0,0 +1,52 @@
+package handlers
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/School-meal-lover/backend/internal/models"
+	"github.com/School-meal-lover/backend/internal/services"
+	"github.com/gin-gonic/gin"
+)
+
+type ChatbotHandler struct {
+	chatbotService *services.ChatbotService
+}
+
+func NewChatbotHandler(chatbotService *services.ChatbotService) *ChatbotHandler {
+	return &ChatbotHandler{chatbotService: chatbotService}
+}
+
+// @Summary      카카오톡 챗봇 스킬
+// @Description  카카오 i 오픈빌더 스킬 요청을 받아 "오늘 1식당 점심", "내일 저녁" 같은 발화를 해석하고 식단 카드로 응답합니다. 식당을 지정하지 않으면 모든 식당, 식사를 지정하지 않으면 하루 전체 식단을 보여줍니다.
+// @Tags         Chatbot
+// @Accept       json
+// @Produce      json
+// @Param        data body models.KakaoSkillRequest true "스킬 요청"
+// @Success      200 {object} models.KakaoSkillResponse "스킬 응답"
+// @Failure      400 {object} models.ErrorResponse "잘못된 요청"
+// @Router       /chatbot/kakao [post]
+func (h *ChatbotHandler) KakaoSkill(c *gin.Context) {
+	var req models.KakaoSkillRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{Success: false, Error: err.Error()})
+		return
+	}
+
+	cards, lang := h.chatbotService.Answer(req.UserRequest.Utterance, time.Now())
+	c.JSON(http.StatusOK, services.BuildKakaoResponse(cards, lang))
+}
+
+// @Summary      슬랙 슬래시 커맨드
+// @Description  슬랙 슬래시 커맨드(예: /menu tomorrow dinner restaurant 2)의 text를 해석해서 식단을 응답합니다. SLACK_SIGNING_SECRET이 설정되어 있으면 요청 서명을 검증합니다.
+// @Tags         Chatbot
+// @Accept       x-www-form-urlencoded
+// @Produce      json
+// @Param        text formData string false "커맨드 뒤에 입력한 문장"
+// @Success      200 {object} models.SlackCommandResponse "슬랙 응답"
+// @Failure      401 {object} models.ErrorResponse "서명 검증 실패"
+// @Router       /chatbot/slack [post]
+func (h *ChatbotHandler) SlackCommand(c *gin.Context) {
+	cards, _ := h.chatbotService.Answer(c.PostForm("text"), time.Now())
+	c.JSON(http.StatusOK, services.BuildSlackResponse(cards))
+}
@@ -0,0 +1,154 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/School-meal-lover/backend/internal/middleware"
+	"github.com/School-meal-lover/backend/internal/models"
+	"github.com/School-meal-lover/backend/internal/services"
+	"github.com/gin-gonic/gin"
+)
+
+// 기간을 지정하지 않은 휴무 목록 조회 범위
+const defaultClosureListDays = 90
+
+type ClosureHandler struct {
+	closureService *services.ClosureService
+	auditService   *services.AuditService
+}
+
+func NewClosureHandler(closureService *services.ClosureService, auditService *services.AuditService) *ClosureHandler {
+	return &ClosureHandler{closureService: closureService, auditService: auditService}
+}
+
+// @Summary      식당 휴무 목록
+// @Description  기간과 겹치는 식당 휴무(공휴일, 시험 기간, 방학 등)를 조회합니다. 기간을 지정하지 않으면 오늘부터 90일입니다.
+// @Tags         Closures
+// @Produce      json
+// @Param        name path string true "식당 이름 (RESTAURANT_1, RESTAURANT_2)"
+// @Param        from query string false "시작 날짜 (YYYY-MM-DD)" example(2025-06-01)
+// @Param        to query string false "종료 날짜 (YYYY-MM-DD)" example(2025-08-31)
+// @Success      200 {object} models.ClosureListResponse "조회 성공"
+// @Failure      400 {object} models.ErrorResponse "잘못된 식당 이름 또는 날짜"
+// @Failure      500 {object} models.ErrorResponse "서버 내부 오류 발생"
+// @Router       /restaurants/{name}/closures [get]
+func (h *ClosureHandler) ListClosures(c *gin.Context) {
+	restaurant, ok := models.ParseRestaurantType(c.Param("name"))
+	if !ok {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{Success: false, Error: "Invalid restaurant name"})
+		return
+	}
+
+	today, _ := time.Parse("2006-01-02", time.Now().In(time.FixedZone("KST", 9*60*60)).Format("2006-01-02"))
+	from, err := parseDateQuery(c, "from", today)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{Success: false, Error: err.Error()})
+		return
+	}
+	to, err := parseDateQuery(c, "to", from.AddDate(0, 0, defaultClosureListDays))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{Success: false, Error: err.Error()})
+		return
+	}
+
+	closures, err := h.closureService.List(restaurant, from, to)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, models.ErrorResponse{Success: false, Error: err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, models.ClosureListResponse{Success: true, Data: closures})
+}
+
+// @Summary      식당 휴무 등록
+// @Description  식당이 쉬는 날(meal_type 없음)이나 운영하지 않는 식사(meal_type 지정)를 기간으로 등록합니다. 등록된 휴무는 주간/하루 식단 응답에 closed 항목으로 표시되고, 휴무 기간의 식단을 업로드하면 경고가 붙습니다.
+// @Tags         Closures
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        data body models.ClosureRequest true "휴무 정보"
+// @Success      201 {object} models.ClosureResponse "등록 성공"
+// @Failure      400 {object} models.ErrorResponse "잘못된 요청"
+// @Failure      401 {object} models.ErrorResponse "Unauthorized - Invalid or missing token"
+// @Failure      403 {object} models.ErrorResponse "권한 없음"
+// @Failure      500 {object} models.ErrorResponse "서버 내부 오류 발생"
+// @Router       /admin/closures [post]
+func (h *ClosureHandler) CreateClosure(c *gin.Context) {
+	var req models.ClosureRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{Success: false, Error: err.Error()})
+		return
+	}
+
+	var createdBy string
+	if principal := middleware.CurrentPrincipal(c); principal != nil {
+		createdBy = principal.Subject
+	}
+	closure, err := h.closureService.Create(&req, createdBy)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		if services.IsValidationError(err) {
+			statusCode = http.StatusBadRequest
+		}
+		c.JSON(statusCode, models.ErrorResponse{Success: false, Error: err.Error()})
+		return
+	}
+
+	entry := newAuditLog(c, models.AuditClosureCreate)
+	entry.Restaurant = &closure.Restaurant
+	entry.Summary["closure_id"] = closure.ID
+	entry.Summary["start_date"] = closure.StartDate
+	entry.Summary["end_date"] = closure.EndDate
+	entry.Summary["meal_type"] = closure.MealType
+	entry.Summary["reason_ko"] = closure.ReasonKo
+	h.auditService.Record(entry)
+
+	c.JSON(http.StatusCreated, models.ClosureResponse{Success: true, Data: closure})
+}
+
+// @Summary      식당 휴무 삭제
+// @Tags         Closures
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path string true "휴무 ID"
+// @Success      204 "삭제 성공"
+// @Failure      401 {object} models.ErrorResponse "Unauthorized - Invalid or missing token"
+// @Failure      403 {object} models.ErrorResponse "권한 없음"
+// @Failure      404 {object} models.ErrorResponse "휴무를 찾을 수 없음"
+// @Failure      500 {object} models.ErrorResponse "서버 내부 오류 발생"
+// @Router       /admin/closures/{id} [delete]
+func (h *ClosureHandler) DeleteClosure(c *gin.Context) {
+	closure, err := h.closureService.Delete(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, models.ErrorResponse{Success: false, Error: err.Error()})
+		return
+	}
+	if closure == nil {
+		c.JSON(http.StatusNotFound, models.ErrorResponse{Success: false, Error: "closure not found"})
+		return
+	}
+
+	entry := newAuditLog(c, models.AuditClosureDelete)
+	entry.Restaurant = &closure.Restaurant
+	entry.Summary["closure_id"] = closure.ID
+	entry.Summary["start_date"] = closure.StartDate
+	entry.Summary["end_date"] = closure.EndDate
+	entry.Summary["meal_type"] = closure.MealType
+	h.auditService.Record(entry)
+
+	c.Status(http.StatusNoContent)
+}
+
+// 날짜 쿼리 파라미터 (없으면 fallback)
+func parseDateQuery(c *gin.Context, name string, fallback time.Time) (time.Time, error) {
+	value := c.Query(name)
+	if value == "" {
+		return fallback, nil
+	}
+	date, err := time.Parse("2006-01-02", value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid %s %q (expected YYYY-MM-DD)", name, value)
+	}
+	return date, nil
+}
@@ -0,0 +1,98 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/School-meal-lover/backend/internal/models"
+	"github.com/School-meal-lover/backend/internal/services"
+	"github.com/gin-gonic/gin"
+)
+
+type DocumentHandler struct {
+	documentService *services.DocumentService
+	uploadService   *services.UploadService
+	auditService    *services.AuditService
+}
+
+func NewDocumentHandler(documentService *services.DocumentService, uploadService *services.UploadService, auditService *services.AuditService) *DocumentHandler {
+	return &DocumentHandler{documentService: documentService, uploadService: uploadService, auditService: auditService}
+}
+
+// @Summary 주간 식단 문서(JSON/YAML) 업로드
+// @Description 한 주 식단 문서를 받아서 디비에 저장합니다. 문서는 GET /schemas/week-menu.json의 JSON Schema로 검사하며, 오류가 있으면 저장하지 않고 JSON Pointer 경로가 포함된 diagnostics로 400을 반환합니다. Content-Type이 application/yaml(또는 text/yaml)이면 YAML로 읽습니다. admin, approver 또는 해당 식당 staff 권한의 API 키나 JWT가 필요합니다. 저장된 식단은 draft 주차에 들어가고, 게시된 뒤에 조회 API에 나타납니다.
+// @Tags json
+// @Accept json
+// @Accept application/yaml
+// @Produce json
+// @Security BearerAuth
+// @Param document body object true "주간 식단 문서 (스키마: /api/v1/schemas/week-menu.json)"
+// @Success 200 {object} models.ExcelProcessResult "Week document processed successfully (warnings included)"
+// @Failure 400 {object} models.ParseErrorResponse "Invalid document (schema diagnostics)"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized - Invalid or missing token"
+// @Failure 403 {object} models.ErrorResponse "Forbidden - Not allowed to upload for this restaurant"
+// @Failure 413 {object} models.ErrorResponse "Request body is too large"
+// @Failure 500 {object} models.ErrorResponse "Failed to process document"
+// @Router /upload/json [post]
+func (h *DocumentHandler) UploadDocument(c *gin.Context) {
+	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, h.documentService.MaxUploadBytes())
+	body, err := c.GetRawData()
+	if err != nil {
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			c.JSON(http.StatusRequestEntityTooLarge, models.ErrorResponse{Success: false, Error: "Request body is too large"})
+			return
+		}
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{Success: false, Error: "Failed to read request body"})
+		return
+	}
+	if len(body) == 0 {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{Success: false, Error: "Document body is required"})
+		return
+	}
+
+	format := services.DocumentFormat(c.ContentType(), "", body)
+	menu, warnings, err := h.documentService.ParseDocument(body, format)
+	if err != nil {
+		respondTextError(c, err)
+		return
+	}
+	if !authorizeRestaurant(c, menu.Restaurant) {
+		return
+	}
+
+	result, err := h.documentService.ImportDocument(menu, warnings)
+	if err != nil {
+		respondTextError(c, err)
+		return
+	}
+
+	entry := newAuditLog(c, models.AuditUploadJSON)
+	entry.Restaurant = &menu.Restaurant
+	entry.WeekID = &result.WeekID
+	entry.Summary = auditSummary(result)
+	entry.Files = []models.AuditFile{services.AuditFileFromBytes("", body)}
+	h.auditService.Record(entry)
+
+	// 재처리를 위해 원본 보관
+	archiveUpload(c, h.uploadService, models.UploadKindJSON, menu.Restaurant, result.WeekID,
+		services.ArchivedFile{Role: models.UploadFileDocument, Name: "week." + string(format), Data: body})
+
+	c.JSON(http.StatusOK, result)
+}
+
+// @Summary 주간 식단 문서 JSON Schema
+// @Description POST /upload/json이 받는 문서의 JSON Schema (draft 2020-12). 식사 종류 코드는 현재 meal_types 설정을 따릅니다
+// @Tags json
+// @Produce json
+// @Success 200 {object} object "JSON Schema"
+// @Failure 500 {object} models.ErrorResponse "서버 내부 오류 발생"
+// @Router /schemas/week-menu.json [get]
+func (h *DocumentHandler) GetSchema(c *gin.Context) {
+	schema, err := h.documentService.Schema()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, models.ErrorResponse{Success: false, Error: err.Error()})
+		return
+	}
+	c.Data(http.StatusOK, "application/schema+json", schema)
+}
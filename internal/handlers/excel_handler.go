@@ -1,11 +1,14 @@
 package handlers
 
 import (
+	"errors"
 	"fmt"
+	"log"
 	"mime/multipart"
 	"net/http"
 	"os"
 	"path/filepath"
+	"strings"
 
 	"github.com/School-meal-lover/backend/internal/models"
 	"github.com/School-meal-lover/backend/internal/services"
@@ -13,7 +16,9 @@ import (
 )
 
 type ExcelHandler struct {
-	excelService *services.ExcelService
+	excelService  *services.ExcelService
+	uploadService *services.UploadService
+	auditService  *services.AuditService
 }
 type DualExcelProcessResponse struct {
 	Success  bool                      `json:"success"`
@@ -21,106 +26,126 @@ type DualExcelProcessResponse struct {
 	ResultEn models.ExcelProcessResult `json:"result_en"`
 }
 
-func NewExcelHandler(excelService *services.ExcelService) *ExcelHandler {
-	return &ExcelHandler{excelService: excelService}
+func NewExcelHandler(excelService *services.ExcelService, uploadService *services.UploadService, auditService *services.AuditService) *ExcelHandler {
+	return &ExcelHandler{excelService: excelService, uploadService: uploadService, auditService: auditService}
 }
 
 // @Summary 엑셀 처리 API
-// @Description 파일을 업로드 해서 식단 데이터를 디비에 저장한다.
+// @Description 파일을 업로드 해서 식단 데이터를 디비에 저장한다. .xlsx, .xls(Excel 97-2003), .ods, .csv 파일을 받으며 요청 본문 크기는 UPLOAD_MAX_BYTES(기본 20MB)로 제한된다. 저장된 식단은 draft 주차에 들어가고, 게시된 뒤에 조회 API에 나타난다.
 // @Tags excel
 // @Accept multipart/form-data
+// @Security BearerAuth
 // @Param excel_ko formData file true "한국어 엑셀 파일"
 // @Param excel_en formData file true "영어 엑셀 파일"
 // @Success 200 {object} DualExcelProcessResponse "Excel file processed successfully"
+// @Failure 400 {object} models.ErrorResponse "Invalid Excel file"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized - Invalid or missing token"
+// @Failure 403 {object} models.ErrorResponse "Forbidden - Not allowed to upload for this restaurant"
+// @Failure 413 {object} models.ErrorResponse "Request body is too large"
 // @Failure 500 {object} models.ErrorResponse "Failed to process Excel file"
 // @Router /upload/excel [post]
 func (h *ExcelHandler) UploadAndProcessExcel(c *gin.Context) {
+	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, h.excelService.MaxUploadBytes())
+
 	var files [2]*multipart.FileHeader
 	var err error
-	if err := os.MkdirAll("uploads", 0755); err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{
-			"success": false,
-			"error":   "failed to create uploads directory",
-		})
-		return
-	}
-
-	files[0], err = c.FormFile("excel_ko")
-	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{
-			"success": false,
-			"error":   "Korean Excel file is missing",
-		})
-		return
+	for i, field := range []string{"excel_ko", "excel_en"} {
+		files[i], err = c.FormFile(field)
+		if err != nil {
+			var maxBytesErr *http.MaxBytesError
+			if errors.As(err, &maxBytesErr) {
+				c.JSON(http.StatusRequestEntityTooLarge, gin.H{
+					"success": false,
+					"error":   fmt.Sprintf("request body is too large (max %d bytes)", h.excelService.MaxUploadBytes()),
+				})
+				return
+			}
+			c.JSON(http.StatusBadRequest, gin.H{
+				"success": false,
+				"error":   fmt.Sprintf("%s file is missing", field),
+			})
+			return
+		}
 	}
 
-	files[1], err = c.FormFile("excel_en")
+	// 요청마다 별도 임시 디렉터리에 저장해서 동시 업로드가 서로 덮어쓰지 않게 합니다
+	dir, err := os.MkdirTemp("", "excel-upload-*")
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{
+		c.JSON(http.StatusInternalServerError, gin.H{
 			"success": false,
-			"error":   "English Excel file is missing",
+			"error":   "failed to create upload directory",
 		})
 		return
 	}
+	defer os.RemoveAll(dir)
 
-	var fileKoPath, fileEnPath string
-	var savedFiles []string
-	defer func() {
-		for _, path := range savedFiles {
-			os.Remove(path)
-		}
-	}()
-	for i, file := range files[:2] {
-		if file == nil {
-			c.JSON(http.StatusBadRequest, gin.H{
-				"success": false,
-				"error":   fmt.Sprintf("file %d is missing", i),
-			})
-			return
-		}
-
-		ext := filepath.Ext(file.Filename)
-		if ext != ".xlsx" && ext != ".xls" {
-			c.JSON(http.StatusBadRequest, gin.H{
-				"success": false,
-				"error":   fmt.Sprintf("file %d has invalid extension", i),
-			})
-			return
-		}
-		savePath := "uploads/" + file.Filename
-		if err := c.SaveUploadedFile(file, savePath); err != nil {
+	var fileNames, filePaths [2]string
+	for i, file := range files {
+		// 클라이언트 파일 이름은 기록용으로만 쓰고 저장 경로에는 쓰지 않습니다
+		fileNames[i] = services.SanitizeFileName(file.Filename, "upload.xlsx")
+		filePaths[i] = filepath.Join(dir, fmt.Sprintf("%d%s", i, strings.ToLower(filepath.Ext(fileNames[i]))))
+		if err := c.SaveUploadedFile(file, filePaths[i]); err != nil {
 			c.JSON(http.StatusInternalServerError, gin.H{
 				"success": false,
-				"error":   fmt.Sprintf("failed to save file %d", i),
+				"error":   fmt.Sprintf("failed to save %s", fileNames[i]),
 			})
 			return
 		}
-		savedFiles = append(savedFiles, savePath)
-		if i == 0 {
-			fileKoPath = savePath
-		} else {
-			fileEnPath = savePath
+		if err := h.excelService.ValidateExcelFile(fileNames[i], filePaths[i]); err != nil {
+			respondExcelError(c, err)
+			return
 		}
 	}
+	fileKoPath, fileEnPath := filePaths[0], filePaths[1]
 
-	resultKo, err := h.excelService.ProcessExcelFile(fileKoPath)
+	// 담당 식당 확인 (두 파일은 같은 식당의 식단이어야 합니다)
+	restaurant, err := h.excelService.DetectRestaurant(fileKoPath)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{
+		respondExcelError(c, err)
+		return
+	}
+	restaurantEn, err := h.excelService.DetectRestaurant(fileEnPath)
+	if err != nil {
+		respondExcelError(c, err)
+		return
+	}
+	if restaurantEn != restaurant {
+		c.JSON(http.StatusBadRequest, gin.H{
 			"success": false,
-			"error":   "failed to process Korean Excel: " + err.Error(),
+			"error":   fmt.Sprintf("excel_ko is for %s but excel_en is for %s", restaurant, restaurantEn),
 		})
 		return
 	}
+	if !authorizeRestaurant(c, restaurant) {
+		return
+	}
 
-	resultEn, err := h.excelService.ProcessEnglishExcelFile(fileEnPath, resultKo.WeekID)
+	resultKo, resultEn, err := h.excelService.ProcessExcelFiles(fileKoPath, fileEnPath)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{
-			"success": false,
-			"error":   "failed to process English Excel: " + err.Error(),
-		})
+		respondExcelError(c, err)
 		return
 	}
 
+	entry := newAuditLog(c, models.AuditUploadExcel)
+	entry.Restaurant = &restaurant
+	entry.WeekID = &resultKo.WeekID
+	entry.Summary = auditSummary(resultKo)
+	for i, path := range []string{fileKoPath, fileEnPath} {
+		if file, err := services.AuditFileFromPath(fileNames[i], path); err == nil {
+			entry.Files = append(entry.Files, file)
+		}
+	}
+	h.auditService.Record(entry)
+
+	// 재처리를 위해 원본 보관
+	archivedKo, errKo := services.ArchivedFileFromPath(models.UploadFileKorean, fileNames[0], fileKoPath)
+	archivedEn, errEn := services.ArchivedFileFromPath(models.UploadFileEnglish, fileNames[1], fileEnPath)
+	if errKo == nil && errEn == nil {
+		archiveUpload(c, h.uploadService, models.UploadKindExcel, restaurant, resultKo.WeekID, archivedKo, archivedEn)
+	} else {
+		log.Printf("Failed to archive Excel upload: ko=%v en=%v", errKo, errEn)
+	}
+
 	c.JSON(http.StatusOK, gin.H{
 		"success":   true,
 		"result_ko": resultKo,
@@ -128,3 +153,14 @@ func (h *ExcelHandler) UploadAndProcessExcel(c *gin.Context) {
 	})
 }
 
+// 잘못된 파일은 400, 저장 실패 등 서버 오류는 500으로 응답합니다
+func respondExcelError(c *gin.Context, err error) {
+	statusCode := http.StatusInternalServerError
+	if services.IsValidationError(err) {
+		statusCode = http.StatusBadRequest
+	}
+	c.JSON(statusCode, gin.H{
+		"success": false,
+		"error":   err.Error(),
+	})
+}
@@ -0,0 +1,55 @@
+package handlers
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/School-meal-lover/backend/internal/models"
+	"github.com/School-meal-lover/backend/internal/services"
+	"github.com/gin-gonic/gin"
+)
+
+type FeedHandler struct {
+	feedService *services.FeedService
+}
+
+func NewFeedHandler(feedService *services.FeedService) *FeedHandler {
+	return &FeedHandler{feedService: feedService}
+}
+
+// @Summary      일별 식단 Atom 피드
+// @Description  식당의 최근 4주 및 예정된 식단을 Atom 피드로 제공합니다. 하루치 식단(모든 식사)이 하나의 항목이며, 해당 날짜의 식단이 업로드로 바뀌면 updated가 갱신됩니다.
+// @Tags         Meals
+// @Produce      application/atom+xml
+// @Param        name path string true "레스토랑 이름 (RESTAURANT_1, RESTAURANT_2)"
+// @Param        lang query string false "언어 (ko, en)" Enums(ko, en) default(ko)
+// @Success      200 {string} string "Atom 피드"
+// @Failure      400 {object} models.ErrorResponse "잘못된 식당 이름 또는 언어"
+// @Failure      500 {object} models.ErrorResponse "서버 내부 오류 발생"
+// @Router       /restaurants/{name}/feed.atom [get]
+func (h *FeedHandler) GetRestaurantFeed(c *gin.Context) {
+	restaurant, ok := models.ParseRestaurantType(c.Param("name"))
+	if !ok {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{Success: false, Error: "Invalid restaurant name"})
+		return
+	}
+	lang := strings.ToLower(c.DefaultQuery("lang", services.LangKorean))
+	if lang != services.LangKorean && lang != services.LangEnglish {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{Success: false, Error: "lang must be ko or en"})
+		return
+	}
+
+	scheme := "https"
+	if c.Request.TLS == nil && c.GetHeader("X-Forwarded-Proto") == "" {
+		scheme = "http"
+	}
+	selfURL := scheme + "://" + c.Request.Host + c.Request.URL.RequestURI()
+
+	feed, err := h.feedService.GetRestaurantFeed(restaurant, lang, selfURL, time.Now())
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, models.ErrorResponse{Success: false, Error: "Failed to build feed"})
+		return
+	}
+	c.Data(http.StatusOK, "application/atom+xml; charset=utf-8", feed)
+}
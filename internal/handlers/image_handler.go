@@ -1,83 +1,270 @@
 package handlers
 
 import (
+	"errors"
+	"io"
 	"net/http"
-	"strconv"
+	"strings"
+	"time"
 
 	"github.com/School-meal-lover/backend/internal/models"
 	"github.com/School-meal-lover/backend/internal/services"
+	"github.com/School-meal-lover/backend/internal/storage"
 	"github.com/gin-gonic/gin"
 )
 
 type ImageHandler struct {
 	imageService *services.ImageService
+	auditService *services.AuditService
 }
 
-func NewImageHandler(imageService *services.ImageService) *ImageHandler {
-	return &ImageHandler{imageService: imageService}
+func NewImageHandler(imageService *services.ImageService, auditService *services.AuditService) *ImageHandler {
+	return &ImageHandler{imageService: imageService, auditService: auditService}
 }
 
 // @Summary      이미지 이름 업로드
-// @Description  이미지 이름을 업로드합니다.
+// @Description  이미지 이름을 업로드합니다. date가 속한 주차에 연결되며, 생략하면 오늘 날짜 기준입니다.
 // @Tags         Images
 // @Accept	   json
 // @Produce      json
-// @Param restaurant_name query int true "레스토랑 번호" Enums(1, 2, 3)
+// @Security     BearerAuth
+// @Param restaurant_name query string true "레스토랑 이름 (RESTAURANT_1, RESTAURANT_2, 대소문자 관계없음. 이전 번호 1, 2도 허용)"
+// @Param date query string false "기준 날짜 (YYYY-MM-DD)"
 // @Param  data body models.ImageUploadRequest true "업로드할 이미지 이름"
 // @Success      200 {object} models.ImageInfoResponse "성공적으로 이미지 이름 업로드"
+// @Failure      400 {object} models.ErrorResponse "잘못된 요청"
 // @Failure      500 {object} models.ErrorResponse "서버 내부 오류 발생"
+// @Failure      401 {object} models.ErrorResponse "인증 실패"
+// @Failure      403 {object} models.ErrorResponse "해당 식당에 대한 권한 없음"
 // @Router       /images/upload [post]
 func (h *ImageHandler) UploadImageName(c *gin.Context) {
 	var requestBody models.ImageUploadRequest
 	if err := c.ShouldBindJSON(&requestBody); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		c.JSON(http.StatusBadRequest, gin.H{"success": false, "error": err.Error()})
 		return
 	}
-	restaurantNumberString := c.Query("restaurant_name")
-	if restaurantNumberString == "" {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "restaurant_name query parameter is required"})
+	restaurant, ok := restaurantQuery(c)
+	if !ok || !authorizeRestaurant(c, restaurant) {
 		return
 	}
-	restaurantNumber, err := strconv.Atoi(restaurantNumberString)
+	date, ok := imageDateQuery(c)
+	if !ok {
+		return
+	}
+
+	response, err := h.imageService.UploadImageName(requestBody.ImageName, restaurant, date)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"success": false, "error": err.Error()})
+		return
+	}
+	h.recordImageAudit(c, models.AuditImageUpload, restaurant, response, nil)
+	c.JSON(http.StatusOK, response)
+}
+
+// @Summary      이미지 파일 업로드
+// @Description  식단 사진 파일을 업로드합니다. 형식은 파일 내용으로 판별하며 JPEG, PNG, GIF만 허용합니다. 썸네일이 함께 생성됩니다.
+// @Tags         Images
+// @Accept       multipart/form-data
+// @Produce      json
+// @Security     BearerAuth
+// @Param restaurant_name query string true "레스토랑 이름 (RESTAURANT_1, RESTAURANT_2, 대소문자 관계없음. 이전 번호 1, 2도 허용)"
+// @Param date query string false "기준 날짜 (YYYY-MM-DD)"
+// @Param image formData file true "이미지 파일"
+// @Success      200 {object} models.ImageInfoResponse "성공적으로 이미지 업로드"
+// @Failure      400 {object} models.ErrorResponse "잘못된 요청"
+// @Failure      413 {object} models.ErrorResponse "파일 크기 초과"
+// @Failure      500 {object} models.ErrorResponse "서버 내부 오류 발생"
+// @Failure      401 {object} models.ErrorResponse "인증 실패"
+// @Failure      403 {object} models.ErrorResponse "해당 식당에 대한 권한 없음"
+// @Router       /images/upload/file [post]
+func (h *ImageHandler) UploadImageFile(c *gin.Context) {
+	restaurant, ok := restaurantQuery(c)
+	if !ok || !authorizeRestaurant(c, restaurant) {
+		return
+	}
+	date, ok := imageDateQuery(c)
+	if !ok {
+		return
+	}
+
+	maxBytes := h.imageService.MaxUploadBytes()
+	// multipart 헤더 여유분을 더해서 본문 전체 크기를 제한합니다
+	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, maxBytes+1<<20)
+	fileHeader, err := c.FormFile("image")
+	if err != nil {
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			c.JSON(http.StatusRequestEntityTooLarge, gin.H{"success": false, "error": "image file is too large"})
+			return
+		}
+		c.JSON(http.StatusBadRequest, gin.H{"success": false, "error": "image file is missing"})
+		return
+	}
+	if fileHeader.Size > maxBytes {
+		c.JSON(http.StatusRequestEntityTooLarge, gin.H{"success": false, "error": "image file is too large"})
+		return
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"success": false, "error": "failed to read uploaded file"})
+		return
+	}
+	defer file.Close()
+	data, err := io.ReadAll(io.LimitReader(file, maxBytes+1))
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid restaurant_name query parameter"})
+		c.JSON(http.StatusInternalServerError, gin.H{"success": false, "error": "failed to read uploaded file"})
 		return
 	}
 
-	imageName := requestBody.ImageName
-	response, err := h.imageService.UploadImageName(imageName, restaurantNumber-1)
+	auditFile := services.AuditFileFromBytes(fileHeader.Filename, data)
+	response, err := h.imageService.UploadImageFile(c.Request.Context(), restaurant, date, fileHeader.Filename, data)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		if services.IsValidationError(err) {
+			c.JSON(http.StatusBadRequest, gin.H{"success": false, "error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"success": false, "error": err.Error()})
 		return
 	}
+	h.recordImageAudit(c, models.AuditImageUploadFile, restaurant, response, &auditFile)
 	c.JSON(http.StatusOK, response)
 }
 
+// @Summary      이미지 파일 조회
+// @Description  업로드한 이미지 원본 또는 썸네일 파일을 내려줍니다.
+// @Tags         Images
+// @Produce      image/jpeg,image/png,image/gif
+// @Param key path string true "이미지 키 (응답의 image_url, thumbnail_url 경로)"
+// @Success      200 {file} file "이미지 파일"
+// @Failure      404 {object} models.ErrorResponse "이미지를 찾을 수 없음"
+// @Failure      500 {object} models.ErrorResponse "서버 내부 오류 발생"
+// @Router       /images/files/{key} [get]
+func (h *ImageHandler) GetImageFile(c *gin.Context) {
+	key := strings.TrimPrefix(c.Param("key"), "/")
+
+	reader, contentType, err := h.imageService.OpenImageFile(c.Request.Context(), key)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"success": false, "error": "image not found"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"success": false, "error": err.Error()})
+		return
+	}
+	defer reader.Close()
+
+	// 키에 UUID가 들어가므로 같은 키의 내용은 바뀌지 않습니다
+	c.Header("Cache-Control", "public, max-age=31536000, immutable")
+	c.DataFromReader(http.StatusOK, -1, contentType, reader, nil)
+}
+
 // @Summary      현재 이미지 이름 조회
-// @Description  현재 저장된 이미지 이름을 조회합니다.
+// @Description  해당 날짜에 유효한 이미지 이름을 조회합니다. date를 생략하면 오늘 날짜 기준입니다.
 // @Tags         Images
 // @Accept	   json
 // @Produce      json
-// @Param restaurant_name query int true "레스토랑 번호" Enums(1, 2, 3)
+// @Param restaurant_name query string true "레스토랑 이름 (RESTAURANT_1, RESTAURANT_2, 대소문자 관계없음. 이전 번호 1, 2도 허용)"
+// @Param date query string false "기준 날짜 (YYYY-MM-DD)"
 // @Success      200 {object} models.ImageInfoResponse "성공적으로 현재 이미지 이름 조회"
+// @Failure      400 {object} models.ErrorResponse "잘못된 요청"
+// @Failure      404 {object} models.ErrorResponse "해당 날짜에 유효한 이미지가 없음"
 // @Failure      500 {object} models.ErrorResponse "서버 내부 오류 발생"
 // @Router       /images/current [get]
 func (h *ImageHandler) GetCurrentImageName(c *gin.Context) {
-	restaurantNumberString := c.Query("restaurant_name")
-	if restaurantNumberString == "" {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "restaurant_name query parameter is required"})
+	restaurant, ok := restaurantQuery(c)
+	if !ok {
+		return
+	}
+	date, ok := imageDateQuery(c)
+	if !ok {
 		return
 	}
-	restaurantNumber, err := strconv.Atoi(restaurantNumberString)
+
+	response, err := h.imageService.GetCurrentImageName(restaurant, date)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid restaurant_name query parameter"})
+		c.JSON(http.StatusInternalServerError, gin.H{"success": false, "error": err.Error()})
+		return
+	}
+	if response == nil {
+		c.JSON(http.StatusNotFound, gin.H{"success": false, "error": "no image registered for this restaurant and date"})
+		return
+	}
+	c.JSON(http.StatusOK, response)
+}
+
+// @Summary      이미지 업로드 기록 조회
+// @Description  식당별 이미지 업로드 기록을 최신순으로 조회합니다.
+// @Tags         Images
+// @Produce      json
+// @Param restaurant_name query string true "레스토랑 이름 (RESTAURANT_1, RESTAURANT_2, 대소문자 관계없음. 이전 번호 1, 2도 허용)"
+// @Success      200 {object} models.ImageHistoryResponse "이미지 업로드 기록"
+// @Failure      400 {object} models.ErrorResponse "잘못된 요청"
+// @Failure      500 {object} models.ErrorResponse "서버 내부 오류 발생"
+// @Router       /images/history [get]
+func (h *ImageHandler) GetImageHistory(c *gin.Context) {
+	restaurant, ok := restaurantQuery(c)
+	if !ok {
 		return
 	}
 
-	response, err := h.imageService.GetCurrentImageName(restaurantNumber - 1)
+	response, err := h.imageService.GetImageHistory(restaurant)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		c.JSON(http.StatusInternalServerError, gin.H{"success": false, "error": err.Error()})
 		return
 	}
 	c.JSON(http.StatusOK, response)
 }
+
+// 이전 이미지 API는 식당 번호로 식당을 구분했으므로 이미지 API에서만 번호도 받습니다
+var legacyImageRestaurants = map[string]models.RestaurantType{
+	"1": models.Restaurant1,
+	"2": models.Restaurant2,
+}
+
+// restaurant_name 쿼리 파라미터 (/restaurants/:name과 같은 식당 이름, 이전 식당 번호)
+func restaurantQuery(c *gin.Context) (models.RestaurantType, bool) {
+	name := c.Query("restaurant_name")
+	if name == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"success": false, "error": "restaurant_name query parameter is required"})
+		return "", false
+	}
+	restaurant, ok := models.ParseRestaurantType(name)
+	if !ok {
+		restaurant, ok = legacyImageRestaurants[strings.TrimSpace(name)]
+	}
+	if !ok {
+		c.JSON(http.StatusBadRequest, gin.H{"success": false, "error": "unknown restaurant: " + name})
+		return "", false
+	}
+	return restaurant, true
+}
+
+// date 쿼리 파라미터 (생략하면 한국 시간 기준 오늘)
+func imageDateQuery(c *gin.Context) (time.Time, bool) {
+	dateString := c.Query("date")
+	if dateString == "" {
+		now := time.Now().In(time.FixedZone("KST", 9*60*60))
+		return time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC), true
+	}
+	date, err := time.Parse("2006-01-02", dateString)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"success": false, "error": "invalid date format. Use YYYY-MM-DD"})
+		return time.Time{}, false
+	}
+	return date, true
+}
+
+func (h *ImageHandler) recordImageAudit(c *gin.Context, action string, restaurant models.RestaurantType, response *models.ImageInfoResponse, file *models.AuditFile) {
+	entry := newAuditLog(c, action)
+	entry.Restaurant = &restaurant
+	if response.WeekID != "" {
+		entry.WeekID = &response.WeekID
+	}
+	entry.Summary["image_name"] = response.ImageName
+	entry.Summary["valid_from"] = response.ValidFrom
+	if file != nil {
+		entry.Files = []models.AuditFile{*file}
+	}
+	h.auditService.Record(entry)
+}
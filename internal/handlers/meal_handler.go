@@ -3,6 +3,7 @@ package handlers
 import (
 	"net/http"
 	"strings"
+	"time"
 
 	"github.com/School-meal-lover/backend/internal/models"
 	"github.com/School-meal-lover/backend/internal/services"
@@ -18,7 +19,7 @@ func NewMealHandler(mealService *services.MealService) *MealHandler {
 }
 
 // @Summary      특정 식당의 주간 식단 조회
-// @Description  경로 파라미터로 받은 식당 이름과 쿼리로 받은 날짜를 기준으로 주간 식단을 조회합니다. Restaurant1은 평일만(월~금, 5일), Restaurant2는 주말 포함(월~일, 7일) 조회됩니다.
+// @Description  경로 파라미터로 받은 식당 이름과 쿼리로 받은 날짜를 기준으로 주간 식단을 조회합니다. Restaurant1은 평일만(월~금, 5일), Restaurant2는 주말 포함(월~일, 7일) 조회됩니다. 등록된 휴무일은 closed 항목으로, 공휴일은 holiday가 붙은 항목으로 포함됩니다.
 // @Tags         Meals
 // @Accept       json
 // @Produce      json
@@ -77,3 +78,41 @@ func (h *MealHandler) GetRestaurantMeals(c *gin.Context) {
 
 	c.JSON(statusCode, response)
 }
+
+// @Summary      특정 식당의 하루 식단 조회
+// @Description  하루 식단을 식사 종류별로 조회합니다. 휴무일은 closed와 휴무 사유(closure)가 담긴 항목으로, 운영하지 않는 식사는 closed 식사 항목으로 응답합니다. 공휴일에는 holiday가 붙고, 휴무 등록과 식단이 모두 없으면 likely_closed로 표시됩니다.
+// @Tags         Meals
+// @Produce      json
+// @Param        name path string true "레스토랑 이름 (RESTAURANT_1, RESTAURANT_2)"
+// @Param        date query string false "조회할 날짜 (YYYY-MM-DD 형식, 기본값 오늘)" example:"2025-06-06"
+// @Success      200 {object} models.DailyMealsResponse "성공적으로 식단 정보 조회"
+// @Failure      400 {object} models.ErrorResponse "잘못된 요청 파라미터 (식당 이름 또는 날짜 형식 오류)"
+// @Failure      404 {object} models.ErrorResponse "해당 날짜의 식단, 휴무, 공휴일 정보가 없음"
+// @Failure      500 {object} models.ErrorResponse "서버 내부 오류 발생"
+// @Router       /restaurants/{name}/daily [get]
+func (h *MealHandler) GetRestaurantDailyMeals(c *gin.Context) {
+	date := c.Query("date")
+	if date == "" {
+		date = time.Now().In(time.FixedZone("KST", 9*60*60)).Format("2006-01-02")
+	}
+
+	response, err := h.mealService.GetRestaurantDailyMeals(c.Param("name"), date)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, models.DailyMealsResponse{
+			Success: false,
+			Error:   "Internal server error",
+			Code:    "INTERNAL_ERROR",
+		})
+		return
+	}
+	statusCode := http.StatusOK
+	if !response.Success {
+		switch response.Code {
+		case "DAY_DATA_NOT_FOUND":
+			statusCode = http.StatusNotFound
+		default:
+			statusCode = http.StatusBadRequest
+		}
+	}
+	c.JSON(statusCode, response)
+}
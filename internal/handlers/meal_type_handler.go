@@ -0,0 +1,89 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/School-meal-lover/backend/internal/models"
+	"github.com/School-meal-lover/backend/internal/services"
+	"github.com/gin-gonic/gin"
+)
+
+type MealTypeHandler struct {
+	mealTypeService *services.MealTypeService
+	auditService    *services.AuditService
+}
+
+func NewMealTypeHandler(mealTypeService *services.MealTypeService, auditService *services.AuditService) *MealTypeHandler {
+	return &MealTypeHandler{mealTypeService: mealTypeService, auditService: auditService}
+}
+
+// @Summary      식당의 식사 종류 목록
+// @Description  식당에서 운영하는 식사 종류(코드, 한국어/영어 이름, 표시 순서, 배식 시간)를 표시 순서대로 조회합니다.
+// @Tags         MealTypes
+// @Produce      json
+// @Param        name path string true "식당 이름 (RESTAURANT_1, RESTAURANT_2)"
+// @Success      200 {object} models.MealTypeListResponse "조회 성공"
+// @Failure      400 {object} models.ErrorResponse "잘못된 식당 이름"
+// @Failure      500 {object} models.ErrorResponse "서버 내부 오류 발생"
+// @Router       /restaurants/{name}/meal-types [get]
+func (h *MealTypeHandler) ListMealTypes(c *gin.Context) {
+	restaurant, ok := models.ParseRestaurantType(c.Param("name"))
+	if !ok {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{Success: false, Error: "Invalid restaurant name"})
+		return
+	}
+
+	mealTypes, err := h.mealTypeService.List(restaurant)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, models.ErrorResponse{Success: false, Error: err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, models.MealTypeListResponse{Success: true, Data: mealTypes})
+}
+
+// @Summary      식사 종류 추가/수정
+// @Description  식당의 식사 종류를 추가하거나 수정합니다. 새 코드(예: Late_snack)를 추가하면 텍스트/JSON 업로드에서 바로 쓸 수 있고, 엑셀 행 범위를 지정하면 엑셀 업로드에서도 읽습니다.
+// @Tags         MealTypes
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        restaurant path string true "식당 이름 (RESTAURANT_1, RESTAURANT_2)"
+// @Param        code path string true "식사 종류 코드" example(Late_snack)
+// @Param        data body models.MealTypeRequest true "식사 종류 설정"
+// @Success      200 {object} models.MealTypeResponse "저장 성공"
+// @Failure      400 {object} models.ErrorResponse "잘못된 요청"
+// @Failure      401 {object} models.ErrorResponse "Unauthorized - Invalid or missing token"
+// @Failure      403 {object} models.ErrorResponse "권한 없음"
+// @Failure      500 {object} models.ErrorResponse "서버 내부 오류 발생"
+// @Router       /admin/meal-types/{restaurant}/{code} [put]
+func (h *MealTypeHandler) UpsertMealType(c *gin.Context) {
+	restaurant, ok := models.ParseRestaurantType(c.Param("restaurant"))
+	if !ok {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{Success: false, Error: "Invalid restaurant name"})
+		return
+	}
+	var req models.MealTypeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{Success: false, Error: err.Error()})
+		return
+	}
+
+	mealType, err := h.mealTypeService.Upsert(restaurant, c.Param("code"), &req)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		if services.IsValidationError(err) {
+			statusCode = http.StatusBadRequest
+		}
+		c.JSON(statusCode, models.ErrorResponse{Success: false, Error: err.Error()})
+		return
+	}
+
+	entry := newAuditLog(c, models.AuditMealTypeUpdate)
+	entry.Restaurant = &restaurant
+	entry.Summary["code"] = mealType.Code
+	entry.Summary["label_ko"] = mealType.LabelKo
+	entry.Summary["serving"] = mealType.ServingStart + "-" + mealType.ServingEnd
+	h.auditService.Record(entry)
+
+	c.JSON(http.StatusOK, models.MealTypeResponse{Success: true, Data: mealType})
+}
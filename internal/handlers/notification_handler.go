@@ -0,0 +1,90 @@
+package handlers
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/School-meal-lover/backend/internal/auth"
+	"github.com/School-meal-lover/backend/internal/middleware"
+	"github.com/School-meal-lover/backend/internal/models"
+	"github.com/School-meal-lover/backend/internal/services"
+	"github.com/gin-gonic/gin"
+)
+
+type NotificationHandler struct {
+	notificationService *services.NotificationService
+}
+
+func NewNotificationHandler(notificationService *services.NotificationService) *NotificationHandler {
+	return &NotificationHandler{notificationService: notificationService}
+}
+
+// @Summary      푸시 알림 구독
+// @Description  디바이스 토큰과 즐겨찾기 메뉴를 등록합니다. 같은 디바이스 토큰으로 다시 요청하면 설정을 덮어씁니다. 새 식단이 업로드되면 즐겨찾기 메뉴가 포함된 경우 알림을 보내고, restaurant를 지정한 경우 이미 게시된 날짜의 식단이 바뀌면 변경 알림을 보냅니다.
+// @Tags         Notifications
+// @Accept       json
+// @Produce      json
+// @Param        data body models.NotificationSubscribeRequest true "구독 정보"
+// @Success      200 {object} models.NotificationSubscriberResponse "구독 성공"
+// @Failure      400 {object} models.ErrorResponse "잘못된 요청"
+// @Failure      500 {object} models.ErrorResponse "서버 내부 오류 발생"
+// @Router       /notifications/subscribers [post]
+func (h *NotificationHandler) Subscribe(c *gin.Context) {
+	var req models.NotificationSubscribeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{Success: false, Error: err.Error()})
+		return
+	}
+	if req.Platform != models.PlatformFCM && req.Platform != models.PlatformAPNs {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{Success: false, Error: "platform must be fcm or apns"})
+		return
+	}
+	if req.Restaurant != "" {
+		req.Restaurant = strings.ToUpper(req.Restaurant)
+		restaurant := models.RestaurantType(req.Restaurant)
+		if restaurant != models.Restaurant1 && restaurant != models.Restaurant2 {
+			c.JSON(http.StatusBadRequest, models.ErrorResponse{Success: false, Error: "invalid restaurant"})
+			return
+		}
+	}
+
+	subscriber, err := h.notificationService.Subscribe(&req)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, models.ErrorResponse{Success: false, Error: err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, models.NotificationSubscriberResponse{Success: true, Data: subscriber})
+}
+
+// @Summary      푸시 알림 구독 해지
+// @Description  등록된 구독자와 즐겨찾기 메뉴를 삭제합니다. 앱은 X-Device-Token 헤더에 구독할 때 쓴 디바이스 토큰을 보내고, admin 키로는 토큰 없이 삭제할 수 있습니다.
+// @Tags         Notifications
+// @Produce      json
+// @Param        id path string true "구독자 ID"
+// @Param        X-Device-Token header string false "구독한 디바이스 토큰 (admin 키가 없을 때 필수)"
+// @Success      204 "구독 해지 성공"
+// @Failure      401 {object} models.ErrorResponse "디바이스 토큰 또는 admin 키가 없음"
+// @Failure      404 {object} models.ErrorResponse "구독자를 찾을 수 없음"
+// @Failure      500 {object} models.ErrorResponse "서버 내부 오류 발생"
+// @Router       /notifications/subscribers/{id} [delete]
+func (h *NotificationHandler) Unsubscribe(c *gin.Context) {
+	// 다른 디바이스의 구독인지 알려주지 않도록 토큰이 맞지 않으면 404로 응답합니다
+	deviceToken := c.GetHeader("X-Device-Token")
+	if principal := middleware.CurrentPrincipal(c); principal != nil && principal.HasRole(auth.RoleAdmin) {
+		deviceToken = ""
+	} else if strings.TrimSpace(deviceToken) == "" {
+		c.JSON(http.StatusUnauthorized, models.ErrorResponse{Success: false, Error: "X-Device-Token header or an admin key is required"})
+		return
+	}
+
+	deleted, err := h.notificationService.Unsubscribe(c.Param("id"), deviceToken)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, models.ErrorResponse{Success: false, Error: err.Error()})
+		return
+	}
+	if !deleted {
+		c.JSON(http.StatusNotFound, models.ErrorResponse{Success: false, Error: "subscriber not found"})
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
@@ -1,37 +1,52 @@
 package handlers
 
 import (
+	"errors"
 	"net/http"
 
 	"github.com/School-meal-lover/backend/internal/models"
 	"github.com/School-meal-lover/backend/internal/services"
+	"github.com/School-meal-lover/backend/internal/textformat"
 	"github.com/gin-gonic/gin"
 )
 
 type TextHandler struct {
-	textService *services.TextService
+	textService   *services.TextService
+	uploadService *services.UploadService
+	auditService  *services.AuditService
 }
 
-func NewTextHandler(textService *services.TextService) *TextHandler {
-	return &TextHandler{textService: textService}
+func NewTextHandler(textService *services.TextService, uploadService *services.UploadService, auditService *services.AuditService) *TextHandler {
+	return &TextHandler{textService: textService, uploadService: uploadService, auditService: auditService}
 }
 
 // @Summary 텍스트로 식단 데이터 업로드
-// @Description plain text로 한 주치 식단 데이터를 받아서 디비에 저장합니다. Bearer token 인증이 필요합니다.
+// @Description plain text로 한 주치 식단 데이터를 받아서 디비에 저장합니다. admin, approver 또는 해당 식당 staff 권한의 API 키나 JWT가 필요합니다. 저장된 식단은 draft 주차에 들어가고, 게시된 뒤에 조회 API에 나타납니다. 형식 오류(알 수 없는 식사 종류, 주차 밖의 날짜, 요일 불일치, 중복된 날짜 등)가 하나라도 있으면 저장하지 않고 줄 번호가 포함된 diagnostics로 400을 반환합니다. 첫 줄에 "#format v2"를 쓰면 "돈까스 | Pork Cutlet ; category=메인메뉴 ; price=5000 ; allergens=1,2,5" 형식으로 영어 이름과 속성을 함께 보낼 수 있습니다.
 // @Tags text
 // @Accept text/plain
 // @Produce json
 // @Security BearerAuth
 // @Param text body string true "식단 텍스트 데이터" example:"RESTAURANT_1\n2025-05-26\nMonday 2025-05-26\nBreakfast\n밥\n국\n반찬\nLunch_1\n메인메뉴\nLunch_2\n밥\n국\n메인메뉴\n반찬\nDinner\n밥\n국\n메인메뉴\n반찬"
-// @Success 200 {object} models.ExcelProcessResult "Text processed successfully"
-// @Failure 400 {object} models.ErrorResponse "Invalid request body or format"
+// @Success 200 {object} models.ExcelProcessResult "Text processed successfully (warnings included)"
+// @Failure 400 {object} models.ParseErrorResponse "Invalid text format (line-numbered diagnostics)"
 // @Failure 401 {object} models.ErrorResponse "Unauthorized - Invalid or missing token"
+// @Failure 403 {object} models.ErrorResponse "Forbidden - Not allowed to upload for this restaurant"
+// @Failure 413 {object} models.ErrorResponse "Request body is too large"
 // @Failure 500 {object} models.ErrorResponse "Failed to process text"
 // @Router /upload/text [post]
 func (h *TextHandler) UploadText(c *gin.Context) {
 	// 텍스트 데이터 읽기
+	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, h.textService.MaxUploadBytes())
 	body, err := c.GetRawData()
 	if err != nil {
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			c.JSON(http.StatusRequestEntityTooLarge, models.ErrorResponse{
+				Success: false,
+				Error:   "Request body is too large",
+			})
+			return
+		}
 		c.JSON(http.StatusBadRequest, models.ErrorResponse{
 			Success: false,
 			Error:   "Failed to read request body",
@@ -48,16 +63,54 @@ func (h *TextHandler) UploadText(c *gin.Context) {
 		return
 	}
 
+	// 담당 식당 확인
+	restaurant, err := h.textService.DetectRestaurant(text)
+	if err != nil {
+		respondTextError(c, err)
+		return
+	}
+	if !authorizeRestaurant(c, restaurant) {
+		return
+	}
+
 	// 텍스트 처리
 	result, err := h.textService.ProcessText(text)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, models.ErrorResponse{
-			Success: false,
-			Error:   "Failed to process text: " + err.Error(),
-		})
+		respondTextError(c, err)
 		return
 	}
 
+	entry := newAuditLog(c, models.AuditUploadText)
+	entry.Restaurant = &restaurant
+	entry.WeekID = &result.WeekID
+	entry.Summary = auditSummary(result)
+	entry.Files = []models.AuditFile{services.AuditFileFromBytes("", body)}
+	h.auditService.Record(entry)
+
+	// 재처리를 위해 원본 보관
+	archiveUpload(c, h.uploadService, models.UploadKindText, restaurant, result.WeekID,
+		services.ArchivedFile{Role: models.UploadFileText, Name: "body.txt", Data: body})
+
 	c.JSON(http.StatusOK, result)
 }
 
+// 형식 오류는 줄 번호가 포함된 400으로, 저장 실패는 500으로 응답합니다
+func respondTextError(c *gin.Context, err error) {
+	var formatErr *textformat.Error
+	if errors.As(err, &formatErr) {
+		c.JSON(http.StatusBadRequest, models.ParseErrorResponse{
+			Success:     false,
+			Error:       formatErr.Error(),
+			Diagnostics: formatErr.Diagnostics,
+		})
+		return
+	}
+	if services.IsValidationError(err) {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{Success: false, Error: err.Error()})
+		return
+	}
+	c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+		Success: false,
+		Error:   "Failed to process text: " + err.Error(),
+	})
+}
@@ -0,0 +1,105 @@
+package handlers
+
+import (
+	"errors"
+	"log"
+	"net/http"
+
+	"github.com/School-meal-lover/backend/internal/middleware"
+	"github.com/School-meal-lover/backend/internal/models"
+	"github.com/School-meal-lover/backend/internal/services"
+	"github.com/School-meal-lover/backend/internal/textformat"
+	"github.com/gin-gonic/gin"
+)
+
+type UploadHandler struct {
+	uploadService *services.UploadService
+	auditService  *services.AuditService
+}
+
+func NewUploadHandler(uploadService *services.UploadService, auditService *services.AuditService) *UploadHandler {
+	return &UploadHandler{uploadService: uploadService, auditService: auditService}
+}
+
+// @Summary      주차별 원본 업로드 목록
+// @Description  주차에 올라온 엑셀/텍스트 원본 업로드 기록을 최신순으로 조회합니다. admin 권한이 필요합니다.
+// @Tags         Admin
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path string true "주차 ID"
+// @Success      200 {object} models.UploadListResponse "조회 성공"
+// @Failure      401 {object} models.ErrorResponse "Unauthorized - Invalid or missing token"
+// @Failure      403 {object} models.ErrorResponse "Forbidden - admin role required"
+// @Failure      404 {object} models.ErrorResponse "잘못된 주차 ID"
+// @Failure      500 {object} models.ErrorResponse "서버 내부 오류 발생"
+// @Router       /admin/weeks/{id}/uploads [get]
+func (h *UploadHandler) ListWeekUploads(c *gin.Context) {
+	uploads, err := h.uploadService.ListByWeek(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, models.ErrorResponse{Success: false, Error: err.Error()})
+		return
+	}
+	if uploads == nil {
+		c.JSON(http.StatusNotFound, models.ErrorResponse{Success: false, Error: "week not found"})
+		return
+	}
+	c.JSON(http.StatusOK, models.UploadListResponse{Success: true, Data: uploads})
+}
+
+// @Summary      원본 업로드 재처리
+// @Description  보관된 원본 파일을 현재 파서로 다시 처리해 식단 데이터를 덮어씁니다. admin 권한이 필요합니다.
+// @Tags         Admin
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path string true "업로드 ID"
+// @Success      200 {object} models.ReprocessResponse "재처리 성공"
+// @Failure      400 {object} models.ParseErrorResponse "원본을 현재 파서로 처리할 수 없음"
+// @Failure      401 {object} models.ErrorResponse "Unauthorized - Invalid or missing token"
+// @Failure      403 {object} models.ErrorResponse "Forbidden - admin role required"
+// @Failure      404 {object} models.ErrorResponse "업로드를 찾을 수 없음"
+// @Failure      500 {object} models.ErrorResponse "재처리 실패"
+// @Router       /admin/uploads/{id}/reprocess [post]
+func (h *UploadHandler) ReprocessUpload(c *gin.Context) {
+	upload, results, err := h.uploadService.Reprocess(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		// 현재 파서로 읽을 수 없는 원본은 요청 오류로 응답합니다
+		var formatErr *textformat.Error
+		if errors.As(err, &formatErr) {
+			respondTextError(c, err)
+			return
+		}
+		statusCode := http.StatusInternalServerError
+		if services.IsValidationError(err) {
+			statusCode = http.StatusBadRequest
+		}
+		c.JSON(statusCode, models.ErrorResponse{Success: false, Error: "Failed to reprocess upload: " + err.Error()})
+		return
+	}
+	if upload == nil {
+		c.JSON(http.StatusNotFound, models.ErrorResponse{Success: false, Error: "upload not found"})
+		return
+	}
+
+	entry := newAuditLog(c, models.AuditUploadReprocess)
+	entry.Restaurant = &upload.Restaurant
+	entry.WeekID = &results[0].WeekID
+	entry.Summary = auditSummary(results[0])
+	entry.Summary["upload_id"] = upload.ID
+	for _, file := range upload.Files {
+		entry.Files = append(entry.Files, models.AuditFile{Name: file.FileName, SHA256: file.SHA256, Size: file.SizeBytes})
+	}
+	h.auditService.Record(entry)
+
+	c.JSON(http.StatusOK, models.ReprocessResponse{Success: true, Upload: upload, Results: results})
+}
+
+// 처리에 성공한 업로드 원본 보관 (보관에 실패해도 업로드는 성공으로 응답합니다)
+func archiveUpload(c *gin.Context, uploadService *services.UploadService, kind string, restaurant models.RestaurantType, weekID string, files ...services.ArchivedFile) {
+	uploadedBy := ""
+	if principal := middleware.CurrentPrincipal(c); principal != nil {
+		uploadedBy = principal.Subject
+	}
+	if _, err := uploadService.Archive(c.Request.Context(), kind, restaurant, weekID, uploadedBy, files...); err != nil {
+		log.Printf("Failed to archive %s upload for week %s: %v", kind, weekID, err)
+	}
+}
@@ -0,0 +1,125 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/School-meal-lover/backend/internal/models"
+	"github.com/School-meal-lover/backend/internal/services"
+	"github.com/gin-gonic/gin"
+)
+
+type WebhookHandler struct {
+	webhookService *services.WebhookService
+	auditService   *services.AuditService
+}
+
+func NewWebhookHandler(webhookService *services.WebhookService, auditService *services.AuditService) *WebhookHandler {
+	return &WebhookHandler{webhookService: webhookService, auditService: auditService}
+}
+
+// @Summary      웹훅 구독 등록
+// @Description  식단 게시/변경, 이미지 변경 시 호출될 URL을 등록합니다. 이벤트 종류는 week.published, week.updated, image.updated 입니다. 요청 본문은 X-Grrrr-Signature 헤더에 HMAC-SHA256(secret, "<X-Grrrr-Timestamp>.<body>") 서명(sha256=hex)과 함께 전송됩니다. secret을 비우면 서버가 생성하며 응답에서 한 번만 확인할 수 있습니다.
+// @Tags         Webhooks
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        data body models.WebhookCreateRequest true "구독 정보"
+// @Success      201 {object} models.WebhookSubscriptionResponse "구독 등록 성공"
+// @Failure      400 {object} models.ErrorResponse "잘못된 요청"
+// @Failure      401 {object} models.ErrorResponse "Unauthorized - Invalid or missing token"
+// @Failure      500 {object} models.ErrorResponse "서버 내부 오류 발생"
+// @Router       /webhooks [post]
+func (h *WebhookHandler) CreateSubscription(c *gin.Context) {
+	var req models.WebhookCreateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{Success: false, Error: err.Error()})
+		return
+	}
+
+	subscription, err := h.webhookService.CreateSubscription(&req)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		if services.IsValidationError(err) {
+			statusCode = http.StatusBadRequest
+		}
+		c.JSON(statusCode, models.ErrorResponse{Success: false, Error: err.Error()})
+		return
+	}
+	entry := newAuditLog(c, models.AuditWebhookCreate)
+	entry.Summary["subscription_id"] = subscription.ID
+	entry.Summary["url"] = subscription.URL
+	entry.Summary["event_types"] = subscription.EventTypes
+	h.auditService.Record(entry)
+
+	c.JSON(http.StatusCreated, models.WebhookSubscriptionResponse{Success: true, Data: subscription})
+}
+
+// @Summary      웹훅 구독 목록
+// @Description  등록된 웹훅 구독 목록을 조회합니다. secret은 포함되지 않습니다.
+// @Tags         Webhooks
+// @Produce      json
+// @Security     BearerAuth
+// @Success      200 {object} models.WebhookSubscriptionListResponse "조회 성공"
+// @Failure      401 {object} models.ErrorResponse "Unauthorized - Invalid or missing token"
+// @Failure      500 {object} models.ErrorResponse "서버 내부 오류 발생"
+// @Router       /webhooks [get]
+func (h *WebhookHandler) ListSubscriptions(c *gin.Context) {
+	subscriptions, err := h.webhookService.ListSubscriptions()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, models.ErrorResponse{Success: false, Error: err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, models.WebhookSubscriptionListResponse{Success: true, Data: subscriptions})
+}
+
+// @Summary      웹훅 구독 삭제
+// @Tags         Webhooks
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path string true "구독 ID"
+// @Success      204 "삭제 성공"
+// @Failure      401 {object} models.ErrorResponse "Unauthorized - Invalid or missing token"
+// @Failure      404 {object} models.ErrorResponse "구독을 찾을 수 없음"
+// @Failure      500 {object} models.ErrorResponse "서버 내부 오류 발생"
+// @Router       /webhooks/{id} [delete]
+func (h *WebhookHandler) DeleteSubscription(c *gin.Context) {
+	deleted, err := h.webhookService.DeleteSubscription(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, models.ErrorResponse{Success: false, Error: err.Error()})
+		return
+	}
+	if !deleted {
+		c.JSON(http.StatusNotFound, models.ErrorResponse{Success: false, Error: "webhook subscription not found"})
+		return
+	}
+
+	entry := newAuditLog(c, models.AuditWebhookDelete)
+	entry.Summary["subscription_id"] = c.Param("id")
+	h.auditService.Record(entry)
+
+	c.Status(http.StatusNoContent)
+}
+
+// @Summary      웹훅 전송 기록
+// @Description  구독별 최근 100건의 전송 기록(상태, 시도 횟수, 응답 코드, 본문)을 조회합니다.
+// @Tags         Webhooks
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path string true "구독 ID"
+// @Success      200 {object} models.WebhookDeliveryListResponse "조회 성공"
+// @Failure      401 {object} models.ErrorResponse "Unauthorized - Invalid or missing token"
+// @Failure      404 {object} models.ErrorResponse "구독을 찾을 수 없음"
+// @Failure      500 {object} models.ErrorResponse "서버 내부 오류 발생"
+// @Router       /webhooks/{id}/deliveries [get]
+func (h *WebhookHandler) GetDeliveries(c *gin.Context) {
+	deliveries, err := h.webhookService.GetDeliveries(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, models.ErrorResponse{Success: false, Error: err.Error()})
+		return
+	}
+	if deliveries == nil {
+		c.JSON(http.StatusNotFound, models.ErrorResponse{Success: false, Error: "webhook subscription not found"})
+		return
+	}
+	c.JSON(http.StatusOK, models.WebhookDeliveryListResponse{Success: true, Data: deliveries})
+}
@@ -0,0 +1,213 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/School-meal-lover/backend/internal/middleware"
+	"github.com/School-meal-lover/backend/internal/models"
+	"github.com/School-meal-lover/backend/internal/services"
+	"github.com/gin-gonic/gin"
+)
+
+type WeekHandler struct {
+	weekService  *services.WeekService
+	auditService *services.AuditService
+}
+
+func NewWeekHandler(weekService *services.WeekService, auditService *services.AuditService) *WeekHandler {
+	return &WeekHandler{weekService: weekService, auditService: auditService}
+}
+
+// @Summary      주차 목록
+// @Description  식당과 게시 상태(draft, in_review, published, retracted)로 주차를 조회합니다. 담당 식당이 있는 키는 그 식당의 주차만 조회합니다.
+// @Tags         Weeks
+// @Produce      json
+// @Security     BearerAuth
+// @Param        restaurant query string false "식당 이름 (RESTAURANT_1, RESTAURANT_2)"
+// @Param        status query string false "게시 상태" Enums(draft, in_review, published, retracted)
+// @Success      200 {object} models.WeekListResponse "조회 성공"
+// @Failure      400 {object} models.ErrorResponse "잘못된 식당 이름 또는 상태"
+// @Failure      401 {object} models.ErrorResponse "Unauthorized - Invalid or missing token"
+// @Failure      403 {object} models.ErrorResponse "권한 없음"
+// @Failure      500 {object} models.ErrorResponse "서버 내부 오류 발생"
+// @Router       /weeks [get]
+func (h *WeekHandler) ListWeeks(c *gin.Context) {
+	var restaurant models.RestaurantType
+	if name := c.Query("restaurant"); name != "" {
+		var ok bool
+		restaurant, ok = models.ParseRestaurantType(name)
+		if !ok {
+			c.JSON(http.StatusBadRequest, models.ErrorResponse{Success: false, Error: "Invalid restaurant name"})
+			return
+		}
+	}
+	if principal := middleware.CurrentPrincipal(c); principal != nil && principal.Restaurant != "" {
+		if restaurant != "" && restaurant != principal.Restaurant {
+			authorizeRestaurant(c, restaurant)
+			return
+		}
+		restaurant = principal.Restaurant
+	}
+
+	weeks, err := h.weekService.List(restaurant, c.Query("status"))
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		if services.IsValidationError(err) {
+			statusCode = http.StatusBadRequest
+		}
+		c.JSON(statusCode, models.ErrorResponse{Success: false, Error: err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, models.WeekListResponse{Success: true, Data: weeks})
+}
+
+// @Summary      게시 전 식단 미리보기
+// @Description  주차 식단과 같은 기간에 지금 게시된 식단을 함께 보여주고, 게시하면 바뀌는 메뉴를 알려줍니다.
+// @Tags         Weeks
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path string true "주차 ID"
+// @Success      200 {object} models.WeekPreviewResponse "조회 성공"
+// @Failure      401 {object} models.ErrorResponse "Unauthorized - Invalid or missing token"
+// @Failure      403 {object} models.ErrorResponse "권한 없음"
+// @Failure      404 {object} models.ErrorResponse "주차를 찾을 수 없음"
+// @Failure      500 {object} models.ErrorResponse "서버 내부 오류 발생"
+// @Router       /weeks/{id}/preview [get]
+func (h *WeekHandler) PreviewWeek(c *gin.Context) {
+	week, ok := h.loadWeek(c)
+	if !ok || !authorizeRestaurant(c, week.RestaurantType) {
+		return
+	}
+
+	preview, err := h.weekService.Preview(week)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, models.ErrorResponse{Success: false, Error: err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, models.WeekPreviewResponse{Success: true, Data: preview})
+}
+
+// @Summary      검토 요청
+// @Description  draft 주차를 검토 중(in_review)으로 바꿉니다. 검토 중에 식단을 다시 올리면 draft로 돌아갑니다.
+// @Tags         Weeks
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path string true "주차 ID"
+// @Success      200 {object} models.WeekResponse "검토 요청 성공"
+// @Failure      401 {object} models.ErrorResponse "Unauthorized - Invalid or missing token"
+// @Failure      403 {object} models.ErrorResponse "권한 없음"
+// @Failure      404 {object} models.ErrorResponse "주차를 찾을 수 없음"
+// @Failure      409 {object} models.ErrorResponse "draft 상태가 아님"
+// @Failure      500 {object} models.ErrorResponse "서버 내부 오류 발생"
+// @Router       /weeks/{id}/submit [post]
+func (h *WeekHandler) SubmitWeek(c *gin.Context) {
+	week, ok := h.loadWeek(c)
+	if !ok || !authorizeRestaurant(c, week.RestaurantType) {
+		return
+	}
+	h.respondTransition(c, week, models.AuditWeekSubmit, h.weekService.Submit)
+}
+
+// @Summary      식단 게시
+// @Description  검토 중(in_review)이거나 내려간(retracted) 주차를 게시합니다. 같은 기간에 게시되어 있던 주차는 retracted가 되고, 알림과 웹훅이 전송됩니다. approver와 admin만 호출할 수 있습니다.
+// @Tags         Weeks
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path string true "주차 ID"
+// @Success      200 {object} models.WeekResponse "게시 성공"
+// @Failure      401 {object} models.ErrorResponse "Unauthorized - Invalid or missing token"
+// @Failure      403 {object} models.ErrorResponse "권한 없음"
+// @Failure      404 {object} models.ErrorResponse "주차를 찾을 수 없음"
+// @Failure      409 {object} models.ErrorResponse "게시할 수 없는 상태"
+// @Failure      500 {object} models.ErrorResponse "서버 내부 오류 발생"
+// @Router       /weeks/{id}/publish [post]
+func (h *WeekHandler) PublishWeek(c *gin.Context) {
+	week, ok := h.loadWeek(c)
+	if !ok || !authorizeApproval(c, week.RestaurantType) {
+		return
+	}
+	h.respondTransition(c, week, models.AuditWeekPublish, h.weekService.Publish)
+}
+
+// @Summary      검토 반려
+// @Description  검토 중(in_review)인 주차를 draft로 되돌립니다. approver와 admin만 호출할 수 있습니다.
+// @Tags         Weeks
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path string true "주차 ID"
+// @Success      200 {object} models.WeekResponse "반려 성공"
+// @Failure      401 {object} models.ErrorResponse "Unauthorized - Invalid or missing token"
+// @Failure      403 {object} models.ErrorResponse "권한 없음"
+// @Failure      404 {object} models.ErrorResponse "주차를 찾을 수 없음"
+// @Failure      409 {object} models.ErrorResponse "검토 중이 아님"
+// @Failure      500 {object} models.ErrorResponse "서버 내부 오류 발생"
+// @Router       /weeks/{id}/reject [post]
+func (h *WeekHandler) RejectWeek(c *gin.Context) {
+	week, ok := h.loadWeek(c)
+	if !ok || !authorizeApproval(c, week.RestaurantType) {
+		return
+	}
+	h.respondTransition(c, week, models.AuditWeekReject, h.weekService.Reject)
+}
+
+// @Summary      게시 취소
+// @Description  게시된 주차를 조회 API에서 내립니다(retracted). 내린 주차는 다시 게시할 수 있습니다. approver와 admin만 호출할 수 있습니다.
+// @Tags         Weeks
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path string true "주차 ID"
+// @Success      200 {object} models.WeekResponse "게시 취소 성공"
+// @Failure      401 {object} models.ErrorResponse "Unauthorized - Invalid or missing token"
+// @Failure      403 {object} models.ErrorResponse "권한 없음"
+// @Failure      404 {object} models.ErrorResponse "주차를 찾을 수 없음"
+// @Failure      409 {object} models.ErrorResponse "게시된 주차가 아님"
+// @Failure      500 {object} models.ErrorResponse "서버 내부 오류 발생"
+// @Router       /weeks/{id}/retract [post]
+func (h *WeekHandler) RetractWeek(c *gin.Context) {
+	week, ok := h.loadWeek(c)
+	if !ok || !authorizeApproval(c, week.RestaurantType) {
+		return
+	}
+	h.respondTransition(c, week, models.AuditWeekRetract, h.weekService.Retract)
+}
+
+// 경로의 주차를 조회하고, 없으면 404로 응답합니다
+func (h *WeekHandler) loadWeek(c *gin.Context) (*models.Week, bool) {
+	week, err := h.weekService.Get(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, models.ErrorResponse{Success: false, Error: err.Error()})
+		return nil, false
+	}
+	if week == nil {
+		c.JSON(http.StatusNotFound, models.ErrorResponse{Success: false, Error: "week not found"})
+		return nil, false
+	}
+	return week, true
+}
+
+func (h *WeekHandler) respondTransition(c *gin.Context, week *models.Week, action string, transition func(*models.Week, string) (*models.Week, error)) {
+	var actor string
+	if principal := middleware.CurrentPrincipal(c); principal != nil {
+		actor = principal.Subject
+	}
+	updated, err := transition(week, actor)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		if services.IsConflictError(err) {
+			statusCode = http.StatusConflict
+		}
+		c.JSON(statusCode, models.ErrorResponse{Success: false, Error: err.Error()})
+		return
+	}
+
+	entry := newAuditLog(c, action)
+	entry.Restaurant = &updated.RestaurantType
+	entry.Summary["week_id"] = updated.ID
+	entry.Summary["start_date"] = updated.StartDate.Format("2006-01-02")
+	entry.Summary["end_date"] = updated.EndDate.Format("2006-01-02")
+	entry.Summary["from_status"] = week.Status
+	entry.Summary["status"] = updated.Status
+	h.auditService.Record(entry)
+
+	c.JSON(http.StatusOK, models.WeekResponse{Success: true, Data: updated})
+}
@@ -0,0 +1,230 @@
+// Package holiday는 식당 운영에 영향을 주는 한국 공휴일 달력입니다.
+// 음력 공휴일(설날, 추석, 부처님오신날)은 FirstYear~LastYear까지 양력 날짜를 표로 가지고 있고,
+// 대체공휴일은 관공서의 공휴일에 관한 규정에 따라 계산합니다.
+package holiday
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+// 음력 날짜 표가 있는 기간
+const (
+	FirstYear = 2024
+	LastYear  = 2030
+)
+
+type Holiday struct {
+	Date   time.Time // UTC 자정 기준 날짜
+	Name   string
+	NameEn string
+	// 다른 공휴일이 주말이나 공휴일과 겹쳐서 생긴 대체공휴일
+	Substitute bool
+}
+
+// 양력 고정 공휴일
+type fixedHoliday struct {
+	month      time.Month
+	day        int
+	name       string
+	nameEn     string
+	substitute bool // 주말이나 다른 공휴일과 겹치면 대체공휴일
+}
+
+var fixedHolidays = []fixedHoliday{
+	{time.January, 1, "신정", "New Year's Day", false},
+	{time.March, 1, "삼일절", "Independence Movement Day", true},
+	{time.May, 5, "어린이날", "Children's Day", true},
+	{time.June, 6, "현충일", "Memorial Day", false},
+	{time.August, 15, "광복절", "Liberation Day", true},
+	{time.October, 3, "개천절", "National Foundation Day", true},
+	{time.October, 9, "한글날", "Hangul Day", true},
+	{time.December, 25, "성탄절", "Christmas Day", true},
+}
+
+// 음력 공휴일의 양력 날짜 (설날, 추석은 당일이며 앞뒤 하루씩 연휴)
+var lunarHolidays = map[int]struct {
+	seollal, buddha, chuseok [2]int // 월, 일
+}{
+	2024: {seollal: [2]int{2, 10}, buddha: [2]int{5, 15}, chuseok: [2]int{9, 17}},
+	2025: {seollal: [2]int{1, 29}, buddha: [2]int{5, 5}, chuseok: [2]int{10, 6}},
+	2026: {seollal: [2]int{2, 17}, buddha: [2]int{5, 24}, chuseok: [2]int{9, 25}},
+	2027: {seollal: [2]int{2, 7}, buddha: [2]int{5, 13}, chuseok: [2]int{9, 15}},
+	2028: {seollal: [2]int{1, 27}, buddha: [2]int{5, 2}, chuseok: [2]int{10, 3}},
+	2029: {seollal: [2]int{2, 13}, buddha: [2]int{5, 20}, chuseok: [2]int{9, 22}},
+	2030: {seollal: [2]int{2, 3}, buddha: [2]int{5, 9}, chuseok: [2]int{9, 12}},
+}
+
+// 선거일, 임시공휴일처럼 따로 지정된 휴일 (대체공휴일 없음)
+var designatedHolidays = []Holiday{
+	{Date: civil(2024, time.April, 10), Name: "국회의원 선거일", NameEn: "National Assembly Election Day"},
+	{Date: civil(2024, time.October, 1), Name: "임시공휴일(국군의 날)", NameEn: "Temporary holiday (Armed Forces Day)"},
+	{Date: civil(2025, time.January, 27), Name: "임시공휴일", NameEn: "Temporary holiday"},
+	{Date: civil(2025, time.June, 3), Name: "대통령 선거일", NameEn: "Presidential Election Day"},
+	{Date: civil(2026, time.June, 3), Name: "전국동시지방선거일", NameEn: "Local Election Day"},
+}
+
+var (
+	buildOnce sync.Once
+	byDate    map[string][]Holiday
+	byYear    map[int][]Holiday
+)
+
+// 날짜의 공휴일 (같은 날 두 공휴일이 겹치면 둘 다)
+func On(date time.Time) []Holiday {
+	build()
+	return byDate[date.Format("2006-01-02")]
+}
+
+// 기간(from~to, 양 끝 포함)의 공휴일 (날짜 순)
+func Between(from, to time.Time) []Holiday {
+	var holidays []Holiday
+	for date := civil(from.Date()); !date.After(civil(to.Date())); date = date.AddDate(0, 0, 1) {
+		holidays = append(holidays, On(date)...)
+	}
+	return holidays
+}
+
+// 한 해의 공휴일 (날짜 순, 표가 없는 해는 nil)
+func Year(year int) []Holiday {
+	build()
+	return byYear[year]
+}
+
+// 음력 공휴일 표가 있는 해인지
+func Covers(year int) bool {
+	return year >= FirstYear && year <= LastYear
+}
+
+func build() {
+	buildOnce.Do(func() {
+		byDate = map[string][]Holiday{}
+		byYear = map[int][]Holiday{}
+		for year := FirstYear; year <= LastYear; year++ {
+			holidays := yearHolidays(year)
+			byYear[year] = holidays
+			for _, holiday := range holidays {
+				key := holiday.Date.Format("2006-01-02")
+				byDate[key] = append(byDate[key], holiday)
+			}
+		}
+	})
+}
+
+// 대체공휴일을 판단하는 공휴일 묶음 (설날, 추석은 사흘 연휴가 한 묶음)
+type group struct {
+	days       []Holiday
+	substitute bool
+	// 설날, 추석 연휴는 일요일과 겹칠 때만, 나머지는 토요일이나 일요일과 겹칠 때 대체공휴일
+	sundayOnly bool
+}
+
+func yearHolidays(year int) []Holiday {
+	lunar := lunarHolidays[year]
+	var groups []group
+	for _, fixed := range fixedHolidays {
+		groups = append(groups, group{
+			days:       []Holiday{{Date: civil(year, fixed.month, fixed.day), Name: fixed.name, NameEn: fixed.nameEn}},
+			substitute: fixed.substitute,
+		})
+	}
+	groups = append(groups,
+		lunarPeriod(year, lunar.seollal, "설날", "Seollal"),
+		group{
+			days:       []Holiday{{Date: civil(year, time.Month(lunar.buddha[0]), lunar.buddha[1]), Name: "부처님오신날", NameEn: "Buddha's Birthday"}},
+			substitute: true,
+		},
+		lunarPeriod(year, lunar.chuseok, "추석", "Chuseok"),
+	)
+	for _, designated := range designatedHolidays {
+		if designated.Date.Year() == year {
+			groups = append(groups, group{days: []Holiday{designated}})
+		}
+	}
+	sort.SliceStable(groups, func(i, j int) bool { return groups[i].days[0].Date.Before(groups[j].days[0].Date) })
+
+	count := map[time.Time]int{}
+	var holidays []Holiday
+	for _, g := range groups {
+		for _, day := range g.days {
+			count[day.Date]++
+			holidays = append(holidays, day)
+		}
+	}
+
+	// 겹친 날 하루에는 대체공휴일도 하루만 생깁니다 (예: 2025-05-05 어린이날과 부처님오신날)
+	overlapUsed := map[time.Time]bool{}
+	for _, g := range groups {
+		if !g.substitute {
+			continue
+		}
+		needed := false
+		for _, day := range g.days {
+			weekday := day.Date.Weekday()
+			if weekday == time.Sunday || (weekday == time.Saturday && !g.sundayOnly) {
+				needed = true
+			}
+		}
+		for _, day := range g.days {
+			if count[day.Date] > 1 && !overlapUsed[day.Date] {
+				overlapUsed[day.Date] = true
+				needed = true
+			}
+		}
+		if !needed {
+			continue
+		}
+
+		last := g.days[len(g.days)-1]
+		date := nextWorkday(last.Date, count)
+		count[date]++
+		holidays = append(holidays, Holiday{
+			Date:       date,
+			Name:       "대체공휴일(" + baseName(last.Name) + ")",
+			NameEn:     "Substitute holiday (" + baseName(last.NameEn) + ")",
+			Substitute: true,
+		})
+	}
+	sort.SliceStable(holidays, func(i, j int) bool { return holidays[i].Date.Before(holidays[j].Date) })
+	return holidays
+}
+
+// 설날, 추석 사흘 연휴
+func lunarPeriod(year int, monthDay [2]int, name, nameEn string) group {
+	day := civil(year, time.Month(monthDay[0]), monthDay[1])
+	return group{
+		days: []Holiday{
+			{Date: day.AddDate(0, 0, -1), Name: name + " 연휴", NameEn: nameEn + " holiday"},
+			{Date: day, Name: name, NameEn: nameEn},
+			{Date: day.AddDate(0, 0, 1), Name: name + " 연휴", NameEn: nameEn + " holiday"},
+		},
+		substitute: true,
+		sundayOnly: true,
+	}
+}
+
+// 날짜 다음의 첫 번째 평일 중 공휴일이 아닌 날
+func nextWorkday(date time.Time, holidays map[time.Time]int) time.Time {
+	for {
+		date = date.AddDate(0, 0, 1)
+		weekday := date.Weekday()
+		if weekday != time.Saturday && weekday != time.Sunday && holidays[date] == 0 {
+			return date
+		}
+	}
+}
+
+// "설날 연휴" -> "설날", "Seollal holiday" -> "Seollal"
+func baseName(name string) string {
+	for _, suffix := range []string{" 연휴", " holiday"} {
+		if len(name) > len(suffix) && name[len(name)-len(suffix):] == suffix {
+			return name[:len(name)-len(suffix)]
+		}
+	}
+	return name
+}
+
+func civil(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
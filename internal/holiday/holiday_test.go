@@ -0,0 +1,119 @@
+package holiday
+
+import (
+	"testing"
+	"time"
+)
+
+func date(value string) time.Time {
+	parsed, err := time.Parse("2006-01-02", value)
+	if err != nil {
+		panic(err)
+	}
+	return parsed
+}
+
+func TestSubstituteHolidays(t *testing.T) {
+	// 연도별로 발표된 대체공휴일
+	want := map[int][]string{
+		2024: {"2024-02-12", "2024-05-06"},
+		2025: {"2025-03-03", "2025-05-06", "2025-10-08"},
+		2026: {"2026-03-02", "2026-05-25", "2026-08-17", "2026-10-05"},
+		2027: {"2027-02-09", "2027-08-16", "2027-10-04", "2027-10-11", "2027-12-27"},
+		2028: {"2028-10-05"},
+		2029: {"2029-05-07", "2029-05-21", "2029-09-24"},
+		2030: {"2030-02-05", "2030-05-06"},
+	}
+	for year, dates := range want {
+		var got []string
+		for _, holiday := range Year(year) {
+			if holiday.Substitute {
+				got = append(got, holiday.Date.Format("2006-01-02"))
+			}
+		}
+		if len(got) != len(dates) {
+			t.Errorf("%d: substitute holidays = %v, want %v", year, got, dates)
+			continue
+		}
+		for i := range dates {
+			if got[i] != dates[i] {
+				t.Errorf("%d: substitute holidays = %v, want %v", year, got, dates)
+				break
+			}
+		}
+	}
+}
+
+func TestLunarHolidays(t *testing.T) {
+	tests := []struct {
+		date string
+		name string
+	}{
+		{"2024-02-09", "설날 연휴"},
+		{"2024-02-10", "설날"},
+		{"2024-02-11", "설날 연휴"},
+		{"2024-05-15", "부처님오신날"},
+		{"2024-09-17", "추석"},
+		{"2025-01-29", "설날"},
+		{"2025-10-06", "추석"},
+		{"2026-02-17", "설날"},
+		{"2026-09-25", "추석"},
+		{"2028-10-04", "추석 연휴"},
+		{"2030-09-12", "추석"},
+	}
+	for _, tt := range tests {
+		holidays := On(date(tt.date))
+		if len(holidays) == 0 || holidays[0].Name != tt.name {
+			t.Errorf("On(%s) = %v, want %s", tt.date, holidays, tt.name)
+		}
+	}
+}
+
+func TestOverlappingHolidays(t *testing.T) {
+	// 2025-05-05는 어린이날과 부처님오신날이 겹치고, 대체공휴일은 하루만 생깁니다
+	if got := On(date("2025-05-05")); len(got) != 2 {
+		t.Errorf("On(2025-05-05) = %v, want 2 holidays", got)
+	}
+	if got := On(date("2025-05-07")); len(got) != 0 {
+		t.Errorf("On(2025-05-07) = %v, want none", got)
+	}
+	// 2028-10-03은 개천절과 추석이 겹칩니다
+	if got := On(date("2028-10-03")); len(got) != 2 {
+		t.Errorf("On(2028-10-03) = %v, want 2 holidays", got)
+	}
+}
+
+func TestNoSubstitute(t *testing.T) {
+	// 현충일과 신정은 주말이어도 대체공휴일이 없고, 토요일에 걸친 추석 연휴도 마찬가지입니다
+	for _, value := range []string{"2026-06-08", "2027-06-07", "2028-01-03", "2026-09-28"} {
+		if got := On(date(value)); len(got) != 0 {
+			t.Errorf("On(%s) = %v, want none", value, got)
+		}
+	}
+}
+
+func TestBetween(t *testing.T) {
+	got := Between(date("2025-10-01"), date("2025-10-10"))
+	var dates []string
+	for _, holiday := range got {
+		dates = append(dates, holiday.Date.Format("2006-01-02"))
+	}
+	want := []string{"2025-10-03", "2025-10-05", "2025-10-06", "2025-10-07", "2025-10-08", "2025-10-09"}
+	if len(dates) != len(want) {
+		t.Fatalf("Between = %v, want %v", dates, want)
+	}
+	for i := range want {
+		if dates[i] != want[i] {
+			t.Fatalf("Between = %v, want %v", dates, want)
+		}
+	}
+}
+
+func TestUncoveredYear(t *testing.T) {
+	if Covers(2031) || Year(2031) != nil {
+		t.Error("2031 should not be covered")
+	}
+	if got := On(date("2031-01-01")); got != nil {
+		t.Errorf("On(2031-01-01) = %v, want nil", got)
+	}
+}
@@ -0,0 +1,289 @@
+// menuquery는 "오늘 1식당 점심", "tomorrow dinner restaurant 2" 같은
+// 자연어 문장에서 날짜, 식당, 식사 종류를 뽑아냅니다.
+package menuquery
+
+import (
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/School-meal-lover/backend/internal/models"
+)
+
+// 문장에서 해석한 식단 조회 조건
+type Query struct {
+	Date          time.Time             // 조회 날짜 (자정 기준)
+	DateSpecified bool                  // 문장에 날짜 표현이 있었는지
+	Restaurant    models.RestaurantType // 비어 있으면 모든 식당
+	MealTypes     []string              // 비어 있으면 모든 식사
+	Lang          string                // "ko" 또는 "en"
+}
+
+var (
+	isoDatePattern     = regexp.MustCompile(`(\d{4})-(\d{1,2})-(\d{1,2})`)
+	koreanDatePattern  = regexp.MustCompile(`(\d{1,2})\s*월\s*(\d{1,2})\s*일`)
+	slashDatePattern   = regexp.MustCompile(`\b(\d{1,2})/(\d{1,2})\b`)
+	koreanWeekday      = regexp.MustCompile(`(이번\s*주|다음\s*주|담주|지난\s*주|저번\s*주)?\s*([월화수목금토일])\s*(?:요일|욜)`)
+	englishWeekday     = regexp.MustCompile(`\b(this|next|last)?\s*(monday|tuesday|wednesday|thursday|friday|saturday|sunday|mon|tue|tues|wed|thu|thur|thurs|fri|sat|sun)\b`)
+	koreanRestaurant   = regexp.MustCompile(`(?:제\s*)?([12])\s*(?:학생\s*식당|학식|식당)`)
+	englishRestaurant1 = regexp.MustCompile(`\b(?:restaurant|cafeteria|cafe|dining)\s*(?:no\.?\s*|#)?([12])\b`)
+	englishRestaurant2 = regexp.MustCompile(`\b([12])(?:st|nd)?\s*(?:restaurant|cafeteria|cafe|dining)\b`)
+	englishOrdinal     = regexp.MustCompile(`\b(first|second)\s*(?:restaurant|cafeteria|cafe|dining)\b`)
+)
+
+var koreanWeekdays = map[string]time.Weekday{
+	"일": time.Sunday, "월": time.Monday, "화": time.Tuesday, "수": time.Wednesday,
+	"목": time.Thursday, "금": time.Friday, "토": time.Saturday,
+}
+
+var englishWeekdays = map[string]time.Weekday{
+	"sunday": time.Sunday, "sun": time.Sunday,
+	"monday": time.Monday, "mon": time.Monday,
+	"tuesday": time.Tuesday, "tue": time.Tuesday, "tues": time.Tuesday,
+	"wednesday": time.Wednesday, "wed": time.Wednesday,
+	"thursday": time.Thursday, "thu": time.Thursday, "thur": time.Thursday, "thurs": time.Thursday,
+	"friday": time.Friday, "fri": time.Friday,
+	"saturday": time.Saturday, "sat": time.Saturday,
+}
+
+// 상대 날짜 표현 (긴 표현을 먼저 검사)
+var relativeDays = []struct {
+	word   string
+	offset int
+}{
+	{"day after tomorrow", 2},
+	{"그저께", -2}, {"그제", -2},
+	{"모레", 2}, {"내일", 1}, {"명일", 1}, {"tomorrow", 1},
+	{"어제", -1}, {"yesterday", -1},
+	{"오늘", 0}, {"금일", 0}, {"today", 0}, {"tonight", 0},
+}
+
+// 식사 종류 이름에 쓰이는 단어의 다른 표현
+var mealSynonyms = map[string][]string{
+	"아침":        {"조식"},
+	"점심":        {"중식"},
+	"저녁":        {"석식"},
+	"breakfast": {"morning"},
+	"lunch":     {"noon"},
+	"dinner":    {"supper", "tonight", "evening"},
+}
+
+// Parse는 문장을 해석합니다. now는 상대 날짜의 기준 시각입니다.
+// mealTypes는 식당별 식사 종류이며, 한국어/영어 이름에 들어간 단어로 식사를 찾습니다.
+func Parse(text string, now time.Time, mealTypes []*models.MealType) *Query {
+	normalized := strings.ToLower(strings.TrimSpace(text))
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+
+	query := &Query{Date: today, Lang: detectLang(normalized)}
+	if date, ok := parseDate(normalized, today); ok {
+		query.Date = date
+		query.DateSpecified = true
+	}
+	query.Restaurant = parseRestaurant(normalized)
+	query.MealTypes = parseMealTypes(normalized, query.Restaurant, mealTypes)
+	return query
+}
+
+// 한글이 하나라도 있으면 한국어로 응답
+func detectLang(text string) string {
+	for _, r := range text {
+		if unicode.Is(unicode.Hangul, r) {
+			return "ko"
+		}
+	}
+	return "en"
+}
+
+func parseDate(text string, today time.Time) (time.Time, bool) {
+	if m := isoDatePattern.FindStringSubmatch(text); m != nil {
+		year, _ := strconv.Atoi(m[1])
+		month, _ := strconv.Atoi(m[2])
+		day, _ := strconv.Atoi(m[3])
+		if date, ok := makeDate(year, month, day, today.Location()); ok {
+			return date, true
+		}
+	}
+	if m := koreanDatePattern.FindStringSubmatch(text); m != nil {
+		if date, ok := monthDay(m[1], m[2], today); ok {
+			return date, true
+		}
+	}
+	if m := slashDatePattern.FindStringSubmatch(text); m != nil {
+		if date, ok := monthDay(m[1], m[2], today); ok {
+			return date, true
+		}
+	}
+
+	for _, relative := range relativeDays {
+		if containsWord(text, relative.word) {
+			return today.AddDate(0, 0, relative.offset), true
+		}
+	}
+
+	if m := koreanWeekday.FindStringSubmatch(text); m != nil {
+		modifier := strings.ReplaceAll(m[1], " ", "")
+		weekOffset := 0
+		switch modifier {
+		case "다음주", "담주":
+			weekOffset = 1
+		case "지난주", "저번주":
+			weekOffset = -1
+		}
+		return weekdayDate(today, koreanWeekdays[m[2]], modifier != "", weekOffset), true
+	}
+	if m := englishWeekday.FindStringSubmatch(text); m != nil {
+		weekOffset := 0
+		switch m[1] {
+		case "next":
+			weekOffset = 1
+		case "last":
+			weekOffset = -1
+		}
+		return weekdayDate(today, englishWeekdays[m[2]], m[1] != "", weekOffset), true
+	}
+
+	return time.Time{}, false
+}
+
+// 요일에 해당하는 날짜를 계산합니다.
+// 주를 지정한 경우 월요일 시작 주 기준, 요일만 있으면 오늘 이후 가장 가까운 날짜입니다.
+func weekdayDate(today time.Time, weekday time.Weekday, weekSpecified bool, weekOffset int) time.Time {
+	if !weekSpecified {
+		diff := (int(weekday) - int(today.Weekday()) + 7) % 7
+		return today.AddDate(0, 0, diff)
+	}
+	monday := today.AddDate(0, 0, -((int(today.Weekday()) + 6) % 7))
+	return monday.AddDate(0, 0, weekOffset*7+(int(weekday)+6)%7)
+}
+
+// 연도가 없는 월/일은 오늘과 가장 가까운 연도로 해석합니다 (연말/연초 대비)
+func monthDay(monthStr, dayStr string, today time.Time) (time.Time, bool) {
+	month, _ := strconv.Atoi(monthStr)
+	day, _ := strconv.Atoi(dayStr)
+	date, ok := makeDate(today.Year(), month, day, today.Location())
+	if !ok {
+		return time.Time{}, false
+	}
+	if date.Sub(today) > 183*24*time.Hour {
+		date = date.AddDate(-1, 0, 0)
+	} else if today.Sub(date) > 183*24*time.Hour {
+		date = date.AddDate(1, 0, 0)
+	}
+	return date, true
+}
+
+func makeDate(year, month, day int, loc *time.Location) (time.Time, bool) {
+	if month < 1 || month > 12 || day < 1 || day > 31 {
+		return time.Time{}, false
+	}
+	date := time.Date(year, time.Month(month), day, 0, 0, 0, 0, loc)
+	// 2월 30일처럼 존재하지 않는 날짜는 거부
+	if date.Month() != time.Month(month) {
+		return time.Time{}, false
+	}
+	return date, true
+}
+
+func parseRestaurant(text string) models.RestaurantType {
+	var number string
+	if m := koreanRestaurant.FindStringSubmatch(text); m != nil {
+		number = m[1]
+	} else if m := englishRestaurant1.FindStringSubmatch(text); m != nil {
+		number = m[1]
+	} else if m := englishRestaurant2.FindStringSubmatch(text); m != nil {
+		number = m[1]
+	} else if m := englishOrdinal.FindStringSubmatch(text); m != nil {
+		number = map[string]string{"first": "1", "second": "2"}[m[1]]
+	}
+
+	switch number {
+	case "1":
+		return models.Restaurant1
+	case "2":
+		return models.Restaurant2
+	}
+	return ""
+}
+
+// 이름의 단어가 가장 많이 나온 식사 종류를 고릅니다
+// "점심"은 "점심 (일품)"과 "점심"에 모두 맞고, "일품"이나 "일품 점심"은 "점심 (일품)"만 맞습니다
+func parseMealTypes(text string, restaurant models.RestaurantType, mealTypes []*models.MealType) []string {
+	best := 0
+	var codes []string
+	for _, mealType := range mealTypes {
+		if restaurant != "" && mealType.Restaurant != restaurant {
+			continue
+		}
+		matched := 0
+		for _, word := range labelWords(mealType) {
+			if mentions(text, word) {
+				matched++
+			}
+		}
+		switch {
+		case matched == 0 || matched < best:
+		case matched > best:
+			best = matched
+			codes = []string{mealType.Code}
+		case !slices.Contains(codes, mealType.Code):
+			codes = append(codes, mealType.Code)
+		}
+	}
+	return codes
+}
+
+// "점심 (일품)", "Lunch (Special)" -> 점심, 일품, lunch, special
+func labelWords(mealType *models.MealType) []string {
+	var words []string
+	for _, label := range []string{mealType.LabelKo, mealType.LabelEn} {
+		for _, word := range strings.FieldsFunc(strings.ToLower(label), func(r rune) bool {
+			return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+		}) {
+			if !slices.Contains(words, word) {
+				words = append(words, word)
+			}
+		}
+	}
+	return words
+}
+
+// 단어나 그 다른 표현이 문장에 있는지
+func mentions(text, word string) bool {
+	if containsWord(text, word) {
+		return true
+	}
+	for _, synonym := range mealSynonyms[word] {
+		if containsWord(text, synonym) {
+			return true
+		}
+	}
+	return false
+}
+
+// 영어 단어는 단어 경계를 확인하고, 한글은 조사가 붙으므로 부분 문자열로 검사합니다
+func containsWord(text, word string) bool {
+	if detectLang(word) == "ko" {
+		return strings.Contains(text, word)
+	}
+	for start := 0; ; {
+		idx := strings.Index(text[start:], word)
+		if idx < 0 {
+			return false
+		}
+		idx += start
+		end := idx + len(word)
+		beforeOK := idx == 0 || !isWordChar(rune(text[idx-1]))
+		afterOK := end == len(text) || !isWordChar(rune(text[end]))
+		if beforeOK && afterOK {
+			return true
+		}
+		start = idx + 1
+	}
+}
+
+func isWordChar(r rune) bool {
+	return r < 0x80 && (unicode.IsLetter(r) || unicode.IsDigit(r))
+}
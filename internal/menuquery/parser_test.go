@@ -0,0 +1,185 @@
+package menuquery
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/School-meal-lover/backend/internal/models"
+)
+
+var kst = time.FixedZone("KST", 9*60*60)
+
+// 2025-05-28 (수) 12:00
+var now = time.Date(2025, time.May, 28, 12, 0, 0, 0, kst)
+
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, kst)
+}
+
+// 기본 식사 종류에 RESTAURANT_2만 야식이 있는 설정
+func testMealTypes() []*models.MealType {
+	var mealTypes []*models.MealType
+	for _, restaurant := range []models.RestaurantType{models.Restaurant1, models.Restaurant2} {
+		mealTypes = append(mealTypes,
+			&models.MealType{Restaurant: restaurant, Code: "Breakfast", LabelKo: "아침", LabelEn: "Breakfast"},
+			&models.MealType{Restaurant: restaurant, Code: "Lunch_1", LabelKo: "점심 (일품)", LabelEn: "Lunch (Special)"},
+			&models.MealType{Restaurant: restaurant, Code: "Lunch_2", LabelKo: "점심", LabelEn: "Lunch"},
+			&models.MealType{Restaurant: restaurant, Code: "Dinner", LabelKo: "저녁", LabelEn: "Dinner"},
+		)
+	}
+	return append(mealTypes, &models.MealType{Restaurant: models.Restaurant2, Code: "Late_snack", LabelKo: "야식", LabelEn: "Late snack"})
+}
+
+func TestParseRelativeDates(t *testing.T) {
+	tests := []struct {
+		text string
+		want time.Time
+	}{
+		{"오늘 점심", date(2025, time.May, 28)},
+		{"금일 메뉴", date(2025, time.May, 28)},
+		{"내일 뭐 나와?", date(2025, time.May, 29)},
+		{"모레 저녁", date(2025, time.May, 30)},
+		{"어제 점심", date(2025, time.May, 27)},
+		{"그저께", date(2025, time.May, 26)},
+		{"today", date(2025, time.May, 28)},
+		{"tomorrow dinner", date(2025, time.May, 29)},
+		{"day after tomorrow", date(2025, time.May, 30)},
+		{"yesterday lunch", date(2025, time.May, 27)},
+	}
+	for _, tt := range tests {
+		query := Parse(tt.text, now, nil)
+		if !query.DateSpecified || !query.Date.Equal(tt.want) {
+			t.Errorf("Parse(%q).Date = %s (specified %v), want %s", tt.text, query.Date.Format("2006-01-02"), query.DateSpecified, tt.want.Format("2006-01-02"))
+		}
+	}
+}
+
+func TestParseWeekdays(t *testing.T) {
+	tests := []struct {
+		text string
+		want time.Time
+	}{
+		{"다음주 월요일", date(2025, time.June, 2)},
+		{"다음 주 월요일 점심", date(2025, time.June, 2)},
+		{"담주 금욜", date(2025, time.June, 6)},
+		{"이번주 월요일", date(2025, time.May, 26)},
+		{"지난주 수요일", date(2025, time.May, 21)},
+		{"저번 주 금요일", date(2025, time.May, 23)},
+		// 주를 지정하지 않으면 오늘 이후 가장 가까운 요일
+		{"수요일", date(2025, time.May, 28)},
+		{"금요일 저녁", date(2025, time.May, 30)},
+		{"월요일", date(2025, time.June, 2)},
+		{"next mon", date(2025, time.June, 2)},
+		{"next monday lunch", date(2025, time.June, 2)},
+		{"this friday", date(2025, time.May, 30)},
+		{"last tue", date(2025, time.May, 20)},
+		{"thursday", date(2025, time.May, 29)},
+	}
+	for _, tt := range tests {
+		query := Parse(tt.text, now, nil)
+		if !query.DateSpecified || !query.Date.Equal(tt.want) {
+			t.Errorf("Parse(%q).Date = %s (specified %v), want %s", tt.text, query.Date.Format("2006-01-02"), query.DateSpecified, tt.want.Format("2006-01-02"))
+		}
+	}
+}
+
+func TestParseMonthDay(t *testing.T) {
+	newYearsEve := time.Date(2025, time.December, 30, 9, 0, 0, 0, kst)
+	newYear := time.Date(2026, time.January, 2, 9, 0, 0, 0, kst)
+	tests := []struct {
+		text string
+		now  time.Time
+		want time.Time
+	}{
+		{"2025-06-03 점심", now, date(2025, time.June, 3)},
+		{"6월 3일", now, date(2025, time.June, 3)},
+		{"6/3 lunch", now, date(2025, time.June, 3)},
+		// 연도가 없으면 오늘과 가까운 연도로 해석합니다
+		{"1월 2일 점심", newYearsEve, date(2026, time.January, 2)},
+		{"1/2", newYearsEve, date(2026, time.January, 2)},
+		{"12월 30일", newYear, date(2025, time.December, 30)},
+		{"12/31 dinner", newYear, date(2025, time.December, 31)},
+	}
+	for _, tt := range tests {
+		query := Parse(tt.text, tt.now, nil)
+		if !query.DateSpecified || !query.Date.Equal(tt.want) {
+			t.Errorf("Parse(%q).Date = %s (specified %v), want %s", tt.text, query.Date.Format("2006-01-02"), query.DateSpecified, tt.want.Format("2006-01-02"))
+		}
+	}
+}
+
+func TestParseWithoutDate(t *testing.T) {
+	for _, text := range []string{"점심 메뉴", "2/30 점심", "lunch"} {
+		query := Parse(text, now, nil)
+		if query.DateSpecified || !query.Date.Equal(date(2025, time.May, 28)) {
+			t.Errorf("Parse(%q).Date = %s (specified %v), want today without a date", text, query.Date.Format("2006-01-02"), query.DateSpecified)
+		}
+	}
+}
+
+func TestParseRestaurant(t *testing.T) {
+	tests := []struct {
+		text string
+		want models.RestaurantType
+	}{
+		{"1식당 점심", models.Restaurant1},
+		{"제2학생식당 저녁", models.Restaurant2},
+		{"2 학식", models.Restaurant2},
+		{"restaurant 1 lunch", models.Restaurant1},
+		{"cafeteria #2", models.Restaurant2},
+		{"2nd cafeteria dinner", models.Restaurant2},
+		{"1st restaurant", models.Restaurant1},
+		{"second dining hall", models.Restaurant2},
+		{"2학기 점심", ""},
+		{"오늘 점심", ""},
+	}
+	for _, tt := range tests {
+		if got := Parse(tt.text, now, nil).Restaurant; got != tt.want {
+			t.Errorf("Parse(%q).Restaurant = %q, want %q", tt.text, got, tt.want)
+		}
+	}
+}
+
+func TestParseMealTypes(t *testing.T) {
+	mealTypes := testMealTypes()
+	tests := []struct {
+		text string
+		want []string
+	}{
+		{"오늘 점심", []string{"Lunch_1", "Lunch_2"}},
+		{"중식 메뉴", []string{"Lunch_1", "Lunch_2"}},
+		{"일품", []string{"Lunch_1"}},
+		{"일품 점심", []string{"Lunch_1"}},
+		{"조식", []string{"Breakfast"}},
+		{"내일 석식", []string{"Dinner"}},
+		{"breakfast", []string{"Breakfast"}},
+		{"lunch special", []string{"Lunch_1"}},
+		{"supper", []string{"Dinner"}},
+		{"tonight", []string{"Dinner"}},
+		{"2식당 야식", []string{"Late_snack"}},
+		{"late snack", []string{"Late_snack"}},
+		// 1식당에는 야식이 없습니다
+		{"1식당 야식", nil},
+		{"afternoon", nil},
+		{"메뉴 알려줘", nil},
+	}
+	for _, tt := range tests {
+		if got := Parse(tt.text, now, mealTypes).MealTypes; !slices.Equal(got, tt.want) {
+			t.Errorf("Parse(%q).MealTypes = %v, want %v", tt.text, got, tt.want)
+		}
+	}
+
+	if got := Parse("점심", now, nil).MealTypes; got != nil {
+		t.Errorf("Parse without meal types = %v, want nil", got)
+	}
+}
+
+func TestParseLang(t *testing.T) {
+	if got := Parse("내일 점심", now, nil).Lang; got != "ko" {
+		t.Errorf("Lang = %q, want ko", got)
+	}
+	if got := Parse("tomorrow lunch", now, nil).Lang; got != "en" {
+		t.Errorf("Lang = %q, want en", got)
+	}
+}
@@ -4,53 +4,87 @@ import (
 	"errors"
 	"log"
 	"net/http"
-	"os"
 	"strings"
 
+	"github.com/School-meal-lover/backend/internal/auth"
 	"github.com/gin-gonic/gin"
 )
 
-// GetBearerToken은 환경변수에서 Bearer token을 가져옵니다
-func GetBearerToken() (string, error) {
-	token := os.Getenv("BEARER_TOKEN")
-	if token == "" {
-		return "", errors.New("BEARER_TOKEN 환경변수가 비어있습니다")
-	}
-	return token, nil
+const principalContextKey = "auth.principal"
+
+// Authenticate 미들웨어는 Authorization 헤더의 Bearer 토큰(API 키 또는 JWT)으로 요청 주체를 확인합니다
+// 헤더가 없으면 SSO 로그인으로 발급한 세션 쿠키를 사용합니다
+func Authenticate(authenticator auth.Authenticator) gin.HandlerFunc {
+	return authenticate(authenticator, true)
 }
 
-// BearerTokenAuth 미들웨어는 Bearer token을 검증합니다
-func BearerTokenAuth() gin.HandlerFunc {
-	expectedToken, err := GetBearerToken()
-	if err != nil {
-		log.Fatalf("토큰을 받아오는데 실패했습니다: %v", err)
-	}
-	
+// OptionalAuthenticate 미들웨어는 토큰이 있을 때만 요청 주체를 확인합니다 (잘못된 토큰은 401)
+// 앱처럼 인증 없이 호출하는 요청과 관리자 요청을 함께 받는 엔드포인트에서 사용합니다
+func OptionalAuthenticate(authenticator auth.Authenticator) gin.HandlerFunc {
+	return authenticate(authenticator, false)
+}
+
+func authenticate(authenticator auth.Authenticator, required bool) gin.HandlerFunc {
 	return func(c *gin.Context) {
-		authHeader := c.GetHeader("Authorization")
-		if authHeader == "" {
-			c.JSON(http.StatusUnauthorized, gin.H{
+		token := strings.TrimSpace(strings.TrimPrefix(c.GetHeader("Authorization"), "Bearer "))
+		if token == "" {
+			token, _ = c.Cookie(auth.SessionCookieName)
+		}
+		if token == "" {
+			if !required {
+				c.Next()
+				return
+			}
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{
 				"success": false,
 				"error":   "Authorization header is required",
 			})
-			c.Abort()
 			return
 		}
 
-		token := strings.TrimPrefix(authHeader, "Bearer ")
-		token = strings.TrimSpace(token)
-
-		// 토큰 검증
-		if token != expectedToken {
-			c.JSON(http.StatusUnauthorized, gin.H{
+		principal, err := authenticator.Authenticate(c.Request.Context(), token)
+		if err != nil {
+			if !errors.Is(err, auth.ErrInvalidCredentials) {
+				log.Printf("Failed to authenticate request: %v", err)
+				c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+					"success": false,
+					"error":   "failed to verify credentials",
+				})
+				return
+			}
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{
 				"success": false,
 				"error":   "Invalid token",
 			})
-			c.Abort()
 			return
 		}
 
+		c.Set(principalContextKey, principal)
+		c.Next()
+	}
+}
+
+// RequireRole 미들웨어는 Authenticate 뒤에서 요청 주체의 역할을 확인합니다
+func RequireRole(roles ...auth.Role) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		principal := CurrentPrincipal(c)
+		if principal == nil || !principal.HasRole(roles...) {
+			c.AbortWithStatusJSON(http.StatusForbidden, gin.H{
+				"success": false,
+				"error":   "insufficient role for this operation",
+			})
+			return
+		}
 		c.Next()
 	}
 }
 
+// 인증된 요청 주체 (Authenticate를 거치지 않았으면 nil)
+func CurrentPrincipal(c *gin.Context) *auth.Principal {
+	value, ok := c.Get(principalContextKey)
+	if !ok {
+		return nil
+	}
+	principal, _ := value.(*auth.Principal)
+	return principal
+}
@@ -1,13 +1,52 @@
 package models
 
+import "time"
+
 type ExcelProcessResult struct {
 	Success        bool   `json:"success"`
 	RestaurantType string `json:"restaurant_type,omitempty"`
 	WeekID         string `json:"week_id,omitempty"`
 	WeekStartDate  string `json:"week_start_date,omitempty"`
+	WeekEndDate    string `json:"week_end_date,omitempty"`
+	// 업로드한 식단은 draft 주차에 저장되고, 게시된 뒤에 조회 API에 나타납니다
+	WeekStatus     string `json:"week_status,omitempty" example:"draft"`
 	TotalMeals     int    `json:"total_meals,omitempty"`
 	TotalMenuItems int    `json:"total_menu_items,omitempty"`
 	Message        string `json:"message"`
+	// 처리는 됐지만 확인이 필요한 항목
+	Warnings []ParseDiagnostic `json:"warnings,omitempty"`
+	// 업로드 전 데이터와 비교한 메뉴 변경 내역
+	Changes *MenuChanges `json:"changes,omitempty"`
+}
+
+type MenuChanges struct {
+	Added   int              `json:"added"`
+	Removed int              `json:"removed"`
+	Updated int              `json:"updated"`
+	Items   []MenuItemChange `json:"items,omitempty"`
+}
+
+type MenuItemChange struct {
+	Change   string `json:"change" example:"added"`
+	Date     string `json:"date" example:"2025-05-26"`
+	MealType string `json:"meal_type" example:"Lunch_2"`
+	Category string `json:"category" example:"메인메뉴"`
+	Name     string `json:"name" example:"돈까스"`
+}
+
+// 업로드 형식 검사 결과 (텍스트는 1부터 시작하는 줄 번호, JSON/YAML 문서는 JSON Pointer 경로)
+type ParseDiagnostic struct {
+	Line     int    `json:"line,omitempty" example:"12"`
+	Path     string `json:"path,omitempty" example:"/days/0/meals/Lunch_1/2/price"`
+	Severity string `json:"severity" example:"error" enums:"error,warning"`
+	Message  string `json:"message" example:"unknown meal type: Lunch_3 (expected Breakfast, Lunch_1, Lunch_2, Dinner)"`
+}
+
+// 업로드 형식 오류 응답
+type ParseErrorResponse struct {
+	Success     bool              `json:"success" example:"false"`
+	Error       string            `json:"error" example:"2 errors (first at line 12: unknown meal type: Lunch_3)"`
+	Diagnostics []ParseDiagnostic `json:"diagnostics"`
 }
 
 type ImageUploadRequest struct {
@@ -15,9 +54,20 @@ type ImageUploadRequest struct {
 }
 
 type ImageInfoResponse struct {
-	Success   bool   `json:"success"`
-	ImageName string `json:"image_name,omitempty"`
-	ImageDate string `json:"image_date,omitempty"`
+	Success      bool   `json:"success"`
+	ImageName    string `json:"image_name,omitempty"`
+	ImageDate    string `json:"image_date,omitempty"`
+	ValidFrom    string `json:"valid_from,omitempty"`
+	WeekID       string `json:"week_id,omitempty"`
+	ImageURL     string `json:"image_url,omitempty"`
+	ThumbnailURL string `json:"thumbnail_url,omitempty"`
+	ContentType  string `json:"content_type,omitempty"`
+	SizeBytes    int64  `json:"size_bytes,omitempty"`
+}
+
+type ImageHistoryResponse struct {
+	Success bool                 `json:"success"`
+	Data    []*ImageInfoResponse `json:"data"`
 }
 
 type RestaurantMealsResponse struct {
@@ -43,25 +93,55 @@ type WeekInfo struct {
 	ID        string `json:"id"`
 	StartDate string `json:"start_date"`
 	EndDate   string `json:"end_date"`
+	Status    string `json:"status,omitempty" example:"published"`
 }
 
 type DayMeals struct {
-	Date      string               `json:"date"`
-	DayOfWeek string               `json:"day_of_week"`
-	Meals     map[string]*MealInfo `json:"meals"`
+	Date      string `json:"date"`
+	DayOfWeek string `json:"day_of_week"`
+	// 하루 전체 휴무인 날은 Closed와 휴무 사유를 함께 보냅니다
+	Closed  bool         `json:"closed,omitempty"`
+	Closure *ClosureInfo `json:"closure,omitempty"`
+	// 공휴일이면 이름을 붙이고, 등록된 휴무와 식단이 모두 없으면 쉬는 날일 가능성이 높다고 표시합니다
+	Holiday      *HolidayInfo         `json:"holiday,omitempty"`
+	LikelyClosed bool                 `json:"likely_closed,omitempty"`
+	Meals        map[string]*MealInfo `json:"meals"`
+}
+
+type HolidayInfo struct {
+	Name       string `json:"name" example:"현충일"`
+	NameEn     string `json:"name_en" example:"Memorial Day"`
+	Substitute bool   `json:"substitute,omitempty"`
+}
+
+// 식단 응답에 포함되는 휴무 사유
+type ClosureInfo struct {
+	ID       string `json:"id"`
+	ReasonKo string `json:"reason_ko" example:"현충일"`
+	ReasonEn string `json:"reason_en" example:"Memorial Day"`
 }
 
 type MenuItemResponse struct {
-	ID       string  `json:"id" db:"id"`
-	Category string  `json:"category" db:"category"`
-	Name     string  `json:"name" db:"name"`
-	NameEn   string  `json:"name_en" db:"name_en"`
-	Price    float64 `json:"price" db:"price"`
+	ID        string   `json:"id" db:"id"`
+	Category  string   `json:"category" db:"category"`
+	Name      string   `json:"name" db:"name"`
+	NameEn    string   `json:"name_en" db:"name_en"`
+	Price     float64  `json:"price" db:"price"`
+	Allergens []string `json:"allergens,omitempty" db:"allergens" example:"1,2,5"`
 }
 
 type MealInfo struct {
-	MealID    string              `json:"meal_id"`
-	MealType  string              `json:"meal_type"`
+	MealID   string `json:"meal_id"`
+	MealType string `json:"meal_type"`
+	// 식사 종류 설정(meal_types)에 있는 표시 이름과 배식 시간
+	Label        string `json:"label,omitempty" example:"점심"`
+	LabelEn      string `json:"label_en,omitempty" example:"Lunch"`
+	ServingStart string `json:"serving_start,omitempty" example:"11:30"`
+	ServingEnd   string `json:"serving_end,omitempty" example:"13:30"`
+	DisplayOrder int    `json:"-"`
+	// 운영하지 않는 식사 (식단이 올라와 있어도 휴무가 우선합니다)
+	Closed    bool                `json:"closed,omitempty"`
+	Closure   *ClosureInfo        `json:"closure,omitempty"`
 	MenuItems []*MenuItemResponse `json:"menu_items"`
 }
 
@@ -70,3 +150,260 @@ type MealsSummary struct {
 	TotalMeals     int `json:"total_meals"`
 	TotalMenuItems int `json:"total_menu_items"`
 }
+
+type NotificationSubscribeRequest struct {
+	DeviceToken    string   `json:"device_token" binding:"required"`
+	Platform       string   `json:"platform" binding:"required" enums:"fcm,apns"`
+	Restaurant     string   `json:"restaurant,omitempty" example:"RESTAURANT_1"`
+	FavoriteDishes []string `json:"favorite_dishes" example:"돈까스,제육볶음"`
+}
+
+type NotificationSubscriberResponse struct {
+	Success bool                    `json:"success"`
+	Data    *NotificationSubscriber `json:"data,omitempty"`
+}
+
+type WebhookCreateRequest struct {
+	URL        string   `json:"url" binding:"required" example:"https://signage.example.com/hooks/menu"`
+	Secret     string   `json:"secret,omitempty"`
+	EventTypes []string `json:"event_types" binding:"required" example:"week.published,week.updated"`
+}
+
+type WebhookSubscriptionResponse struct {
+	Success bool                 `json:"success"`
+	Data    *WebhookSubscription `json:"data,omitempty"`
+}
+
+type WebhookSubscriptionListResponse struct {
+	Success bool                   `json:"success"`
+	Data    []*WebhookSubscription `json:"data"`
+}
+
+type WebhookDeliveryListResponse struct {
+	Success bool               `json:"success"`
+	Data    []*WebhookDelivery `json:"data"`
+}
+
+type MealTypeRequest struct {
+	LabelKo       string `json:"label_ko" binding:"required" example:"야식"`
+	LabelEn       string `json:"label_en" binding:"required" example:"Late snack"`
+	DisplayOrder  int    `json:"display_order" example:"5"`
+	ServingStart  string `json:"serving_start" binding:"required" example:"21:00"`
+	ServingEnd    string `json:"serving_end" binding:"required" example:"22:00"`
+	ExcelStartRow *int   `json:"excel_start_row,omitempty"`
+	ExcelEndRow   *int   `json:"excel_end_row,omitempty"`
+}
+
+type MealTypeResponse struct {
+	Success bool      `json:"success"`
+	Data    *MealType `json:"data,omitempty"`
+}
+
+type MealTypeListResponse struct {
+	Success bool        `json:"success"`
+	Data    []*MealType `json:"data"`
+}
+
+type ClosureRequest struct {
+	Restaurant string `json:"restaurant" binding:"required" example:"RESTAURANT_1"`
+	StartDate  string `json:"start_date" binding:"required" example:"2025-06-06"`
+	// 비우면 종료일은 시작일과 같습니다
+	EndDate string `json:"end_date,omitempty" example:"2025-06-06"`
+	// 비우면 하루 전체 휴무
+	MealType string `json:"meal_type,omitempty" example:"Dinner"`
+	ReasonKo string `json:"reason_ko,omitempty" example:"현충일"`
+	ReasonEn string `json:"reason_en,omitempty" example:"Memorial Day"`
+}
+
+type ClosureResponse struct {
+	Success bool     `json:"success"`
+	Data    *Closure `json:"data,omitempty"`
+}
+
+type ClosureListResponse struct {
+	Success bool       `json:"success"`
+	Data    []*Closure `json:"data"`
+}
+
+type DailyMealsResponse struct {
+	Success bool            `json:"success"`
+	Data    *DailyMealsData `json:"data,omitempty"`
+	Error   string          `json:"error,omitempty"`
+	Code    string          `json:"code,omitempty"`
+}
+
+type DailyMealsData struct {
+	Restaurant string    `json:"restaurant"`
+	Day        *DayMeals `json:"day"`
+}
+
+type WeekResponse struct {
+	Success bool  `json:"success"`
+	Data    *Week `json:"data,omitempty"`
+}
+
+type WeekListResponse struct {
+	Success bool    `json:"success"`
+	Data    []*Week `json:"data"`
+}
+
+// 게시 전 주차와 지금 게시된 버전 비교
+type WeekPreview struct {
+	Week *Week `json:"week"`
+	// 검토 중인 식단 (draft, in_review)
+	Draft *RestaurantMealsData `json:"draft"`
+	// 같은 기간에 지금 게시된 식단 (겹치는 게시 주차마다 하나, 시작일 순)
+	Published []*RestaurantMealsData `json:"published"`
+	// 게시하면 바뀌는 메뉴
+	Changes *MenuChanges `json:"changes"`
+}
+
+type WeekPreviewResponse struct {
+	Success bool         `json:"success"`
+	Data    *WeekPreview `json:"data,omitempty"`
+}
+
+// 웹훅으로 전송되는 JSON 본문
+type WebhookPayload struct {
+	ID        string      `json:"id"`
+	Event     string      `json:"event"`
+	CreatedAt string      `json:"created_at"`
+	Data      interface{} `json:"data"`
+}
+
+// image.updated 이벤트 데이터
+type ImageUpdatedData struct {
+	Restaurant string `json:"restaurant"`
+	ImageName  string `json:"image_name"`
+	ImageDate  string `json:"image_date"`
+	ImageURL   string `json:"image_url,omitempty"`
+}
+
+// 챗봇 응답에 들어가는 식단 카드
+type MenuCard struct {
+	Title       string `json:"title"`
+	Description string `json:"description"`
+}
+
+// 카카오 i 오픈빌더 스킬 요청 (필요한 필드만)
+type KakaoSkillRequest struct {
+	UserRequest struct {
+		Utterance string `json:"utterance"`
+		User      struct {
+			ID string `json:"id"`
+		} `json:"user"`
+	} `json:"userRequest"`
+}
+
+// 카카오 i 오픈빌더 스킬 응답 (version 2.0)
+type KakaoSkillResponse struct {
+	Version  string        `json:"version" example:"2.0"`
+	Template KakaoTemplate `json:"template"`
+}
+
+type KakaoTemplate struct {
+	Outputs      []KakaoOutput     `json:"outputs"`
+	QuickReplies []KakaoQuickReply `json:"quickReplies,omitempty"`
+}
+
+type KakaoOutput struct {
+	SimpleText *KakaoSimpleText `json:"simpleText,omitempty"`
+	TextCard   *KakaoTextCard   `json:"textCard,omitempty"`
+	Carousel   *KakaoCarousel   `json:"carousel,omitempty"`
+}
+
+type KakaoSimpleText struct {
+	Text string `json:"text"`
+}
+
+type KakaoTextCard struct {
+	Title       string `json:"title"`
+	Description string `json:"description"`
+}
+
+type KakaoCarousel struct {
+	Type  string          `json:"type" example:"textCard"`
+	Items []KakaoTextCard `json:"items"`
+}
+
+type KakaoQuickReply struct {
+	Label       string `json:"label"`
+	Action      string `json:"action" example:"message"`
+	MessageText string `json:"messageText"`
+}
+
+// 슬랙 슬래시 커맨드 응답
+type SlackCommandResponse struct {
+	ResponseType string       `json:"response_type" example:"in_channel"`
+	Text         string       `json:"text"`
+	Blocks       []SlackBlock `json:"blocks,omitempty"`
+}
+
+type SlackBlock struct {
+	Type string     `json:"type"`
+	Text *SlackText `json:"text,omitempty"`
+}
+
+type SlackText struct {
+	Type string `json:"type"`
+	Text string `json:"text"`
+}
+
+type APIKeyCreateRequest struct {
+	Name          string   `json:"name" binding:"required" example:"1식당 영양사"`
+	Scopes        []string `json:"scopes" binding:"required" example:"staff@RESTAURANT_1"`
+	ExpiresInDays int      `json:"expires_in_days,omitempty" example:"90"`
+}
+
+type APIKeyResponse struct {
+	Success bool    `json:"success"`
+	Data    *APIKey `json:"data,omitempty"`
+	// 발급할 때 한 번만 내려주는 원본 키
+	Key string `json:"key,omitempty"`
+}
+
+type APIKeyListResponse struct {
+	Success bool      `json:"success"`
+	Data    []*APIKey `json:"data"`
+}
+
+// 로그인한 사용자 정보
+type AuthPrincipal struct {
+	Subject    string `json:"subject" example:"dietitian@example.ac.kr"`
+	Role       string `json:"role" example:"staff"`
+	Restaurant string `json:"restaurant,omitempty" example:"RESTAURANT_1"`
+}
+
+type AuthPrincipalResponse struct {
+	Success bool           `json:"success"`
+	Data    *AuthPrincipal `json:"data,omitempty"`
+}
+
+// 감사 로그 조회 조건
+type AuditLogFilter struct {
+	Actor      string
+	Action     string
+	Restaurant RestaurantType
+	WeekID     string
+	Since      *time.Time
+	Until      *time.Time
+	Limit      int
+	Offset     int
+}
+
+type AuditLogListResponse struct {
+	Success bool        `json:"success"`
+	Data    []*AuditLog `json:"data"`
+}
+
+type UploadListResponse struct {
+	Success bool      `json:"success"`
+	Data    []*Upload `json:"data"`
+}
+
+// 보관된 원본을 다시 처리한 결과
+type ReprocessResponse struct {
+	Success bool                  `json:"success"`
+	Upload  *Upload               `json:"upload"`
+	Results []*ExcelProcessResult `json:"results"`
+}
@@ -1,19 +1,58 @@
 package models
 
-import "time"
+import (
+	"strings"
+	"time"
+)
 
 type RestaurantType string
+
 const (
 	Restaurant1 RestaurantType = "RESTAURANT_1"
 	Restaurant2 RestaurantType = "RESTAURANT_2"
 )
 
+// 한 주에 운영하는 날 수 (restaurant1은 평일만(월~금), restaurant2는 주말 포함(월~일))
+func (r RestaurantType) WeekDays() int {
+	if r == Restaurant1 {
+		return 5
+	}
+	return 7
+}
+
+// 경로 파라미터 등으로 받은 식당 이름을 RestaurantType으로 변환 (대소문자 무시)
+func ParseRestaurantType(name string) (RestaurantType, bool) {
+	switch RestaurantType(strings.ToUpper(strings.TrimSpace(name))) {
+	case Restaurant1:
+		return Restaurant1, true
+	case Restaurant2:
+		return Restaurant2, true
+	}
+	return "", false
+}
+
 type Week struct {
-	ID         string         `json:"id" db:"id"`
-	StartDate  time.Time      `json:"start_date" db:"start_date"`
-	RestaurantType RestaurantType `json:"restaurant" db:"restaurants"`
+	ID              string         `json:"id" db:"id"`
+	StartDate       time.Time      `json:"start_date" db:"start_date"`
+	EndDate         time.Time      `json:"end_date" db:"end_date"`
+	RestaurantType  RestaurantType `json:"restaurant" db:"restaurants"`
+	Status          string         `json:"status" db:"status"`
+	PublishedAt     *time.Time     `json:"published_at,omitempty" db:"published_at"`
+	StatusChangedBy string         `json:"status_changed_by,omitempty" db:"status_changed_by"`
+	StatusChangedAt *time.Time     `json:"status_changed_at,omitempty" db:"status_changed_at"`
+	UpdatedAt       time.Time      `json:"updated_at" db:"updated_at"`
 }
 
+// 주차 게시 상태
+// 업로드는 draft로 저장되고, 검토 요청(in_review) 뒤 approver가 게시(published)하면 조회 API에 나타납니다
+// 새 버전이 게시되면 겹치는 이전 버전은 retracted가 됩니다
+const (
+	WeekStatusDraft     = "draft"
+	WeekStatusInReview  = "in_review"
+	WeekStatusPublished = "published"
+	WeekStatusRetracted = "retracted"
+)
+
 type Meal struct {
 	ID        string    `json:"id" db:"id"`
 	WeekID    string    `json:"week_id" db:"weeks_id"`
@@ -25,14 +64,44 @@ type Meal struct {
 }
 
 type MenuItem struct {
-	ID       string  `json:"id" db:"id"`
-	MealID   string  `json:"meal_id" db:"meals_id"`
-	Category string  `json:"category" db:"category"`
-	Name     string  `json:"name" db:"name"`
-	NameEn   string  `json:"name_en" db:"name_en"`
-	Price    float64 `json:"price" db:"price"`
+	ID        string   `json:"id" db:"id"`
+	MealID    string   `json:"meal_id" db:"meals_id"`
+	Category  string   `json:"category" db:"category"`
+	Name      string   `json:"name" db:"name"`
+	NameEn    string   `json:"name_en" db:"name_en"`
+	Price     float64  `json:"price" db:"price"`
+	Allergens []string `json:"allergens,omitempty" db:"allergens"`
+}
+
+// 업로드 경로(엑셀, 텍스트, JSON/YAML)와 관계없이 가져오기에 쓰는 한 주 식단
+type WeekMenu struct {
+	Restaurant RestaurantType
+	WeekStart  time.Time
+	// 비어 있으면 시작일이 속한 주의 마지막 운영일과 마지막 식단 날짜 중 늦은 날
+	WeekEnd time.Time
+	Days    []*DayMenu
+}
+
+type DayMenu struct {
+	Date      time.Time
+	DayOfWeek string
+	Meals     []*MealMenu
 }
 
+// Items의 Category가 비어 있으면 메뉴 이름 규칙과 이력으로 분류하고,
+// NameEn이 비어 있거나 Allergens가 nil이면 기존 값을 유지합니다
+type MealMenu struct {
+	MealType string
+	Items    []MenuItem
+}
+
+// 업로드 전후 메뉴 변경 종류
+const (
+	MenuChangeAdded   = "added"
+	MenuChangeRemoved = "removed"
+	MenuChangeUpdated = "updated"
+)
+
 // 엑셀 파싱용 구조체
 type DateInfo struct {
 	Date      string `json:"date"`
@@ -40,8 +109,245 @@ type DateInfo struct {
 	Col       string `json:"col"`
 }
 
-type MealTypeConfig struct {
-	MealType string `json:"meal_type"`
-	StartRow int    `json:"start_row"`
-	EndRow   int    `json:"end_row"`
+// 업로드 형식 검사 결과 심각도
+const (
+	DiagnosticError   = "error"
+	DiagnosticWarning = "warning"
+)
+
+// 식당별 식사 종류 설정 (코드는 meals.meal_type에 저장)
+type MealType struct {
+	Restaurant   RestaurantType `json:"restaurant" db:"restaurant"`
+	Code         string         `json:"code" db:"code"`
+	LabelKo      string         `json:"label_ko" db:"label_ko"`
+	LabelEn      string         `json:"label_en" db:"label_en"`
+	DisplayOrder int            `json:"display_order" db:"display_order"`
+	ServingStart string         `json:"serving_start" db:"serving_start"` // "HH:MM"
+	ServingEnd   string         `json:"serving_end" db:"serving_end"`
+	// 엑셀 식단표에서 메뉴를 읽을 행 범위 (없으면 엑셀에서 읽지 않음)
+	ExcelStartRow *int      `json:"excel_start_row,omitempty" db:"excel_start_row"`
+	ExcelEndRow   *int      `json:"excel_end_row,omitempty" db:"excel_end_row"`
+	UpdatedAt     time.Time `json:"updated_at" db:"updated_at"`
+}
+
+// 식당별 식사 종류 코드 (표시 순서)
+type MealTypeCodes map[RestaurantType][]string
+
+// 모든 식당의 코드 (중복 없이, 처음 나온 순서)
+func (c MealTypeCodes) All() []string {
+	var codes []string
+	seen := map[string]bool{}
+	for _, restaurant := range []RestaurantType{Restaurant1, Restaurant2} {
+		for _, code := range c[restaurant] {
+			if !seen[code] {
+				seen[code] = true
+				codes = append(codes, code)
+			}
+		}
+	}
+	return codes
+}
+
+// 푸시 알림 플랫폼
+const (
+	PlatformFCM  = "fcm"
+	PlatformAPNs = "apns"
+)
+
+// 알림 종류
+const (
+	NotificationFavoriteDish = "favorite_dish"
+	NotificationMenuChanged  = "menu_changed"
+)
+
+// 알림 전송 상태
+const (
+	DeliveryPending   = "pending"
+	DeliveryDelivered = "delivered"
+	DeliveryFailed    = "failed"
+)
+
+type NotificationSubscriber struct {
+	ID             string          `json:"id" db:"id"`
+	DeviceToken    string          `json:"device_token" db:"device_token"`
+	Platform       string          `json:"platform" db:"platform"`
+	Restaurant     *RestaurantType `json:"restaurant,omitempty" db:"restaurant"`
+	FavoriteDishes []string        `json:"favorite_dishes"`
+	CreatedAt      time.Time       `json:"created_at" db:"created_at"`
+	UpdatedAt      time.Time       `json:"updated_at" db:"updated_at"`
+}
+
+type NotificationDelivery struct {
+	ID           string     `json:"id" db:"id"`
+	SubscriberID string     `json:"subscriber_id" db:"subscriber_id"`
+	DeviceToken  string     `json:"-"`
+	Platform     string     `json:"platform"`
+	Kind         string     `json:"kind" db:"kind"`
+	Title        string     `json:"title" db:"title"`
+	Body         string     `json:"body" db:"body"`
+	Status       string     `json:"status" db:"status"`
+	Attempts     int        `json:"attempts" db:"attempts"`
+	LastError    string     `json:"last_error,omitempty" db:"last_error"`
+	CreatedAt    time.Time  `json:"created_at" db:"created_at"`
+	DeliveredAt  *time.Time `json:"delivered_at,omitempty" db:"delivered_at"`
+}
+
+// 업로드 완료 후 후속 처리(알림 등)에 전달되는 주차 변경 정보
+type WeekUploadEvent struct {
+	WeekID     string
+	Restaurant RestaurantType
+	Week       *WeekInfo
+	Summary    *MealsSummary
+	Before     []*DayMeals // 이전에 게시된 데이터 (처음 게시하는 주차면 비어 있음)
+	After      []*DayMeals
+	Changes    *MenuChanges
+}
+
+// 웹훅 이벤트 종류
+const (
+	EventWeekPublished = "week.published"
+	EventWeekUpdated   = "week.updated"
+	EventImageUpdated  = "image.updated"
+)
+
+var WebhookEventTypes = []string{EventWeekPublished, EventWeekUpdated, EventImageUpdated}
+
+type WebhookSubscription struct {
+	ID         string    `json:"id" db:"id"`
+	URL        string    `json:"url" db:"url"`
+	Secret     string    `json:"secret,omitempty" db:"secret"`
+	EventTypes []string  `json:"event_types" db:"event_types"`
+	Active     bool      `json:"active" db:"active"`
+	CreatedAt  time.Time `json:"created_at" db:"created_at"`
+	UpdatedAt  time.Time `json:"updated_at" db:"updated_at"`
+}
+
+type WebhookDelivery struct {
+	ID             string     `json:"id" db:"id"`
+	SubscriptionID string     `json:"subscription_id" db:"subscription_id"`
+	EventType      string     `json:"event_type" db:"event_type"`
+	Payload        string     `json:"payload" db:"payload"`
+	Status         string     `json:"status" db:"status"`
+	Attempts       int        `json:"attempts" db:"attempts"`
+	ResponseStatus int        `json:"response_status,omitempty" db:"response_status"`
+	LastError      string     `json:"last_error,omitempty" db:"last_error"`
+	CreatedAt      time.Time  `json:"created_at" db:"created_at"`
+	DeliveredAt    *time.Time `json:"delivered_at,omitempty" db:"delivered_at"`
+}
+
+// 식단 이미지 기록 (식당, 주차별)
+type Image struct {
+	ID           string         `json:"id" db:"id"`
+	Restaurant   RestaurantType `json:"restaurant" db:"restaurant"`
+	WeekID       *string        `json:"week_id,omitempty" db:"week_id"`
+	ImageName    string         `json:"image_name" db:"image_name"`
+	ValidFrom    time.Time      `json:"valid_from" db:"valid_from"`
+	BlobKey      string         `json:"blob_key,omitempty" db:"blob_key"`
+	ThumbnailKey string         `json:"thumbnail_key,omitempty" db:"thumbnail_key"`
+	ContentType  string         `json:"content_type,omitempty" db:"content_type"`
+	SizeBytes    int64          `json:"size_bytes,omitempty" db:"size_bytes"`
+	CreatedAt    time.Time      `json:"created_at" db:"created_at"`
+}
+
+// 발급된 API 키 (원본 키 대신 해시를 저장)
+type APIKey struct {
+	ID         string     `json:"id" db:"id"`
+	Name       string     `json:"name" db:"name"`
+	Prefix     string     `json:"prefix" db:"prefix"`
+	KeyHash    string     `json:"-" db:"key_hash"`
+	Scopes     []string   `json:"scopes" db:"scopes"`
+	CreatedAt  time.Time  `json:"created_at" db:"created_at"`
+	LastUsedAt *time.Time `json:"last_used_at,omitempty" db:"last_used_at"`
+	ExpiresAt  *time.Time `json:"expires_at,omitempty" db:"expires_at"`
+	RevokedAt  *time.Time `json:"revoked_at,omitempty" db:"revoked_at"`
+}
+
+// 식당 휴무 (공휴일, 시험 기간, 방학 등)
+// MealType이 비어 있으면 기간 안의 하루 전체, 있으면 그 식사만 운영하지 않습니다
+type Closure struct {
+	ID         string         `json:"id" db:"id"`
+	Restaurant RestaurantType `json:"restaurant" db:"restaurant"`
+	StartDate  string         `json:"start_date" db:"start_date" example:"2025-06-06"`
+	EndDate    string         `json:"end_date" db:"end_date" example:"2025-06-06"`
+	MealType   string         `json:"meal_type,omitempty" db:"meal_type" example:"Dinner"`
+	ReasonKo   string         `json:"reason_ko" db:"reason_ko" example:"현충일"`
+	ReasonEn   string         `json:"reason_en" db:"reason_en" example:"Memorial Day"`
+	CreatedBy  string         `json:"created_by,omitempty" db:"created_by"`
+	CreatedAt  time.Time      `json:"created_at" db:"created_at"`
+}
+
+// 감사 로그 동작
+const (
+	AuditUploadExcel     = "upload.excel"
+	AuditUploadText      = "upload.text"
+	AuditUploadJSON      = "upload.json"
+	AuditUploadReprocess = "upload.reprocess"
+	AuditImageUpload     = "image.upload"
+	AuditImageUploadFile = "image.upload_file"
+	AuditAPIKeyIssue     = "api_key.issue"
+	AuditAPIKeyRevoke    = "api_key.revoke"
+	AuditWebhookCreate   = "webhook.create"
+	AuditWebhookDelete   = "webhook.delete"
+	AuditMealTypeUpdate  = "meal_type.update"
+	AuditClosureCreate   = "closure.create"
+	AuditClosureDelete   = "closure.delete"
+	AuditWeekSubmit      = "week.submit"
+	AuditWeekReject      = "week.reject"
+	AuditWeekPublish     = "week.publish"
+	AuditWeekRetract     = "week.retract"
+)
+
+// 데이터를 바꾼 요청 기록
+type AuditLog struct {
+	ID         string                 `json:"id" db:"id"`
+	Actor      string                 `json:"actor" db:"actor"`
+	ActorRole  string                 `json:"actor_role" db:"actor_role"`
+	Action     string                 `json:"action" db:"action"`
+	Method     string                 `json:"method" db:"method"`
+	Endpoint   string                 `json:"endpoint" db:"endpoint"`
+	Restaurant *RestaurantType        `json:"restaurant,omitempty" db:"restaurant"`
+	WeekID     *string                `json:"week_id,omitempty" db:"week_id"`
+	Summary    map[string]interface{} `json:"summary" db:"summary"`
+	Files      []AuditFile            `json:"files" db:"files"`
+	CreatedAt  time.Time              `json:"created_at" db:"created_at"`
+}
+
+// 업로드된 원본 파일 정보
+type AuditFile struct {
+	Name   string `json:"name"`
+	SHA256 string `json:"sha256"`
+	Size   int64  `json:"size"`
+}
+
+// 원본 업로드 종류와 파일 역할
+const (
+	UploadKindExcel = "excel"
+	UploadKindText  = "text"
+	UploadKindJSON  = "json"
+
+	UploadFileKorean   = "ko"
+	UploadFileEnglish  = "en"
+	UploadFileText     = "text"
+	UploadFileDocument = "document"
+)
+
+// 보관된 원본 업로드 (재처리용)
+type Upload struct {
+	ID         string         `json:"id" db:"id"`
+	Kind       string         `json:"kind" db:"kind"`
+	Restaurant RestaurantType `json:"restaurant" db:"restaurant"`
+	WeekID     *string        `json:"week_id,omitempty" db:"week_id"`
+	UploadedBy string         `json:"uploaded_by" db:"uploaded_by"`
+	Files      []*UploadFile  `json:"files"`
+	CreatedAt  time.Time      `json:"created_at" db:"created_at"`
+}
+
+type UploadFile struct {
+	ID        string `json:"id" db:"id"`
+	UploadID  string `json:"-" db:"upload_id"`
+	Role      string `json:"role" db:"role"`
+	FileName  string `json:"file_name" db:"file_name"`
+	SHA256    string `json:"sha256" db:"sha256"`
+	SizeBytes int64  `json:"size_bytes" db:"size_bytes"`
+	BlobKey   string `json:"-" db:"blob_key"`
 }
@@ -0,0 +1,75 @@
+package notification
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const defaultAPNsEndpoint = "https://api.push.apple.com"
+
+// APNs HTTP/2 provider API 어댑터 (토큰 기반 인증)
+type APNsNotifier struct {
+	endpoint  string
+	authToken string
+	topic     string
+	client    *http.Client
+}
+
+func NewAPNsNotifier(endpoint, authToken, topic string) *APNsNotifier {
+	if endpoint == "" {
+		endpoint = defaultAPNsEndpoint
+	}
+	return &APNsNotifier{
+		endpoint:  strings.TrimRight(endpoint, "/"),
+		authToken: authToken,
+		topic:     topic,
+		client:    &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+func (n *APNsNotifier) Send(ctx context.Context, msg *Message) error {
+	body := map[string]interface{}{
+		"aps": map[string]interface{}{
+			"alert": map[string]string{
+				"title": msg.Title,
+				"body":  msg.Body,
+			},
+			"sound": "default",
+		},
+	}
+	for key, value := range msg.Data {
+		body[key] = value
+	}
+	payload, err := json.Marshal(body)
+	if err != nil {
+		return fmt.Errorf("failed to encode APNs payload: %w", err)
+	}
+
+	url := fmt.Sprintf("%s/3/device/%s", n.endpoint, msg.DeviceToken)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(payload))
+	if err != nil {
+		return fmt.Errorf("failed to build APNs request: %w", err)
+	}
+	req.Header.Set("Authorization", "bearer "+n.authToken)
+	req.Header.Set("apns-topic", n.topic)
+	req.Header.Set("apns-push-type", "alert")
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := n.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to send APNs request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		respBody, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return statusError("APNs", resp.StatusCode, string(respBody))
+	}
+	return nil
+}
@@ -0,0 +1,48 @@
+package notification
+
+import (
+	"context"
+	"log"
+	"sync"
+)
+
+// 메시지를 메모리에만 기록하는 Notifier (개발 환경 및 테스트용)
+type FakeNotifier struct {
+	mu   sync.Mutex
+	sent []Message
+	// Fail이 설정되어 있으면 전송 시 해당 함수의 결과를 오류로 반환합니다
+	Fail func(msg *Message) error
+}
+
+func NewFakeNotifier() *FakeNotifier {
+	return &FakeNotifier{}
+}
+
+func (n *FakeNotifier) Send(ctx context.Context, msg *Message) error {
+	if n.Fail != nil {
+		if err := n.Fail(msg); err != nil {
+			return err
+		}
+	}
+
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.sent = append(n.sent, *msg)
+	log.Printf("[fake notifier] %s: %s - %s", msg.DeviceToken, msg.Title, msg.Body)
+	return nil
+}
+
+// 지금까지 기록된 메시지 목록
+func (n *FakeNotifier) Sent() []Message {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	sent := make([]Message, len(n.sent))
+	copy(sent, n.sent)
+	return sent
+}
+
+func (n *FakeNotifier) Reset() {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.sent = nil
+}
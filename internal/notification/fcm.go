@@ -0,0 +1,82 @@
+package notification
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const defaultFCMEndpoint = "https://fcm.googleapis.com"
+
+// FCM HTTP v1 API 어댑터
+type FCMNotifier struct {
+	endpoint    string
+	projectID   string
+	accessToken string
+	client      *http.Client
+}
+
+func NewFCMNotifier(endpoint, projectID, accessToken string) *FCMNotifier {
+	if endpoint == "" {
+		endpoint = defaultFCMEndpoint
+	}
+	return &FCMNotifier{
+		endpoint:    strings.TrimRight(endpoint, "/"),
+		projectID:   projectID,
+		accessToken: accessToken,
+		client:      &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+type fcmRequest struct {
+	Message fcmMessage `json:"message"`
+}
+
+type fcmMessage struct {
+	Token        string            `json:"token"`
+	Notification fcmNotification   `json:"notification"`
+	Data         map[string]string `json:"data,omitempty"`
+}
+
+type fcmNotification struct {
+	Title string `json:"title"`
+	Body  string `json:"body"`
+}
+
+func (n *FCMNotifier) Send(ctx context.Context, msg *Message) error {
+	payload, err := json.Marshal(fcmRequest{
+		Message: fcmMessage{
+			Token:        msg.DeviceToken,
+			Notification: fcmNotification{Title: msg.Title, Body: msg.Body},
+			Data:         msg.Data,
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to encode FCM payload: %w", err)
+	}
+
+	url := fmt.Sprintf("%s/v1/projects/%s/messages:send", n.endpoint, n.projectID)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(payload))
+	if err != nil {
+		return fmt.Errorf("failed to build FCM request: %w", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+n.accessToken)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := n.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to send FCM request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode/100 != 2 {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return statusError("FCM", resp.StatusCode, string(body))
+	}
+	return nil
+}
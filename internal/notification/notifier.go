@@ -0,0 +1,73 @@
+package notification
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/School-meal-lover/backend/internal/models"
+)
+
+// 디바이스 한 대에 보낼 푸시 메시지
+type Message struct {
+	DeviceToken string
+	Title       string
+	Body        string
+	Data        map[string]string
+}
+
+// Notifier는 푸시 메시지를 실제로 전달하는 어댑터입니다
+type Notifier interface {
+	Send(ctx context.Context, msg *Message) error
+}
+
+// 재시도해도 성공할 수 없는 오류 (잘못된 토큰 등)
+type PermanentError struct {
+	Err error
+}
+
+func (e *PermanentError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *PermanentError) Unwrap() error {
+	return e.Err
+}
+
+func IsPermanent(err error) bool {
+	var permanent *PermanentError
+	return errors.As(err, &permanent)
+}
+
+// 응답 상태 코드로 오류 분류: 429와 5xx만 재시도 대상
+func statusError(provider string, status int, body string) error {
+	err := fmt.Errorf("%s responded with status %d: %s", provider, status, body)
+	if status == 429 || status >= 500 {
+		return err
+	}
+	return &PermanentError{Err: err}
+}
+
+// 환경변수 설정에 따라 플랫폼별 Notifier를 구성합니다
+// 설정이 없는 플랫폼은 실제로 전송하지 않는 FakeNotifier를 사용합니다
+func NewNotifiersFromEnv() map[string]Notifier {
+	notifiers := make(map[string]Notifier)
+
+	if projectID, token := os.Getenv("FCM_PROJECT_ID"), os.Getenv("FCM_ACCESS_TOKEN"); projectID != "" && token != "" {
+		notifiers[models.PlatformFCM] = NewFCMNotifier(os.Getenv("FCM_ENDPOINT"), projectID, token)
+	} else {
+		log.Println("FCM_PROJECT_ID/FCM_ACCESS_TOKEN not set; FCM notifications will not be delivered")
+		notifiers[models.PlatformFCM] = NewFakeNotifier()
+	}
+
+	if token, topic := os.Getenv("APNS_AUTH_TOKEN"), os.Getenv("APNS_TOPIC"); token != "" && topic != "" {
+		notifiers[models.PlatformAPNs] = NewAPNsNotifier(os.Getenv("APNS_ENDPOINT"), token, topic)
+	} else {
+		log.Println("APNS_AUTH_TOKEN/APNS_TOPIC not set; APNs notifications will not be delivered")
+		notifiers[models.PlatformAPNs] = NewFakeNotifier()
+	}
+
+	return notifiers
+}
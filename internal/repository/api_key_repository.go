@@ -0,0 +1,106 @@
+package repository
+
+import (
+	"database/sql"
+	"fmt"
+
+	"github.com/School-meal-lover/backend/internal/models"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+type APIKeyRepository struct {
+	db *sql.DB
+}
+
+func NewAPIKeyRepository(db *sql.DB) *APIKeyRepository {
+	return &APIKeyRepository{db: db}
+}
+
+func (r *APIKeyRepository) InsertAPIKey(key *models.APIKey) error {
+	if key.ID == "" {
+		key.ID = uuid.New().String()
+	}
+	query := `
+		INSERT INTO api_keys (id, name, prefix, key_hash, scopes, created_at, expires_at)
+		VALUES ($1, $2, $3, $4, $5, now(), $6)
+		RETURNING created_at`
+
+	err := r.db.QueryRow(query, key.ID, key.Name, key.Prefix, key.KeyHash, pq.Array(key.Scopes), key.ExpiresAt).
+		Scan(&key.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to insert api key: %w", err)
+	}
+	return nil
+}
+
+func (r *APIKeyRepository) ListAPIKeys() ([]*models.APIKey, error) {
+	rows, err := r.db.Query(`
+		SELECT id, name, prefix, key_hash, scopes, created_at, last_used_at, expires_at, revoked_at
+		FROM api_keys
+		ORDER BY created_at DESC`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get api keys: %w", err)
+	}
+	defer rows.Close()
+
+	keys := []*models.APIKey{}
+	for rows.Next() {
+		key, err := scanAPIKey(rows)
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, key)
+	}
+	return keys, rows.Err()
+}
+
+// 접두어로 키 조회 (없으면 nil)
+func (r *APIKeyRepository) GetAPIKeyByPrefix(prefix string) (*models.APIKey, error) {
+	row := r.db.QueryRow(`
+		SELECT id, name, prefix, key_hash, scopes, created_at, last_used_at, expires_at, revoked_at
+		FROM api_keys
+		WHERE prefix = $1`, prefix)
+	key, err := scanAPIKey(row)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get api key: %w", err)
+	}
+	return key, nil
+}
+
+// 마지막 사용 시각 기록 (요청마다 쓰지 않도록 1분 단위로만 갱신)
+func (r *APIKeyRepository) TouchAPIKey(id string) error {
+	_, err := r.db.Exec(`
+		UPDATE api_keys SET last_used_at = now()
+		WHERE id = $1 AND (last_used_at IS NULL OR last_used_at < now() - INTERVAL '1 minute')`, id)
+	if err != nil {
+		return fmt.Errorf("failed to update api key usage: %w", err)
+	}
+	return nil
+}
+
+// 키 폐기 (이미 폐기된 키도 찾으면 true)
+func (r *APIKeyRepository) RevokeAPIKey(id string) (bool, error) {
+	result, err := r.db.Exec(`UPDATE api_keys SET revoked_at = COALESCE(revoked_at, now()) WHERE id = $1`, id)
+	if err != nil {
+		return false, fmt.Errorf("failed to revoke api key: %w", err)
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return affected > 0, nil
+}
+
+func scanAPIKey(row rowScanner) (*models.APIKey, error) {
+	key := &models.APIKey{}
+	err := row.Scan(&key.ID, &key.Name, &key.Prefix, &key.KeyHash, pq.Array(&key.Scopes),
+		&key.CreatedAt, &key.LastUsedAt, &key.ExpiresAt, &key.RevokedAt)
+	if err != nil {
+		return nil, err
+	}
+	return key, nil
+}
@@ -0,0 +1,114 @@
+package repository
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/School-meal-lover/backend/internal/models"
+	"github.com/google/uuid"
+)
+
+type AuditRepository struct {
+	db *sql.DB
+}
+
+func NewAuditRepository(db *sql.DB) *AuditRepository {
+	return &AuditRepository{db: db}
+}
+
+func (r *AuditRepository) InsertAuditLog(entry *models.AuditLog) error {
+	if entry.ID == "" {
+		entry.ID = uuid.New().String()
+	}
+	if entry.Summary == nil {
+		entry.Summary = map[string]interface{}{}
+	}
+	if entry.Files == nil {
+		entry.Files = []models.AuditFile{}
+	}
+	summary, err := json.Marshal(entry.Summary)
+	if err != nil {
+		return fmt.Errorf("failed to encode audit summary: %w", err)
+	}
+	files, err := json.Marshal(entry.Files)
+	if err != nil {
+		return fmt.Errorf("failed to encode audit files: %w", err)
+	}
+
+	query := `
+		INSERT INTO audit_logs (id, actor, actor_role, action, method, endpoint, restaurant, week_id, summary, files, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, now())
+		RETURNING created_at`
+
+	err = r.db.QueryRow(query, entry.ID, entry.Actor, entry.ActorRole, entry.Action, entry.Method, entry.Endpoint,
+		entry.Restaurant, entry.WeekID, string(summary), string(files)).Scan(&entry.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to insert audit log: %w", err)
+	}
+	return nil
+}
+
+// 조건에 맞는 감사 로그 조회 (최신순)
+func (r *AuditRepository) ListAuditLogs(filter *models.AuditLogFilter) ([]*models.AuditLog, error) {
+	var conditions []string
+	var args []interface{}
+	addCondition := func(condition string, arg interface{}) {
+		args = append(args, arg)
+		conditions = append(conditions, fmt.Sprintf(condition, len(args)))
+	}
+
+	if filter.Actor != "" {
+		addCondition("actor = $%d", filter.Actor)
+	}
+	if filter.Action != "" {
+		addCondition("action = $%d", filter.Action)
+	}
+	if filter.Restaurant != "" {
+		addCondition("restaurant = $%d", filter.Restaurant)
+	}
+	if filter.WeekID != "" {
+		addCondition("week_id = $%d", filter.WeekID)
+	}
+	if filter.Since != nil {
+		addCondition("created_at >= $%d", *filter.Since)
+	}
+	if filter.Until != nil {
+		addCondition("created_at < $%d", *filter.Until)
+	}
+
+	query := `
+		SELECT id, actor, actor_role, action, method, endpoint, restaurant, week_id, summary::text, files::text, created_at
+		FROM audit_logs`
+	if len(conditions) > 0 {
+		query += "\n\t\tWHERE " + strings.Join(conditions, " AND ")
+	}
+	args = append(args, filter.Limit, filter.Offset)
+	query += fmt.Sprintf("\n\t\tORDER BY created_at DESC\n\t\tLIMIT $%d OFFSET $%d", len(args)-1, len(args))
+
+	rows, err := r.db.Query(query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get audit logs: %w", err)
+	}
+	defer rows.Close()
+
+	entries := []*models.AuditLog{}
+	for rows.Next() {
+		entry := &models.AuditLog{}
+		var summary, files string
+		err := rows.Scan(&entry.ID, &entry.Actor, &entry.ActorRole, &entry.Action, &entry.Method, &entry.Endpoint,
+			&entry.Restaurant, &entry.WeekID, &summary, &files, &entry.CreatedAt)
+		if err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal([]byte(summary), &entry.Summary); err != nil {
+			return nil, fmt.Errorf("failed to decode audit summary: %w", err)
+		}
+		if err := json.Unmarshal([]byte(files), &entry.Files); err != nil {
+			return nil, fmt.Errorf("failed to decode audit files: %w", err)
+		}
+		entries = append(entries, entry)
+	}
+	return entries, rows.Err()
+}
@@ -0,0 +1,85 @@
+package repository
+
+import (
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/School-meal-lover/backend/internal/models"
+	"github.com/google/uuid"
+)
+
+type ClosureRepository struct {
+	db *sql.DB
+}
+
+func NewClosureRepository(db *sql.DB) *ClosureRepository {
+	return &ClosureRepository{db: db}
+}
+
+func (r *ClosureRepository) InsertClosure(closure *models.Closure) error {
+	if closure.ID == "" {
+		closure.ID = uuid.New().String()
+	}
+	query := `
+		INSERT INTO closures (id, restaurant, start_date, end_date, meal_type, reason_ko, reason_en, created_by, created_at)
+		VALUES ($1, $2, $3, $4, NULLIF($5, ''), $6, $7, NULLIF($8, ''), now())
+		RETURNING created_at`
+
+	err := r.db.QueryRow(query, closure.ID, closure.Restaurant, closure.StartDate, closure.EndDate, closure.MealType,
+		closure.ReasonKo, closure.ReasonEn, closure.CreatedBy).Scan(&closure.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to insert closure: %w", err)
+	}
+	return nil
+}
+
+// 기간(from~to)과 겹치는 식당 휴무 (시작일 순)
+func (r *ClosureRepository) ListClosures(restaurant models.RestaurantType, from, to time.Time) ([]*models.Closure, error) {
+	rows, err := r.db.Query(`
+		SELECT id, restaurant, start_date, end_date, COALESCE(meal_type, ''), reason_ko, reason_en,
+			COALESCE(created_by, ''), created_at
+		FROM closures
+		WHERE restaurant = $1 AND start_date <= $3 AND end_date >= $2
+		ORDER BY start_date, meal_type NULLS FIRST, created_at`,
+		restaurant, from.Format("2006-01-02"), to.Format("2006-01-02"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list closures: %w", err)
+	}
+	defer rows.Close()
+
+	var closures []*models.Closure
+	for rows.Next() {
+		closure := &models.Closure{}
+		var startDate, endDate time.Time
+		err := rows.Scan(&closure.ID, &closure.Restaurant, &startDate, &endDate, &closure.MealType,
+			&closure.ReasonKo, &closure.ReasonEn, &closure.CreatedBy, &closure.CreatedAt)
+		if err != nil {
+			return nil, err
+		}
+		closure.StartDate = startDate.Format("2006-01-02")
+		closure.EndDate = endDate.Format("2006-01-02")
+		closures = append(closures, closure)
+	}
+	return closures, rows.Err()
+}
+
+func (r *ClosureRepository) DeleteClosure(id string) (*models.Closure, error) {
+	closure := &models.Closure{}
+	var startDate, endDate time.Time
+	err := r.db.QueryRow(`
+		DELETE FROM closures WHERE id = $1
+		RETURNING id, restaurant, start_date, end_date, COALESCE(meal_type, ''), reason_ko, reason_en,
+			COALESCE(created_by, ''), created_at`, id).
+		Scan(&closure.ID, &closure.Restaurant, &startDate, &endDate, &closure.MealType,
+			&closure.ReasonKo, &closure.ReasonEn, &closure.CreatedBy, &closure.CreatedAt)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to delete closure: %w", err)
+	}
+	closure.StartDate = startDate.Format("2006-01-02")
+	closure.EndDate = endDate.Format("2006-01-02")
+	return closure, nil
+}
@@ -0,0 +1,98 @@
+package repository
+
+import (
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/School-meal-lover/backend/internal/models"
+	"github.com/google/uuid"
+)
+
+type ImageRepository struct {
+	db *sql.DB
+}
+
+func NewImageRepository(db *sql.DB) *ImageRepository {
+	return &ImageRepository{db: db}
+}
+
+func (r *ImageRepository) InsertImage(image *models.Image) error {
+	if image.ID == "" {
+		image.ID = uuid.New().String()
+	}
+	query := `
+		INSERT INTO images (id, restaurant, week_id, image_name, valid_from,
+			blob_key, thumbnail_key, content_type, size_bytes, created_at)
+		VALUES ($1, $2, $3, $4, $5, NULLIF($6, ''), NULLIF($7, ''), NULLIF($8, ''), NULLIF($9, 0), now())
+		RETURNING created_at`
+
+	err := r.db.QueryRow(query, image.ID, image.Restaurant, image.WeekID, image.ImageName, image.ValidFrom,
+		image.BlobKey, image.ThumbnailKey, image.ContentType, image.SizeBytes).Scan(&image.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to insert image: %w", err)
+	}
+	return nil
+}
+
+// 해당 날짜에 유효한 이미지 (그 날짜 이전에 시작된 가장 최근 이미지)
+func (r *ImageRepository) GetImageValidAt(restaurant models.RestaurantType, date time.Time) (*models.Image, error) {
+	query := `
+		SELECT id, restaurant, week_id, image_name, valid_from,
+			COALESCE(blob_key, ''), COALESCE(thumbnail_key, ''), COALESCE(content_type, ''), COALESCE(size_bytes, 0), created_at
+		FROM images
+		WHERE restaurant = $1 AND valid_from <= $2
+		ORDER BY valid_from DESC, created_at DESC
+		LIMIT 1`
+
+	image, err := scanImage(r.db.QueryRow(query, restaurant, date))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get image: %w", err)
+	}
+	return image, nil
+}
+
+// 식당별 이미지 업로드 기록 (최신순)
+func (r *ImageRepository) GetImageHistory(restaurant models.RestaurantType, limit int) ([]*models.Image, error) {
+	query := `
+		SELECT id, restaurant, week_id, image_name, valid_from,
+			COALESCE(blob_key, ''), COALESCE(thumbnail_key, ''), COALESCE(content_type, ''), COALESCE(size_bytes, 0), created_at
+		FROM images
+		WHERE restaurant = $1
+		ORDER BY created_at DESC
+		LIMIT $2`
+
+	rows, err := r.db.Query(query, restaurant, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get image history: %w", err)
+	}
+	defer rows.Close()
+
+	var images []*models.Image
+	for rows.Next() {
+		image, err := scanImage(rows)
+		if err != nil {
+			return nil, err
+		}
+		images = append(images, image)
+	}
+	return images, rows.Err()
+}
+
+// *sql.Row와 *sql.Rows 공통
+type rowScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanImage(row rowScanner) (*models.Image, error) {
+	image := &models.Image{}
+	err := row.Scan(&image.ID, &image.Restaurant, &image.WeekID, &image.ImageName, &image.ValidFrom,
+		&image.BlobKey, &image.ThumbnailKey, &image.ContentType, &image.SizeBytes, &image.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+	return image, nil
+}
@@ -10,6 +10,7 @@ import (
 
 	"github.com/School-meal-lover/backend/internal/models"
 	"github.com/google/uuid"
+	"github.com/lib/pq"
 )
 
 type MealRepository struct {
@@ -20,99 +21,99 @@ func NewMealRepository(db *sql.DB) *MealRepository {
 	return &MealRepository{db: db}
 }
 
-// 주차 정보 삽입
-func (r *MealRepository) InsertWeek(startDate time.Time, restaurant models.RestaurantType) (string, error) {
-	weekID := uuid.New().String()
-	query := `
-        INSERT INTO weeks (id, start_date, restaurant, created_at, updated_at)
-        VALUES ($1, $2, $3, now(), now())
-				RETURNING id`
-
-	var insertedID string
-	err := r.db.QueryRow(query, weekID, startDate, restaurant).Scan(&insertedID)
-	if err != nil {
-		return "", fmt.Errorf("failed to insert week: %w", err)
-	}
+// 한 주로 합칠 수 있는 최대 기간
+const maxWeekDays = 7
 
-	return insertedID, nil
+// 업로드 기간이 기존 주차 두 개 이상과 겹치거나, 합치면 한 주를 넘는 경우
+type WeekOverlapError struct {
+	StartDate string
+	EndDate   string
+	Existing  []*models.WeekInfo
 }
-func (r *MealRepository) FindOrCreateWeek(startDate time.Time, restaurant models.RestaurantType) (string, error) {
-	var weekID string
-	findQuery := `SELECT id FROM weeks WHERE start_date = $1 AND restaurant = $2`
-	
-	err := r.db.QueryRow(findQuery, startDate, restaurant).Scan(&weekID)
-	
-	if err == nil {
-		log.Printf("Found existing week ID: %s for start date %s", weekID, startDate.Format("2006-01-02"))
-		return weekID, nil
-	}
 
-	if err == sql.ErrNoRows {
-		return r.InsertWeek(startDate, restaurant)
+func (e *WeekOverlapError) Error() string {
+	var ranges []string
+	for _, week := range e.Existing {
+		ranges = append(ranges, week.StartDate+" to "+week.EndDate)
 	}
-	return "", fmt.Errorf("error while trying to find week: %w", err)
+	return fmt.Sprintf("week %s to %s overlaps existing week %s", e.StartDate, e.EndDate, strings.Join(ranges, ", "))
 }
 
-
-// 식사 정보 삽입
-func (r *MealRepository) InsertMeal(meal *models.Meal) (string, error) {
-	if meal.ID == "" {
-		meal.ID = uuid.New().String()
-	}
-
-	query := `
-        INSERT INTO meals (id, weeks_id, date, day_of_week, meal_type, created_at, updated_at)
-        VALUES ($1, $2, $3, $4, $5, now(), now())
-        RETURNING id`
-
-	var insertedID string
-	err := r.db.QueryRow(query,
-		meal.ID, meal.WeekID,
-		meal.Date, meal.DayOfWeek, meal.MealType).Scan(&insertedID)
-
-	if err != nil {
-		return "", fmt.Errorf("failed to insert meal %w", err)
-	}
-
-	return insertedID, nil
+type queryer interface {
+	Query(query string, args ...interface{}) (*sql.Rows, error)
 }
-func (r *MealRepository) FindOrCreateMeal(meal *models.Meal) (string, error) {
-    var mealID string
-    findQuery := `SELECT id FROM meals WHERE weeks_id = $1 AND date = $2 AND meal_type = $3`
 
-    err := r.db.QueryRow(findQuery, meal.WeekID, meal.Date, meal.MealType).Scan(&mealID)
+// 게시 전 주차 상태 (업로드는 이 상태의 주차에 저장합니다)
+var editableWeekStatuses = []string{models.WeekStatusDraft, models.WeekStatusInReview}
 
-    if err == nil {
-        return mealID, nil
-    }
-
-    if err == sql.ErrNoRows {
-        return r.InsertMeal(meal)
-    }
-
-    return "", fmt.Errorf("error finding or creating meal: %w", err)
+// 업로드 기간(start~end)의 기준이 되는 기존 주차 조회 (없으면 nil)
+// 게시 전 주차(draft, in_review)가 있으면 그 주차, 없으면 지금 게시된 주차를 반환합니다
+// 겹치는 주차가 둘 이상이거나 합친 기간이 한 주를 넘으면 WeekOverlapError를 반환합니다
+func (r *MealRepository) FindWeekForUpload(restaurant models.RestaurantType, start, end time.Time) (*models.WeekInfo, error) {
+	draft, err := findWeekForRange(r.db, restaurant, start, end, editableWeekStatuses)
+	if err != nil || draft != nil {
+		return draft, err
+	}
+	return findWeekForRange(r.db, restaurant, start, end, []string{models.WeekStatusPublished})
 }
 
-func (r *MealRepository) HandleRepositoryError(err error, notFoundCode, notFoundMessage string) (*models.RestaurantMealsResponse, error) {
+// 상태가 statuses 중 하나인 주차에서 기간이 겹치는 주차 조회
+func findWeekForRange(q queryer, restaurant models.RestaurantType, start, end time.Time, statuses []string) (*models.WeekInfo, error) {
+	rows, err := q.Query(`
+		SELECT id, start_date, end_date, status
+		FROM weeks
+		WHERE restaurant = $1 AND start_date <= $3 AND end_date >= $2 AND status = ANY($4)
+		ORDER BY start_date`, restaurant, start, end, pq.Array(statuses))
 	if err != nil {
-		return &models.RestaurantMealsResponse{
-			Success: false,
-			Error:   notFoundMessage,
-			Code:    notFoundCode,
-		}, nil
+		return nil, fmt.Errorf("error while trying to find week: %w", err)
 	}
-	return nil, nil
+	defer rows.Close()
+
+	var overlapping []*models.WeekInfo
+	for rows.Next() {
+		week := &models.WeekInfo{}
+		var startDate, endDate time.Time
+		if err := rows.Scan(&week.ID, &startDate, &endDate, &week.Status); err != nil {
+			return nil, err
+		}
+		week.StartDate = startDate.Format("2006-01-02")
+		week.EndDate = endDate.Format("2006-01-02")
+		overlapping = append(overlapping, week)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return mergeableWeek(overlapping, start, end)
 }
 
-// 메뉴 아이템 일괄 삽입
-func (r *MealRepository) InsertMenuItems(menuItems []models.MenuItem) error {
-	if len(menuItems) == 0 {
-		return nil
+// 업로드 기간과 겹치는 주차(시작일 순) 중 업로드를 합칠 주차 (겹치는 주차가 없으면 nil)
+// 겹치는 주차가 둘 이상이거나 합친 기간이 한 주를 넘으면 WeekOverlapError를 반환합니다
+func mergeableWeek(overlapping []*models.WeekInfo, start, end time.Time) (*models.WeekInfo, error) {
+	if len(overlapping) == 0 {
+		return nil, nil
+	}
+	overlapErr := &WeekOverlapError{StartDate: start.Format("2006-01-02"), EndDate: end.Format("2006-01-02"), Existing: overlapping}
+	if len(overlapping) > 1 {
+		return nil, overlapErr
+	}
+	week := overlapping[0]
+	mergedStart := min(week.StartDate, overlapErr.StartDate)
+	mergedEnd := max(week.EndDate, overlapErr.EndDate)
+	first, _ := time.Parse("2006-01-02", mergedStart)
+	last, _ := time.Parse("2006-01-02", mergedEnd)
+	if last.Sub(first) >= maxWeekDays*24*time.Hour {
+		return nil, overlapErr
 	}
+	return week, nil
+}
 
+// 한 주 식단을 한 트랜잭션으로 저장합니다
+// 업로드에 포함된 식사는 메뉴 목록 전체를 업로드 내용으로 바꾸고, 포함되지 않은 식사는 그대로 둡니다
+// 영어 이름이 비어 있거나 알레르기 정보가 없으면(nil) 기존 값을 유지합니다
+func (r *MealRepository) SaveWeekMenu(menu *models.WeekMenu) (string, error) {
 	tx, err := r.db.Begin()
 	if err != nil {
-		return fmt.Errorf("failed to begin transaction: %w", err)
+		return "", fmt.Errorf("failed to begin transaction: %w", err)
 	}
 	defer func() {
 		if err := tx.Rollback(); err != nil && err != sql.ErrTxDone {
@@ -120,31 +121,186 @@ func (r *MealRepository) InsertMenuItems(menuItems []models.MenuItem) error {
 		}
 	}()
 
-	stmt, err := tx.Prepare(`
-				INSERT INTO menu_items (id, meals_id, category, name, name_en, price, created_at, updated_at)
-				VALUES ($1, $2, $3, $4, $5, $6, NOW(), NOW())
+	weekID, err := saveWeek(tx, menu.Restaurant, menu.WeekStart, menu.WeekEnd)
+	if err != nil {
+		return "", err
+	}
+
+	upsert, err := tx.Prepare(`
+				INSERT INTO menu_items (id, meals_id, category, name, name_en, price, allergens, created_at, updated_at)
+				VALUES ($1, $2, $3, $4, $5, $6, $7, NOW(), NOW())
 				ON CONFLICT (meals_id, category, name) DO UPDATE SET
-					name_en = EXCLUDED.name_en,
+					name_en = COALESCE(NULLIF(EXCLUDED.name_en, ''), menu_items.name_en),
 					price = EXCLUDED.price,
-					updated_at = NOW();
+					allergens = COALESCE(EXCLUDED.allergens, menu_items.allergens),
+					updated_at = NOW()
+				WHERE COALESCE(NULLIF(EXCLUDED.name_en, ''), menu_items.name_en) IS DISTINCT FROM menu_items.name_en
+					OR EXCLUDED.price IS DISTINCT FROM menu_items.price
+					OR COALESCE(EXCLUDED.allergens, menu_items.allergens) IS DISTINCT FROM menu_items.allergens;
 			`)
 	if err != nil {
-		return fmt.Errorf("failed to prepare statement: %w", err)
+		return "", fmt.Errorf("failed to prepare statement: %w", err)
 	}
-	defer stmt.Close()
+	defer upsert.Close()
+
+	for _, day := range menu.Days {
+		for _, mealMenu := range day.Meals {
+			mealID, err := findOrCreateMeal(tx, &models.Meal{
+				WeekID:    weekID,
+				Date:      day.Date,
+				DayOfWeek: day.DayOfWeek,
+				MealType:  mealMenu.MealType,
+			})
+			if err != nil {
+				return "", err
+			}
+
+			var categories, names []string
+			for _, item := range mealMenu.Items {
+				// 알레르기 정보가 없으면(nil) NULL로 넘겨 기존 값을 유지합니다
+				_, err := upsert.Exec(uuid.New().String(), mealID, item.Category, item.Name, item.NameEn, item.Price, pq.Array(item.Allergens))
+				if err != nil {
+					return "", fmt.Errorf("failed to insert menu item %s: %w", item.Name, err)
+				}
+				categories = append(categories, item.Category)
+				names = append(names, item.Name)
+			}
 
-	for _, item := range menuItems {
-		if item.ID == "" {
-			item.ID = uuid.New().String()
+			// 업로드에서 빠진 메뉴 삭제
+			_, err = tx.Exec(`
+				DELETE FROM menu_items
+				WHERE meals_id = $1
+				AND (category, name) NOT IN (SELECT * FROM unnest($2::text[], $3::text[]))`,
+				mealID, pq.Array(categories), pq.Array(names))
+			if err != nil {
+				return "", fmt.Errorf("failed to delete removed menu items: %w", err)
+			}
 		}
+	}
 
-		_, err := stmt.Exec(item.ID, item.MealID, item.Category, item.Name, item.NameEn, item.Price)
+	if err := tx.Commit(); err != nil {
+		return "", fmt.Errorf("failed to commit week menu: %w", err)
+	}
+	return weekID, nil
+}
+
+// 게시 전 주차가 있으면 기간을 넓혀서 쓰고, 없으면 draft 주차를 새로 만듭니다
+// 새 draft는 같은 기간에 게시된 주차의 식단을 복사해서 시작하므로, 일부 식사만 올려도 게시할 때 나머지가 사라지지 않습니다
+// 같은 식당의 업로드가 동시에 겹치는 주차를 만들지 않도록 식당별로 잠급니다
+func saveWeek(tx *sql.Tx, restaurant models.RestaurantType, start, end time.Time) (string, error) {
+	if err := lockRestaurantWeeks(tx, restaurant); err != nil {
+		return "", err
+	}
+
+	draft, err := findWeekForRange(tx, restaurant, start, end, editableWeekStatuses)
+	if err != nil {
+		return "", err
+	}
+	if draft != nil {
+		// 검토 중에 다시 올리면 검토를 다시 요청해야 합니다
+		_, err := tx.Exec(`
+			UPDATE weeks
+			SET start_date = LEAST(start_date, $2), end_date = GREATEST(end_date, $3), status = $4, updated_at = now()
+			WHERE id = $1`, draft.ID, start, end, models.WeekStatusDraft)
 		if err != nil {
-			return fmt.Errorf("failed to insert menu item %s: %w", item.Name, err)
+			return "", fmt.Errorf("failed to update week dates: %w", err)
 		}
+		log.Printf("Found draft week ID: %s for %s to %s", draft.ID, start.Format("2006-01-02"), end.Format("2006-01-02"))
+		return draft.ID, nil
 	}
 
-	return tx.Commit()
+	published, err := findWeekForRange(tx, restaurant, start, end, []string{models.WeekStatusPublished})
+	if err != nil {
+		return "", err
+	}
+	if published != nil {
+		publishedStart, _ := time.Parse("2006-01-02", published.StartDate)
+		publishedEnd, _ := time.Parse("2006-01-02", published.EndDate)
+		if publishedStart.Before(start) {
+			start = publishedStart
+		}
+		if publishedEnd.After(end) {
+			end = publishedEnd
+		}
+	}
+
+	var weekID string
+	err = tx.QueryRow(`
+        INSERT INTO weeks (id, start_date, end_date, restaurant, status, created_at, updated_at)
+        VALUES ($1, $2, $3, $4, $5, now(), now())
+				RETURNING id`, uuid.New().String(), start, end, restaurant, models.WeekStatusDraft).Scan(&weekID)
+	if err != nil {
+		return "", fmt.Errorf("failed to insert week: %w", err)
+	}
+	if published != nil {
+		if err := copyWeekMeals(tx, published.ID, weekID); err != nil {
+			return "", err
+		}
+	}
+	return weekID, nil
+}
+
+// 같은 식당의 주차를 만들거나 상태를 바꾸는 트랜잭션을 차례로 실행합니다
+func lockRestaurantWeeks(tx *sql.Tx, restaurant models.RestaurantType) error {
+	if _, err := tx.Exec(`SELECT pg_advisory_xact_lock(hashtext('weeks:' || $1))`, string(restaurant)); err != nil {
+		return fmt.Errorf("failed to lock weeks: %w", err)
+	}
+	return nil
+}
+
+// 게시된 주차의 식사와 메뉴를 새 draft 주차로 복사합니다
+func copyWeekMeals(tx *sql.Tx, fromWeekID, toWeekID string) error {
+	_, err := tx.Exec(`
+		INSERT INTO meals (id, weeks_id, date, day_of_week, meal_type, created_at, updated_at)
+		SELECT gen_random_uuid(), $2, date, day_of_week, meal_type, now(), now()
+		FROM meals WHERE weeks_id = $1`, fromWeekID, toWeekID)
+	if err != nil {
+		return fmt.Errorf("failed to copy meals: %w", err)
+	}
+	_, err = tx.Exec(`
+		INSERT INTO menu_items (id, meals_id, category, name, name_en, price, allergens, created_at, updated_at)
+		SELECT gen_random_uuid(), nm.id, mi.category, mi.name, mi.name_en, mi.price, mi.allergens, now(), now()
+		FROM menu_items mi
+		JOIN meals om ON om.id = mi.meals_id
+		JOIN meals nm ON nm.weeks_id = $2 AND nm.date = om.date AND nm.meal_type = om.meal_type
+		WHERE om.weeks_id = $1`, fromWeekID, toWeekID)
+	if err != nil {
+		return fmt.Errorf("failed to copy menu items: %w", err)
+	}
+	return nil
+}
+
+func findOrCreateMeal(tx *sql.Tx, meal *models.Meal) (string, error) {
+	var mealID string
+	err := tx.QueryRow(`SELECT id FROM meals WHERE weeks_id = $1 AND date = $2 AND meal_type = $3`,
+		meal.WeekID, meal.Date, meal.MealType).Scan(&mealID)
+	if err == nil {
+		return mealID, nil
+	}
+	if err != sql.ErrNoRows {
+		return "", fmt.Errorf("error finding or creating meal: %w", err)
+	}
+
+	err = tx.QueryRow(`
+        INSERT INTO meals (id, weeks_id, date, day_of_week, meal_type, created_at, updated_at)
+        VALUES ($1, $2, $3, $4, $5, now(), now())
+        RETURNING id`,
+		uuid.New().String(), meal.WeekID, meal.Date, meal.DayOfWeek, meal.MealType).Scan(&mealID)
+	if err != nil {
+		return "", fmt.Errorf("failed to insert meal %w", err)
+	}
+	return mealID, nil
+}
+
+func (r *MealRepository) HandleRepositoryError(err error, notFoundCode, notFoundMessage string) (*models.RestaurantMealsResponse, error) {
+	if err != nil {
+		return &models.RestaurantMealsResponse{
+			Success: false,
+			Error:   notFoundMessage,
+			Code:    notFoundCode,
+		}, nil
+	}
+	return nil, nil
 }
 
 // weekID로부터 restaurant 정보 조회
@@ -158,53 +314,130 @@ func (r *MealRepository) GetRestaurantByWeekID(weekID string) (models.Restaurant
 	return restaurant, nil
 }
 
+// 날짜가 포함된 게시된 주차 조회
+// 그 날짜의 식단이 있는 주차를 먼저 찾고, 없으면 기간(start_date~end_date)에 날짜가 들어가는 주차를 찾습니다
 func (r *MealRepository) GetWeekInfo(restaurant models.RestaurantType, date string) (*models.WeekInfo, error) {
 	week := &models.WeekInfo{}
-	var startDate time.Time
-	
-	var daysInterval int
-	if restaurant == models.Restaurant1 {
-		daysInterval = 4 
-	} else {
-		daysInterval = 6
-	}
-	
-	query := fmt.Sprintf(`
-		SELECT id, start_date
-        FROM weeks 
-        WHERE restaurant = $1 
-        AND $2 >= start_date 
-        AND $2 <= start_date + INTERVAL '%d days'
-        ORDER BY start_date DESC
-        LIMIT 1`, daysInterval)
-
-	err := r.db.QueryRow(query, restaurant, date).Scan(&week.ID, &startDate)
+	var startDate, endDate time.Time
+
+	query := `
+		SELECT w.id, w.start_date, w.end_date, w.status
+		FROM weeks w
+		WHERE w.restaurant = $1 AND w.status = $3
+		AND (
+			EXISTS (SELECT 1 FROM meals m WHERE m.weeks_id = w.id AND m.date = $2)
+			OR $2 BETWEEN w.start_date AND w.end_date
+		)
+		ORDER BY EXISTS (SELECT 1 FROM meals m WHERE m.weeks_id = w.id AND m.date = $2) DESC, w.start_date DESC
+		LIMIT 1`
+
+	err := r.db.QueryRow(query, restaurant, date, models.WeekStatusPublished).Scan(&week.ID, &startDate, &endDate, &week.Status)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get week by date: %w", err)
 	}
 	week.StartDate = startDate.Format("2006-01-02")
-	week.EndDate = startDate.AddDate(0, 0, daysInterval).Format("2006-01-02")
+	week.EndDate = endDate.Format("2006-01-02")
 
 	return week, nil
 }
 
+// weekID로 주차 정보 조회
+func (r *MealRepository) GetWeekByID(weekID string) (*models.WeekInfo, error) {
+	week := &models.WeekInfo{}
+	var startDate, endDate time.Time
+
+	query := `SELECT id, start_date, end_date, status FROM weeks WHERE id = $1`
+	err := r.db.QueryRow(query, weekID).Scan(&week.ID, &startDate, &endDate, &week.Status)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get week by ID: %w", err)
+	}
+	week.StartDate = startDate.Format("2006-01-02")
+	week.EndDate = endDate.Format("2006-01-02")
+
+	return week, nil
+}
+
+// 특정 날짜 이후에 끝나는 게시된 주차 목록 (시작일 순)
+func (r *MealRepository) ListWeeksSince(restaurant models.RestaurantType, since time.Time) ([]*models.WeekInfo, error) {
+	query := `
+		SELECT id, start_date, end_date, status
+		FROM weeks
+		WHERE restaurant = $1 AND status = $3
+		AND end_date >= $2
+		ORDER BY start_date`
+
+	rows, err := r.db.Query(query, restaurant, since, models.WeekStatusPublished)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list weeks: %w", err)
+	}
+	defer rows.Close()
+
+	var weeks []*models.WeekInfo
+	for rows.Next() {
+		week := &models.WeekInfo{}
+		var startDate, endDate time.Time
+		if err := rows.Scan(&week.ID, &startDate, &endDate, &week.Status); err != nil {
+			return nil, err
+		}
+		week.StartDate = startDate.Format("2006-01-02")
+		week.EndDate = endDate.Format("2006-01-02")
+		weeks = append(weeks, week)
+	}
+	return weeks, rows.Err()
+}
+
+// 주차의 날짜별 마지막 수정 시각
+func (r *MealRepository) GetDayUpdatedTimes(weekID string) (map[string]time.Time, error) {
+	query := `
+		SELECT m.date, GREATEST(MAX(m.updated_at), COALESCE(MAX(mi.updated_at), MAX(m.updated_at)))
+		FROM meals m
+		LEFT JOIN menu_items mi ON m.id = mi.meals_id
+		WHERE m.weeks_id = $1
+		GROUP BY m.date`
+
+	rows, err := r.db.Query(query, weekID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get day updated times: %w", err)
+	}
+	defer rows.Close()
+
+	updated := make(map[string]time.Time)
+	for rows.Next() {
+		var date, updatedAt time.Time
+		if err := rows.Scan(&date, &updatedAt); err != nil {
+			return nil, err
+		}
+		updated[date.Format("2006-01-02")] = updatedAt
+	}
+	return updated, rows.Err()
+}
+
+// 설정(meal_types)에 없는 식사 종류는 뒤에 표시
+const unknownMealTypeOrder = 1000
+
 func (r *MealRepository) GetMealsData(weekID string) ([]*models.DayMeals, *models.MealsSummary, error) {
 	query := `
 			SELECT
-								m.id, m.date, m.day_of_week, m.meal_type, mi.category,
-								COALESCE(mi.id, '00000000-0000-0000-0000-000000000000'::UUID) as menu_id,
+								m.id, m.date, m.day_of_week, m.meal_type,
+								COALESCE(mt.label_ko, '') as label_ko,
+								COALESCE(mt.label_en, '') as label_en,
+								COALESCE(to_char(mt.serving_start, 'HH24:MI'), '') as serving_start,
+								COALESCE(to_char(mt.serving_end, 'HH24:MI'), '') as serving_end,
+								COALESCE(mt.display_order, $2) as display_order,
+								COALESCE(mi.category, '') as category,
+								COALESCE(mi.id::text, '') as menu_id,
 								COALESCE(mi.name, '') as menu_name,
 								COALESCE(mi.name_en, '') as menu_name_en,
-								COALESCE(mi.price, 0) as price
+								COALESCE(mi.price, 0) as price,
+								COALESCE(mi.allergens, '{}') as allergens
 						FROM meals m
+						JOIN weeks w ON w.id = m.weeks_id
+						LEFT JOIN meal_types mt ON mt.restaurant = w.restaurant AND mt.code = m.meal_type
 						LEFT JOIN menu_items mi ON m.id = mi.meals_id
 						WHERE m.weeks_id = $1
-						ORDER BY m.date,
-										CASE m.meal_type
-												WHEN 'Breakfast' THEN 1 WHEN 'Lunch_1' THEN 2
-												WHEN 'Lunch_2' THEN 3 WHEN 'Dinner' THEN 4 END`
+						ORDER BY m.date, display_order, m.meal_type`
 
-	rows, err := r.db.Query(query, weekID)
+	rows, err := r.db.Query(query, weekID, unknownMealTypeOrder)
 	if err != nil {
 		return nil, nil, fmt.Errorf("failed to get meals data: %w", err)
 	}
@@ -217,10 +450,14 @@ func (r *MealRepository) GetMealsData(weekID string) ([]*models.DayMeals, *model
 
 	for rows.Next() {
 		var mealID, dayOfWeek, mealType, menuID, category, menuName, menuNameEn string
+		var label, labelEn, servingStart, servingEnd string
+		var displayOrder int
 		var date time.Time
 		var price float64
+		var allergens []string
 
-		err := rows.Scan(&mealID, &date, &dayOfWeek, &mealType, &category, &menuID, &menuName, &menuNameEn, &price)
+		err := rows.Scan(&mealID, &date, &dayOfWeek, &mealType, &label, &labelEn, &servingStart, &servingEnd, &displayOrder,
+			&category, &menuID, &menuName, &menuNameEn, &price, pq.Array(&allergens))
 		if err != nil {
 			return nil, nil, err
 		}
@@ -237,14 +474,19 @@ func (r *MealRepository) GetMealsData(weekID string) ([]*models.DayMeals, *model
 		mealKey := mealID
 		if mealMap[mealKey] == nil {
 			mealMap[mealKey] = &models.MealInfo{
-				MealID:    mealID,
-				MealType:  mealType,
-				MenuItems: []*models.MenuItemResponse{},
+				MealID:       mealID,
+				MealType:     mealType,
+				Label:        label,
+				LabelEn:      labelEn,
+				ServingStart: servingStart,
+				ServingEnd:   servingEnd,
+				DisplayOrder: displayOrder,
+				MenuItems:    []*models.MenuItemResponse{},
 			}
 			mealsByDay[dayOfWeek].Meals[mealType] = mealMap[mealKey]
 			totalMeals++
 		}
-		// 메뉴 아이템 넣기
+		// 메뉴 아이템 넣기 (메뉴가 없는 식사는 빈 목록)
 		if menuID != "" {
 			menuItem := &models.MenuItemResponse{
 				ID:       menuID,
@@ -253,6 +495,9 @@ func (r *MealRepository) GetMealsData(weekID string) ([]*models.DayMeals, *model
 				NameEn:   menuNameEn,
 				Price:    price,
 			}
+			if len(allergens) > 0 {
+				menuItem.Allergens = allergens
+			}
 			mealMap[mealKey].MenuItems = append(mealMap[mealKey].MenuItems, menuItem)
 			totalMenuItems++
 		}
@@ -280,66 +525,35 @@ func (r *MealRepository) GetMealsData(weekID string) ([]*models.DayMeals, *model
 	return orderedDays, summary, nil
 }
 
-func (r *MealRepository) GetMealIDByWeekDateAndType(weekID, date, mealType string) (string, error) {
-	var mealID string
-	query := `
-        SELECT id FROM meals 
-        WHERE weeks_id = $1 AND date = $2 AND meal_type = $3
-        LIMIT 1
-    `
-	err := r.db.QueryRow(query, weekID, date, mealType).Scan(&mealID)
-	return mealID, err
-}
+// 식당에서 지금까지 게시된 메뉴 이름별로 쓰인 분류와 횟수 (분류 추정용, draft는 게시된 식단의 복사본이라 제외)
+func (r *MealRepository) GetCategoryHistory(restaurant models.RestaurantType, names []string) (map[string]map[string]int, error) {
+	history := make(map[string]map[string]int)
+	if len(names) == 0 {
+		return history, nil
+	}
 
-func (r *MealRepository) GetMenuItemsByMealIDOrdered(mealID string) ([]models.MenuItem, error) {
-	query := `
-        SELECT id, meals_id, category, name, name_en, price
-        FROM menu_items
-        WHERE meals_id = $1
-        ORDER BY id ASC
-    `
-	rows, err := r.db.Query(query, mealID)
+	rows, err := r.db.Query(`
+		SELECT mi.name, mi.category, COUNT(*)
+		FROM menu_items mi
+		JOIN meals m ON m.id = mi.meals_id
+		JOIN weeks w ON w.id = m.weeks_id
+		WHERE w.restaurant = $1 AND mi.name = ANY($2) AND w.status = $3
+		GROUP BY mi.name, mi.category`, restaurant, pq.Array(names), models.WeekStatusPublished)
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("failed to get category history: %w", err)
 	}
 	defer rows.Close()
 
-	var items []models.MenuItem
 	for rows.Next() {
-		var item models.MenuItem
-		if err := rows.Scan(&item.ID, &item.MealID, &item.Category, &item.Name, &item.NameEn, &item.Price); err != nil {
+		var name, category string
+		var count int
+		if err := rows.Scan(&name, &category, &count); err != nil {
 			return nil, err
 		}
-		items = append(items, item)
-	}
-	return items, nil
-}
-
-func (r *MealRepository) UpdateMenuItemNameEn(menuItemID, nameEn string) error {
-	_, err := r.db.Exec(`
-        UPDATE menu_items
-        SET name_en = $1
-        WHERE id = $2
-    `, nameEn, menuItemID)
-	return err
-}
-func (r *MealRepository) UpdateMenuItemsEnglishNameBatch(items []models.MenuItem) error {
-	if len(items) == 0 {
-		return nil
-	}
-
-	query := "UPDATE menu_items SET name_en = CASE id"
-	var ids []string
-	args := []interface{}{}
-
-	for i, item := range items {
-		query += fmt.Sprintf(" WHEN $%d THEN $%d", i*2+1, i*2+2)
-		args = append(args, item.ID, item.NameEn)
-		ids = append(ids, fmt.Sprintf("$%d", i*2+1))
+		if history[name] == nil {
+			history[name] = make(map[string]int)
+		}
+		history[name][category] = count
 	}
-
-	query += " END WHERE id IN (" + strings.Join(ids, ",") + ")"
-
-	_, err := r.db.Exec(query, args...)
-	return err
+	return history, rows.Err()
 }
@@ -0,0 +1,129 @@
+package repository
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/School-meal-lover/backend/internal/models"
+	"github.com/School-meal-lover/backend/internal/testdb"
+)
+
+func testDate(value string) time.Time {
+	date, err := time.Parse("2006-01-02", value)
+	if err != nil {
+		panic(err)
+	}
+	return date
+}
+
+func TestMergeableWeek(t *testing.T) {
+	tests := []struct {
+		name        string
+		existing    [][2]string
+		start, end  string
+		wantOverlap bool
+	}{
+		{name: "no overlap", start: "2025-05-26", end: "2025-05-30"},
+		// 월요일 공휴일 다음 수요일에 시작한 주차에 한 주 전체를 올리는 경우
+		{name: "wednesday start", existing: [][2]string{{"2025-05-28", "2025-05-30"}}, start: "2025-05-26", end: "2025-05-30"},
+		{name: "partial week", existing: [][2]string{{"2025-05-26", "2025-05-27"}}, start: "2025-05-27", end: "2025-05-30"},
+		{name: "seven days", existing: [][2]string{{"2025-05-26", "2025-05-30"}}, start: "2025-05-28", end: "2025-06-01"},
+		{name: "eight days", existing: [][2]string{{"2025-05-26", "2025-05-30"}}, start: "2025-05-30", end: "2025-06-02", wantOverlap: true},
+		{name: "over a week", existing: [][2]string{{"2025-05-26", "2025-06-01"}}, start: "2025-05-30", end: "2025-06-05", wantOverlap: true},
+		{name: "two weeks", existing: [][2]string{{"2025-05-26", "2025-05-27"}, {"2025-05-29", "2025-05-30"}}, start: "2025-05-26", end: "2025-05-30", wantOverlap: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var existing []*models.WeekInfo
+			for i, dates := range tt.existing {
+				existing = append(existing, &models.WeekInfo{ID: string(rune('a' + i)), StartDate: dates[0], EndDate: dates[1]})
+			}
+
+			week, err := mergeableWeek(existing, testDate(tt.start), testDate(tt.end))
+			if tt.wantOverlap {
+				var overlapErr *WeekOverlapError
+				if !errors.As(err, &overlapErr) {
+					t.Fatalf("err = %v, want WeekOverlapError", err)
+				}
+				if overlapErr.StartDate != tt.start || overlapErr.EndDate != tt.end || len(overlapErr.Existing) != len(existing) {
+					t.Errorf("overlap error = %+v", overlapErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(existing) == 0 {
+				if week != nil {
+					t.Errorf("week = %+v, want nil", week)
+				}
+				return
+			}
+			if week != existing[0] {
+				t.Errorf("week = %+v, want %+v", week, existing[0])
+			}
+		})
+	}
+}
+
+func TestFindWeekForUpload(t *testing.T) {
+	db := testdb.Open(t, testdb.Latest(t))
+	weeks := []struct {
+		id         string
+		restaurant models.RestaurantType
+		start, end string
+		status     string
+	}{
+		{"00000000-0000-0000-0000-000000000001", models.Restaurant1, "2025-05-26", "2025-05-30", models.WeekStatusPublished},
+		{"00000000-0000-0000-0000-000000000002", models.Restaurant1, "2025-05-28", "2025-05-30", models.WeekStatusDraft},
+		{"00000000-0000-0000-0000-000000000003", models.Restaurant1, "2025-06-02", "2025-06-06", models.WeekStatusPublished},
+		{"00000000-0000-0000-0000-000000000004", models.Restaurant1, "2025-06-09", "2025-06-13", models.WeekStatusRetracted},
+		{"00000000-0000-0000-0000-000000000005", models.Restaurant2, "2025-05-26", "2025-06-01", models.WeekStatusInReview},
+	}
+	for _, week := range weeks {
+		_, err := db.Exec(`INSERT INTO weeks (id, restaurant, start_date, end_date, status) VALUES ($1, $2, $3, $4, $5)`,
+			week.id, week.restaurant, week.start, week.end, week.status)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	tests := []struct {
+		name        string
+		restaurant  models.RestaurantType
+		start, end  string
+		want        string
+		wantOverlap bool
+	}{
+		// 게시 전 주차가 게시된 주차보다 먼저입니다
+		{name: "draft first", restaurant: models.Restaurant1, start: "2025-05-26", end: "2025-05-30", want: weeks[1].id},
+		{name: "published", restaurant: models.Restaurant1, start: "2025-06-02", end: "2025-06-04", want: weeks[2].id},
+		{name: "retracted weeks are ignored", restaurant: models.Restaurant1, start: "2025-06-09", end: "2025-06-13"},
+		{name: "in review", restaurant: models.Restaurant2, start: "2025-05-31", end: "2025-06-01", want: weeks[4].id},
+		{name: "over a week", restaurant: models.Restaurant2, start: "2025-05-30", end: "2025-06-05", wantOverlap: true},
+	}
+	repo := NewMealRepository(db)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			week, err := repo.FindWeekForUpload(tt.restaurant, testDate(tt.start), testDate(tt.end))
+			if tt.wantOverlap {
+				var overlapErr *WeekOverlapError
+				if !errors.As(err, &overlapErr) {
+					t.Errorf("err = %v, want WeekOverlapError", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			var got string
+			if week != nil {
+				got = week.ID
+			}
+			if got != tt.want {
+				t.Errorf("week = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,71 @@
+package repository
+
+import (
+	"database/sql"
+	"fmt"
+
+	"github.com/School-meal-lover/backend/internal/models"
+)
+
+type MealTypeRepository struct {
+	db *sql.DB
+}
+
+func NewMealTypeRepository(db *sql.DB) *MealTypeRepository {
+	return &MealTypeRepository{db: db}
+}
+
+// 모든 식당의 식사 종류 (식당, 표시 순서 순)
+func (r *MealTypeRepository) ListMealTypes() ([]*models.MealType, error) {
+	rows, err := r.db.Query(`
+		SELECT restaurant, code, label_ko, label_en, display_order,
+			to_char(serving_start, 'HH24:MI'), to_char(serving_end, 'HH24:MI'),
+			excel_start_row, excel_end_row, updated_at
+		FROM meal_types
+		ORDER BY restaurant, display_order, code`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list meal types: %w", err)
+	}
+	defer rows.Close()
+
+	var mealTypes []*models.MealType
+	for rows.Next() {
+		mealType := &models.MealType{}
+		var startRow, endRow sql.NullInt64
+		err := rows.Scan(&mealType.Restaurant, &mealType.Code, &mealType.LabelKo, &mealType.LabelEn, &mealType.DisplayOrder,
+			&mealType.ServingStart, &mealType.ServingEnd, &startRow, &endRow, &mealType.UpdatedAt)
+		if err != nil {
+			return nil, err
+		}
+		if startRow.Valid && endRow.Valid {
+			start, end := int(startRow.Int64), int(endRow.Int64)
+			mealType.ExcelStartRow, mealType.ExcelEndRow = &start, &end
+		}
+		mealTypes = append(mealTypes, mealType)
+	}
+	return mealTypes, rows.Err()
+}
+
+// 식사 종류 추가 또는 수정
+func (r *MealTypeRepository) UpsertMealType(mealType *models.MealType) error {
+	err := r.db.QueryRow(`
+		INSERT INTO meal_types (restaurant, code, label_ko, label_en, display_order, serving_start, serving_end,
+			excel_start_row, excel_end_row, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, now(), now())
+		ON CONFLICT (restaurant, code) DO UPDATE SET
+			label_ko = EXCLUDED.label_ko,
+			label_en = EXCLUDED.label_en,
+			display_order = EXCLUDED.display_order,
+			serving_start = EXCLUDED.serving_start,
+			serving_end = EXCLUDED.serving_end,
+			excel_start_row = EXCLUDED.excel_start_row,
+			excel_end_row = EXCLUDED.excel_end_row,
+			updated_at = now()
+		RETURNING updated_at`,
+		mealType.Restaurant, mealType.Code, mealType.LabelKo, mealType.LabelEn, mealType.DisplayOrder,
+		mealType.ServingStart, mealType.ServingEnd, mealType.ExcelStartRow, mealType.ExcelEndRow).Scan(&mealType.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to save meal type: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,108 @@
+package repository
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/School-meal-lover/backend/internal/testdb"
+)
+
+// 013: 기존 주차에 end_date를 채우고 기간이 겹치는 주차를 하나로 합칩니다
+func TestMigrationWeekEndDate(t *testing.T) {
+	db := testdb.Open(t, 12)
+	const (
+		weekA = "00000000-0000-0000-0000-00000000000a" // 제1학생식당 월요일 주차
+		weekB = "00000000-0000-0000-0000-00000000000b" // 같은 시작일로 나중에 만들어진 주차
+		weekE = "00000000-0000-0000-0000-00000000000e" // 공휴일 다음 수요일에 시작한 주차
+		weekC = "00000000-0000-0000-0000-00000000000c" // 다음 주
+		weekD = "00000000-0000-0000-0000-00000000000d" // 제2학생식당
+	)
+	statements := []string{
+		`INSERT INTO weeks (id, start_date, restaurant, created_at) VALUES
+			('` + weekA + `', '2025-05-26', 'RESTAURANT_1', '2025-05-20 09:00'),
+			('` + weekB + `', '2025-05-26', 'RESTAURANT_1', '2025-05-21 09:00'),
+			('` + weekE + `', '2025-05-28', 'RESTAURANT_1', '2025-05-22 09:00'),
+			('` + weekC + `', '2025-06-02', 'RESTAURANT_1', '2025-05-29 09:00'),
+			('` + weekD + `', '2025-05-26', 'RESTAURANT_2', '2025-05-20 09:00')`,
+		`INSERT INTO meals (id, weeks_id, date, day_of_week, meal_type) VALUES
+			('10000000-0000-0000-0000-000000000001', '` + weekA + `', '2025-05-26', 'Mon', 'Lunch_2'),
+			('10000000-0000-0000-0000-000000000002', '` + weekA + `', '2025-05-27', 'Tue', 'Lunch_2'),
+			('10000000-0000-0000-0000-000000000003', '` + weekB + `', '2025-05-26', 'Mon', 'Lunch_2'),
+			('10000000-0000-0000-0000-000000000004', '` + weekE + `', '2025-05-28', 'Wed', 'Lunch_2'),
+			('10000000-0000-0000-0000-000000000005', '` + weekC + `', '2025-06-02', 'Mon', 'Lunch_2'),
+			('10000000-0000-0000-0000-000000000006', '` + weekD + `', '2025-05-26', 'Mon', 'Lunch')`,
+		`INSERT INTO menu_items (meals_id, category, name) VALUES
+			('10000000-0000-0000-0000-000000000001', '메인메뉴', '돈까스'),
+			('10000000-0000-0000-0000-000000000002', '메인메뉴', '제육볶음'),
+			('10000000-0000-0000-0000-000000000003', '메인메뉴', '카레'),
+			('10000000-0000-0000-0000-000000000004', '메인메뉴', '짜장면')`,
+		`INSERT INTO images (restaurant, week_id, image_name, valid_from) VALUES ('RESTAURANT_1', '` + weekA + `', 'a.jpg', '2025-05-26')`,
+		`INSERT INTO uploads (kind, restaurant, week_id, uploaded_by) VALUES ('excel', 'RESTAURANT_1', '` + weekB + `', 'admin')`,
+		`INSERT INTO images_unassigned (id, restaurant, week_id, image_name, valid_from) VALUES
+			(gen_random_uuid(), 'RESTAURANT_3', '` + weekA + `', 'c.jpg', '2025-05-26')`,
+	}
+	for _, statement := range statements {
+		if _, err := db.Exec(statement); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	testdb.Migrate(t, db, 12, 13)
+
+	rows, err := db.Query(`SELECT id, restaurant, start_date::text, end_date::text FROM weeks ORDER BY restaurant, start_date`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var weeks []string
+	for rows.Next() {
+		var id, restaurant, start, end string
+		if err := rows.Scan(&id, &restaurant, &start, &end); err != nil {
+			t.Fatal(err)
+		}
+		weeks = append(weeks, id+" "+restaurant+" "+start+" "+end)
+	}
+	rows.Close()
+	// 겹치는 주차는 시작일이 가장 늦은 주차로 합치고, 제1학생식당은 금요일, 제2학생식당은 일요일까지입니다
+	want := []string{
+		weekE + " RESTAURANT_1 2025-05-26 2025-05-30",
+		weekC + " RESTAURANT_1 2025-06-02 2025-06-06",
+		weekD + " RESTAURANT_2 2025-05-26 2025-06-01",
+	}
+	if !slices.Equal(weeks, want) {
+		t.Errorf("weeks = %v, want %v", weeks, want)
+	}
+
+	rows, err = db.Query(`
+		SELECT m.date::text || ' ' || mi.name FROM meals m JOIN menu_items mi ON mi.meals_id = m.id
+		WHERE m.weeks_id = $1 ORDER BY m.date`, weekE)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var menus []string
+	for rows.Next() {
+		var menu string
+		if err := rows.Scan(&menu); err != nil {
+			t.Fatal(err)
+		}
+		menus = append(menus, menu)
+	}
+	rows.Close()
+	// 같은 날짜, 같은 식사는 나중에 만들어진 주차의 식사를 남깁니다
+	if want := []string{"2025-05-26 카레", "2025-05-27 제육볶음", "2025-05-28 짜장면"}; !slices.Equal(menus, want) {
+		t.Errorf("menus = %v, want %v", menus, want)
+	}
+
+	for _, table := range []string{"images", "uploads", "images_unassigned"} {
+		var weekID string
+		if err := db.QueryRow(`SELECT week_id FROM ` + table).Scan(&weekID); err != nil {
+			t.Fatal(err)
+		}
+		if weekID != weekE {
+			t.Errorf("%s.week_id = %s, want %s", table, weekID, weekE)
+		}
+	}
+	var orphans int
+	if err := db.QueryRow(`SELECT COUNT(*) FROM meals WHERE weeks_id NOT IN (SELECT id FROM weeks)`).Scan(&orphans); err != nil || orphans != 0 {
+		t.Errorf("%d meals of deleted weeks, %v", orphans, err)
+	}
+}
@@ -0,0 +1,143 @@
+package repository
+
+import (
+	"database/sql"
+	"fmt"
+	"log"
+
+	"github.com/School-meal-lover/backend/internal/models"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+type NotificationRepository struct {
+	db *sql.DB
+}
+
+func NewNotificationRepository(db *sql.DB) *NotificationRepository {
+	return &NotificationRepository{db: db}
+}
+
+// 구독자 등록 (같은 디바이스 토큰이면 정보와 즐겨찾기 메뉴를 갱신)
+func (r *NotificationRepository) UpsertSubscriber(subscriber *models.NotificationSubscriber) (*models.NotificationSubscriber, error) {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() {
+		if err := tx.Rollback(); err != nil && err != sql.ErrTxDone {
+			log.Printf("failed to rollback transaction: %v", err)
+		}
+	}()
+
+	query := `
+		INSERT INTO notification_subscribers (id, device_token, platform, restaurant, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, now(), now())
+		ON CONFLICT (device_token) DO UPDATE SET
+			platform = EXCLUDED.platform,
+			restaurant = EXCLUDED.restaurant,
+			updated_at = now()
+		RETURNING id, created_at, updated_at`
+
+	err = tx.QueryRow(query, uuid.New().String(), subscriber.DeviceToken, subscriber.Platform, subscriber.Restaurant).
+		Scan(&subscriber.ID, &subscriber.CreatedAt, &subscriber.UpdatedAt)
+	if err != nil {
+		return nil, fmt.Errorf("failed to upsert subscriber: %w", err)
+	}
+
+	if _, err := tx.Exec(`DELETE FROM favorite_dishes WHERE subscriber_id = $1`, subscriber.ID); err != nil {
+		return nil, fmt.Errorf("failed to clear favorite dishes: %w", err)
+	}
+	for _, dish := range subscriber.FavoriteDishes {
+		_, err := tx.Exec(`
+			INSERT INTO favorite_dishes (subscriber_id, dish_name) VALUES ($1, $2)
+			ON CONFLICT DO NOTHING`, subscriber.ID, dish)
+		if err != nil {
+			return nil, fmt.Errorf("failed to insert favorite dish %s: %w", dish, err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit subscriber: %w", err)
+	}
+	return subscriber, nil
+}
+
+// deviceToken이 비어 있지 않으면 그 디바이스의 구독일 때만 삭제합니다
+func (r *NotificationRepository) DeleteSubscriber(id, deviceToken string) (bool, error) {
+	result, err := r.db.Exec(`DELETE FROM notification_subscribers WHERE id = $1 AND ($2 = '' OR device_token = $2)`, id, deviceToken)
+	if err != nil {
+		return false, fmt.Errorf("failed to delete subscriber: %w", err)
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return affected > 0, nil
+}
+
+// 해당 식당(또는 식당을 지정하지 않은) 구독자와 즐겨찾기 메뉴 조회
+func (r *NotificationRepository) GetSubscribersByRestaurant(restaurant models.RestaurantType) ([]*models.NotificationSubscriber, error) {
+	query := `
+		SELECT s.id, s.device_token, s.platform, s.restaurant,
+			COALESCE(array_agg(f.dish_name) FILTER (WHERE f.dish_name IS NOT NULL), '{}') AS dishes
+		FROM notification_subscribers s
+		LEFT JOIN favorite_dishes f ON f.subscriber_id = s.id
+		WHERE s.restaurant IS NULL OR s.restaurant = $1
+		GROUP BY s.id`
+
+	rows, err := r.db.Query(query, restaurant)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get subscribers: %w", err)
+	}
+	defer rows.Close()
+
+	var subscribers []*models.NotificationSubscriber
+	for rows.Next() {
+		subscriber := &models.NotificationSubscriber{}
+		var subscribedRestaurant sql.NullString
+		var dishes []string
+		if err := rows.Scan(&subscriber.ID, &subscriber.DeviceToken, &subscriber.Platform, &subscribedRestaurant, pq.Array(&dishes)); err != nil {
+			return nil, err
+		}
+		if subscribedRestaurant.Valid {
+			restaurantType := models.RestaurantType(subscribedRestaurant.String)
+			subscriber.Restaurant = &restaurantType
+		}
+		subscriber.FavoriteDishes = dishes
+		subscribers = append(subscribers, subscriber)
+	}
+	return subscribers, rows.Err()
+}
+
+// 전송 대기 알림 기록
+func (r *NotificationRepository) InsertDelivery(delivery *models.NotificationDelivery) error {
+	if delivery.ID == "" {
+		delivery.ID = uuid.New().String()
+	}
+	query := `
+		INSERT INTO notification_deliveries (id, subscriber_id, kind, title, body, status, attempts, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, 0, now(), now())
+		RETURNING created_at`
+
+	err := r.db.QueryRow(query, delivery.ID, delivery.SubscriberID, delivery.Kind,
+		delivery.Title, delivery.Body, models.DeliveryPending).Scan(&delivery.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to insert notification delivery: %w", err)
+	}
+	delivery.Status = models.DeliveryPending
+	return nil
+}
+
+// 전송 시도 결과 기록
+func (r *NotificationRepository) UpdateDeliveryAttempt(delivery *models.NotificationDelivery) error {
+	_, err := r.db.Exec(`
+		UPDATE notification_deliveries
+		SET status = $1, attempts = $2, last_error = NULLIF($3, ''), delivered_at = $4, updated_at = now()
+		WHERE id = $5`,
+		delivery.Status, delivery.Attempts, delivery.LastError, delivery.DeliveredAt, delivery.ID)
+	if err != nil {
+		return fmt.Errorf("failed to update notification delivery: %w", err)
+	}
+	return nil
+}
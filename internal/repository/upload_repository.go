@@ -0,0 +1,139 @@
+package repository
+
+import (
+	"database/sql"
+	"fmt"
+	"log"
+
+	"github.com/School-meal-lover/backend/internal/models"
+	"github.com/google/uuid"
+)
+
+type UploadRepository struct {
+	db *sql.DB
+}
+
+func NewUploadRepository(db *sql.DB) *UploadRepository {
+	return &UploadRepository{db: db}
+}
+
+// 업로드와 파일 목록을 한 트랜잭션으로 저장
+func (r *UploadRepository) InsertUpload(upload *models.Upload) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() {
+		if err := tx.Rollback(); err != nil && err != sql.ErrTxDone {
+			log.Printf("failed to rollback transaction: %v", err)
+		}
+	}()
+
+	if upload.ID == "" {
+		upload.ID = uuid.New().String()
+	}
+	err = tx.QueryRow(`
+		INSERT INTO uploads (id, kind, restaurant, week_id, uploaded_by, created_at)
+		VALUES ($1, $2, $3, $4, $5, now())
+		RETURNING created_at`,
+		upload.ID, upload.Kind, upload.Restaurant, upload.WeekID, upload.UploadedBy).Scan(&upload.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to insert upload: %w", err)
+	}
+
+	for _, file := range upload.Files {
+		if file.ID == "" {
+			file.ID = uuid.New().String()
+		}
+		file.UploadID = upload.ID
+		_, err := tx.Exec(`
+			INSERT INTO upload_files (id, upload_id, role, file_name, sha256, size_bytes, blob_key)
+			VALUES ($1, $2, $3, $4, $5, $6, $7)`,
+			file.ID, file.UploadID, file.Role, file.FileName, file.SHA256, file.SizeBytes, file.BlobKey)
+		if err != nil {
+			return fmt.Errorf("failed to insert upload file: %w", err)
+		}
+	}
+	return tx.Commit()
+}
+
+// 주차별 업로드 목록 (최신순)
+func (r *UploadRepository) GetUploadsByWeek(weekID string) ([]*models.Upload, error) {
+	rows, err := r.db.Query(`
+		SELECT id, kind, restaurant, week_id, uploaded_by, created_at
+		FROM uploads
+		WHERE week_id = $1
+		ORDER BY created_at DESC`, weekID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get uploads: %w", err)
+	}
+	defer rows.Close()
+
+	uploads := []*models.Upload{}
+	byID := make(map[string]*models.Upload)
+	var ids []string
+	for rows.Next() {
+		upload := &models.Upload{Files: []*models.UploadFile{}}
+		if err := rows.Scan(&upload.ID, &upload.Kind, &upload.Restaurant, &upload.WeekID, &upload.UploadedBy, &upload.CreatedAt); err != nil {
+			return nil, err
+		}
+		uploads = append(uploads, upload)
+		byID[upload.ID] = upload
+		ids = append(ids, upload.ID)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	for _, id := range ids {
+		files, err := r.getUploadFiles(id)
+		if err != nil {
+			return nil, err
+		}
+		byID[id].Files = files
+	}
+	return uploads, nil
+}
+
+// 업로드 조회 (없으면 nil)
+func (r *UploadRepository) GetUploadByID(id string) (*models.Upload, error) {
+	upload := &models.Upload{}
+	err := r.db.QueryRow(`
+		SELECT id, kind, restaurant, week_id, uploaded_by, created_at
+		FROM uploads
+		WHERE id = $1`, id).Scan(&upload.ID, &upload.Kind, &upload.Restaurant, &upload.WeekID, &upload.UploadedBy, &upload.CreatedAt)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get upload: %w", err)
+	}
+
+	upload.Files, err = r.getUploadFiles(id)
+	if err != nil {
+		return nil, err
+	}
+	return upload, nil
+}
+
+func (r *UploadRepository) getUploadFiles(uploadID string) ([]*models.UploadFile, error) {
+	rows, err := r.db.Query(`
+		SELECT id, upload_id, role, file_name, sha256, size_bytes, blob_key
+		FROM upload_files
+		WHERE upload_id = $1
+		ORDER BY role DESC`, uploadID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get upload files: %w", err)
+	}
+	defer rows.Close()
+
+	files := []*models.UploadFile{}
+	for rows.Next() {
+		file := &models.UploadFile{}
+		if err := rows.Scan(&file.ID, &file.UploadID, &file.Role, &file.FileName, &file.SHA256, &file.SizeBytes, &file.BlobKey); err != nil {
+			return nil, err
+		}
+		files = append(files, file)
+	}
+	return files, rows.Err()
+}
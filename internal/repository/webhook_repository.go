@@ -0,0 +1,151 @@
+package repository
+
+import (
+	"database/sql"
+	"fmt"
+
+	"github.com/School-meal-lover/backend/internal/models"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+type WebhookRepository struct {
+	db *sql.DB
+}
+
+func NewWebhookRepository(db *sql.DB) *WebhookRepository {
+	return &WebhookRepository{db: db}
+}
+
+func (r *WebhookRepository) InsertSubscription(subscription *models.WebhookSubscription) error {
+	if subscription.ID == "" {
+		subscription.ID = uuid.New().String()
+	}
+	query := `
+		INSERT INTO webhook_subscriptions (id, url, secret, event_types, active, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, true, now(), now())
+		RETURNING active, created_at, updated_at`
+
+	err := r.db.QueryRow(query, subscription.ID, subscription.URL, subscription.Secret, pq.Array(subscription.EventTypes)).
+		Scan(&subscription.Active, &subscription.CreatedAt, &subscription.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to insert webhook subscription: %w", err)
+	}
+	return nil
+}
+
+func (r *WebhookRepository) ListSubscriptions() ([]*models.WebhookSubscription, error) {
+	return r.querySubscriptions(`
+		SELECT id, url, secret, event_types, active, created_at, updated_at
+		FROM webhook_subscriptions
+		ORDER BY created_at`)
+}
+
+// 특정 이벤트를 구독 중인 활성 웹훅 조회
+func (r *WebhookRepository) GetActiveSubscriptionsByEvent(eventType string) ([]*models.WebhookSubscription, error) {
+	return r.querySubscriptions(`
+		SELECT id, url, secret, event_types, active, created_at, updated_at
+		FROM webhook_subscriptions
+		WHERE active AND $1 = ANY(event_types)`, eventType)
+}
+
+func (r *WebhookRepository) querySubscriptions(query string, args ...interface{}) ([]*models.WebhookSubscription, error) {
+	rows, err := r.db.Query(query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get webhook subscriptions: %w", err)
+	}
+	defer rows.Close()
+
+	var subscriptions []*models.WebhookSubscription
+	for rows.Next() {
+		subscription := &models.WebhookSubscription{}
+		err := rows.Scan(&subscription.ID, &subscription.URL, &subscription.Secret, pq.Array(&subscription.EventTypes),
+			&subscription.Active, &subscription.CreatedAt, &subscription.UpdatedAt)
+		if err != nil {
+			return nil, err
+		}
+		subscriptions = append(subscriptions, subscription)
+	}
+	return subscriptions, rows.Err()
+}
+
+func (r *WebhookRepository) DeleteSubscription(id string) (bool, error) {
+	result, err := r.db.Exec(`DELETE FROM webhook_subscriptions WHERE id = $1`, id)
+	if err != nil {
+		return false, fmt.Errorf("failed to delete webhook subscription: %w", err)
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return affected > 0, nil
+}
+
+func (r *WebhookRepository) InsertDelivery(delivery *models.WebhookDelivery) error {
+	if delivery.ID == "" {
+		delivery.ID = uuid.New().String()
+	}
+	query := `
+		INSERT INTO webhook_deliveries (id, subscription_id, event_type, payload, status, attempts, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, 0, now(), now())
+		RETURNING created_at`
+
+	err := r.db.QueryRow(query, delivery.ID, delivery.SubscriptionID, delivery.EventType,
+		delivery.Payload, models.DeliveryPending).Scan(&delivery.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to insert webhook delivery: %w", err)
+	}
+	delivery.Status = models.DeliveryPending
+	return nil
+}
+
+func (r *WebhookRepository) UpdateDeliveryAttempt(delivery *models.WebhookDelivery) error {
+	_, err := r.db.Exec(`
+		UPDATE webhook_deliveries
+		SET status = $1, attempts = $2, response_status = NULLIF($3, 0), last_error = NULLIF($4, ''),
+			delivered_at = $5, updated_at = now()
+		WHERE id = $6`,
+		delivery.Status, delivery.Attempts, delivery.ResponseStatus, delivery.LastError, delivery.DeliveredAt, delivery.ID)
+	if err != nil {
+		return fmt.Errorf("failed to update webhook delivery: %w", err)
+	}
+	return nil
+}
+
+// 구독별 최근 전송 기록 조회
+func (r *WebhookRepository) GetDeliveriesBySubscription(subscriptionID string, limit int) ([]*models.WebhookDelivery, error) {
+	query := `
+		SELECT id, subscription_id, event_type, payload::text, status, attempts,
+			COALESCE(response_status, 0), COALESCE(last_error, ''), created_at, delivered_at
+		FROM webhook_deliveries
+		WHERE subscription_id = $1
+		ORDER BY created_at DESC
+		LIMIT $2`
+
+	rows, err := r.db.Query(query, subscriptionID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get webhook deliveries: %w", err)
+	}
+	defer rows.Close()
+
+	deliveries := []*models.WebhookDelivery{}
+	for rows.Next() {
+		delivery := &models.WebhookDelivery{}
+		err := rows.Scan(&delivery.ID, &delivery.SubscriptionID, &delivery.EventType, &delivery.Payload, &delivery.Status,
+			&delivery.Attempts, &delivery.ResponseStatus, &delivery.LastError, &delivery.CreatedAt, &delivery.DeliveredAt)
+		if err != nil {
+			return nil, err
+		}
+		deliveries = append(deliveries, delivery)
+	}
+	return deliveries, rows.Err()
+}
+
+func (r *WebhookRepository) SubscriptionExists(id string) (bool, error) {
+	var exists bool
+	err := r.db.QueryRow(`SELECT EXISTS (SELECT 1 FROM webhook_subscriptions WHERE id = $1)`, id).Scan(&exists)
+	if err != nil {
+		return false, fmt.Errorf("failed to check webhook subscription: %w", err)
+	}
+	return exists, nil
+}
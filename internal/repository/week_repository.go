@@ -0,0 +1,173 @@
+package repository
+
+import (
+	"database/sql"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/School-meal-lover/backend/internal/models"
+	"github.com/lib/pq"
+)
+
+type WeekRepository struct {
+	db *sql.DB
+}
+
+func NewWeekRepository(db *sql.DB) *WeekRepository {
+	return &WeekRepository{db: db}
+}
+
+const weekColumns = `id, start_date, end_date, restaurant, status, published_at, COALESCE(status_changed_by, ''), status_changed_at, COALESCE(updated_at, created_at, now())`
+
+func scanWeek(row rowScanner) (*models.Week, error) {
+	week := &models.Week{}
+	var publishedAt, statusChangedAt sql.NullTime
+	err := row.Scan(&week.ID, &week.StartDate, &week.EndDate, &week.RestaurantType, &week.Status,
+		&publishedAt, &week.StatusChangedBy, &statusChangedAt, &week.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+	if publishedAt.Valid {
+		week.PublishedAt = &publishedAt.Time
+	}
+	if statusChangedAt.Valid {
+		week.StatusChangedAt = &statusChangedAt.Time
+	}
+	return week, nil
+}
+
+// 주차 조회 (없으면 nil)
+func (r *WeekRepository) GetWeek(id string) (*models.Week, error) {
+	week, err := scanWeek(r.db.QueryRow(`SELECT `+weekColumns+` FROM weeks WHERE id = $1`, id))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get week: %w", err)
+	}
+	return week, nil
+}
+
+// 식당과 상태로 주차 목록 조회 (빈 값이면 조건 없음, 시작일 최신순)
+func (r *WeekRepository) ListWeeks(restaurant models.RestaurantType, status string, limit int) ([]*models.Week, error) {
+	rows, err := r.db.Query(`
+		SELECT `+weekColumns+`
+		FROM weeks
+		WHERE ($1 = '' OR restaurant::text = $1) AND ($2 = '' OR status = $2)
+		ORDER BY start_date DESC, updated_at DESC
+		LIMIT $3`, string(restaurant), status, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list weeks: %w", err)
+	}
+	defer rows.Close()
+	return scanWeeks(rows)
+}
+
+// 기간(start~end)과 겹치는 게시된 주차 (시작일 순)
+func (r *WeekRepository) ListPublishedOverlapping(restaurant models.RestaurantType, start, end time.Time) ([]*models.Week, error) {
+	rows, err := r.db.Query(`
+		SELECT `+weekColumns+`
+		FROM weeks
+		WHERE restaurant = $1 AND status = $4 AND start_date <= $3 AND end_date >= $2
+		ORDER BY start_date`, restaurant, start, end, models.WeekStatusPublished)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list published weeks: %w", err)
+	}
+	defer rows.Close()
+	return scanWeeks(rows)
+}
+
+func scanWeeks(rows *sql.Rows) ([]*models.Week, error) {
+	var weeks []*models.Week
+	for rows.Next() {
+		week, err := scanWeek(rows)
+		if err != nil {
+			return nil, err
+		}
+		weeks = append(weeks, week)
+	}
+	return weeks, rows.Err()
+}
+
+// 상태가 from 중 하나일 때만 to로 바꿉니다 (바꾸지 못하면 nil)
+func (r *WeekRepository) UpdateWeekStatus(id string, from []string, to, actor string) (*models.Week, error) {
+	week, err := scanWeek(r.db.QueryRow(`
+		UPDATE weeks
+		SET status = $3, status_changed_by = NULLIF($4, ''), status_changed_at = now(), updated_at = now()
+		WHERE id = $1 AND status = ANY($2)
+		RETURNING `+weekColumns, id, pq.Array(from), to, actor))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to update week status: %w", err)
+	}
+	return week, nil
+}
+
+// 상태가 from 중 하나인 주차를 게시하고, 기간이 겹치는 이전 게시 주차를 retracted로 바꿉니다
+// 게시하지 못하면(상태가 다르면) nil, 내려간 이전 주차 ID 목록과 함께 반환합니다
+func (r *WeekRepository) PublishWeek(id string, from []string, actor string) (*models.Week, []string, error) {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() {
+		if err := tx.Rollback(); err != nil && err != sql.ErrTxDone {
+			log.Printf("failed to rollback transaction: %v", err)
+		}
+	}()
+
+	var restaurant models.RestaurantType
+	err = tx.QueryRow(`SELECT restaurant FROM weeks WHERE id = $1`, id).Scan(&restaurant)
+	if err == sql.ErrNoRows {
+		return nil, nil, nil
+	}
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get week: %w", err)
+	}
+	if err := lockRestaurantWeeks(tx, restaurant); err != nil {
+		return nil, nil, err
+	}
+
+	week, err := scanWeek(tx.QueryRow(`
+		UPDATE weeks
+		SET status = $3, published_at = now(), status_changed_by = NULLIF($4, ''), status_changed_at = now(), updated_at = now()
+		WHERE id = $1 AND status = ANY($2)
+		RETURNING `+weekColumns, id, pq.Array(from), models.WeekStatusPublished, actor))
+	if err == sql.ErrNoRows {
+		return nil, nil, nil
+	}
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to publish week: %w", err)
+	}
+
+	rows, err := tx.Query(`
+		UPDATE weeks
+		SET status = $5, status_changed_by = NULLIF($6, ''), status_changed_at = now(), updated_at = now()
+		WHERE restaurant = $1 AND id <> $2 AND status = $7 AND start_date <= $4 AND end_date >= $3
+		RETURNING id`,
+		restaurant, id, week.StartDate, week.EndDate, models.WeekStatusRetracted, actor, models.WeekStatusPublished)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to retract previous weeks: %w", err)
+	}
+	var retracted []string
+	for rows.Next() {
+		var retractedID string
+		if err := rows.Scan(&retractedID); err != nil {
+			rows.Close()
+			return nil, nil, err
+		}
+		retracted = append(retracted, retractedID)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, nil, fmt.Errorf("failed to commit week publish: %w", err)
+	}
+	return week, retracted, nil
+}
@@ -0,0 +1,64 @@
+package services
+
+import (
+	"strings"
+	"time"
+
+	"github.com/School-meal-lover/backend/internal/auth"
+	"github.com/School-meal-lover/backend/internal/models"
+	"github.com/School-meal-lover/backend/internal/repository"
+	"github.com/google/uuid"
+)
+
+type APIKeyService struct {
+	apiKeyRepo *repository.APIKeyRepository
+}
+
+func NewAPIKeyService(apiKeyRepo *repository.APIKeyRepository) *APIKeyService {
+	return &APIKeyService{apiKeyRepo: apiKeyRepo}
+}
+
+// API 키 발급: 원본 키는 이 응답에서만 확인할 수 있습니다
+func (s *APIKeyService) IssueKey(req *models.APIKeyCreateRequest) (*models.APIKey, string, error) {
+	name := strings.TrimSpace(req.Name)
+	if name == "" {
+		return nil, "", newValidationError("name is required")
+	}
+	if req.ExpiresInDays < 0 {
+		return nil, "", newValidationError("expires_in_days must not be negative")
+	}
+	if _, err := auth.PrincipalFromScopes(name, req.Scopes); err != nil {
+		return nil, "", newValidationError("invalid scopes: %v", err)
+	}
+
+	plainKey, prefix, hash, err := auth.GenerateAPIKey()
+	if err != nil {
+		return nil, "", err
+	}
+	key := &models.APIKey{
+		Name:    name,
+		Prefix:  prefix,
+		KeyHash: hash,
+		Scopes:  req.Scopes,
+	}
+	if req.ExpiresInDays > 0 {
+		expiresAt := time.Now().AddDate(0, 0, req.ExpiresInDays)
+		key.ExpiresAt = &expiresAt
+	}
+	if err := s.apiKeyRepo.InsertAPIKey(key); err != nil {
+		return nil, "", err
+	}
+	return key, plainKey, nil
+}
+
+func (s *APIKeyService) ListKeys() ([]*models.APIKey, error) {
+	return s.apiKeyRepo.ListAPIKeys()
+}
+
+// 폐기한 키가 없으면 false (잘못된 ID 포함)
+func (s *APIKeyService) RevokeKey(id string) (bool, error) {
+	if _, err := uuid.Parse(id); err != nil {
+		return false, nil
+	}
+	return s.apiKeyRepo.RevokeAPIKey(id)
+}
@@ -0,0 +1,13 @@
+package services
+
+import "testing"
+
+func TestRevokeKeyInvalidID(t *testing.T) {
+	// 저장소를 조회하지 않고 없는 키로 처리합니다 (핸들러는 404)
+	service := &APIKeyService{}
+	for _, id := range []string{"", "abc", "1234", "00000000-0000-0000-0000-00000000000g"} {
+		if revoked, err := service.RevokeKey(id); revoked || err != nil {
+			t.Errorf("RevokeKey(%q) = %v, %v, want false, nil", id, revoked, err)
+		}
+	}
+}
@@ -0,0 +1,69 @@
+package services
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+
+	"github.com/School-meal-lover/backend/internal/models"
+	"github.com/School-meal-lover/backend/internal/repository"
+)
+
+const (
+	defaultAuditLimit = 100
+	maxAuditLimit     = 500
+)
+
+type AuditService struct {
+	auditRepo *repository.AuditRepository
+}
+
+func NewAuditService(auditRepo *repository.AuditRepository) *AuditService {
+	return &AuditService{auditRepo: auditRepo}
+}
+
+// 감사 로그 기록 (기록에 실패해도 이미 끝난 요청은 실패시키지 않습니다)
+func (s *AuditService) Record(entry *models.AuditLog) {
+	if err := s.auditRepo.InsertAuditLog(entry); err != nil {
+		log.Printf("Failed to record audit log for %s by %s: %v", entry.Action, entry.Actor, err)
+	}
+}
+
+func (s *AuditService) List(filter *models.AuditLogFilter) ([]*models.AuditLog, error) {
+	if filter.Limit == 0 {
+		filter.Limit = defaultAuditLimit
+	}
+	if filter.Limit < 0 || filter.Limit > maxAuditLimit {
+		return nil, newValidationError("limit must be between 1 and %d", maxAuditLimit)
+	}
+	if filter.Offset < 0 {
+		return nil, newValidationError("offset must not be negative")
+	}
+	return s.auditRepo.ListAuditLogs(filter)
+}
+
+// 메모리에 있는 업로드 내용의 체크섬
+func AuditFileFromBytes(name string, data []byte) models.AuditFile {
+	sum := sha256.Sum256(data)
+	return models.AuditFile{Name: name, SHA256: hex.EncodeToString(sum[:]), Size: int64(len(data))}
+}
+
+// 디스크에 저장된 업로드 파일의 체크섬
+func AuditFileFromPath(name, path string) (models.AuditFile, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return models.AuditFile{}, fmt.Errorf("failed to open %s: %w", filepath.Base(path), err)
+	}
+	defer f.Close()
+
+	hash := sha256.New()
+	size, err := io.Copy(hash, f)
+	if err != nil {
+		return models.AuditFile{}, fmt.Errorf("failed to read %s: %w", filepath.Base(path), err)
+	}
+	return models.AuditFile{Name: name, SHA256: hex.EncodeToString(hash.Sum(nil)), Size: size}, nil
+}
@@ -0,0 +1,148 @@
+package services
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/School-meal-lover/backend/internal/models"
+	"github.com/School-meal-lover/backend/internal/repository"
+)
+
+// 캘린더에 포함할 과거 기간
+const calendarLookbackDays = 28
+
+type CalendarService struct {
+	mealRepo *repository.MealRepository
+}
+
+func NewCalendarService(mealRepo *repository.MealRepository) *CalendarService {
+	return &CalendarService{mealRepo: mealRepo}
+}
+
+// 식당의 최근/예정 식단을 iCalendar(RFC 5545) 형식으로 생성합니다
+func (s *CalendarService) GetRestaurantCalendar(restaurant models.RestaurantType, lang string, now time.Time) (string, error) {
+	weeks, err := s.mealRepo.ListWeeksSince(restaurant, now.AddDate(0, 0, -calendarLookbackDays))
+	if err != nil {
+		return "", err
+	}
+
+	calendarName := restaurantLabel(restaurant, lang)
+	cal := &icsWriter{}
+	cal.line("BEGIN:VCALENDAR")
+	cal.line("VERSION:2.0")
+	cal.line("PRODID:-//Grrrrr//Meal Calendar//KO")
+	cal.line("CALSCALE:GREGORIAN")
+	cal.line("METHOD:PUBLISH")
+	cal.property("X-WR-CALNAME", calendarName)
+	cal.line("X-WR-TIMEZONE:Asia/Seoul")
+	cal.line("REFRESH-INTERVAL;VALUE=DURATION:PT6H")
+
+	stamp := now.UTC().Format("20060102T150405Z")
+	for _, week := range weeks {
+		days, _, err := s.mealRepo.GetMealsData(week.ID)
+		if err != nil {
+			return "", err
+		}
+		for _, day := range days {
+			for _, mealType := range orderedMealTypes(day.Meals) {
+				meal := day.Meals[mealType]
+				if len(meal.MenuItems) == 0 {
+					continue
+				}
+				start, end, err := mealServingTime(day.Date, meal)
+				if err != nil {
+					return "", err
+				}
+
+				var names []string
+				for _, item := range meal.MenuItems {
+					names = append(names, menuItemName(item, lang))
+				}
+
+				cal.line("BEGIN:VEVENT")
+				cal.property("UID", fmt.Sprintf("%s-%s@grrrr.me", meal.MealID, lang))
+				cal.line("DTSTAMP:" + stamp)
+				cal.line("DTSTART:" + start.UTC().Format("20060102T150405Z"))
+				cal.line("DTEND:" + end.UTC().Format("20060102T150405Z"))
+				cal.property("SUMMARY", fmt.Sprintf("%s %s", mealTypeLabel(meal, lang), calendarName))
+				cal.property("DESCRIPTION", strings.Join(names, "\n"))
+				cal.property("LOCATION", calendarName)
+				cal.line("TRANSP:TRANSPARENT")
+				cal.line("END:VEVENT")
+			}
+		}
+	}
+
+	cal.line("END:VCALENDAR")
+	return cal.String(), nil
+}
+
+// 식사 종류의 배식 시간을 해당 날짜 기준 시각으로 변환 (설정에 없는 종류는 12:00~13:00)
+func mealServingTime(date string, meal *models.MealInfo) (time.Time, time.Time, error) {
+	hours := [2]string{meal.ServingStart, meal.ServingEnd}
+	if hours[0] == "" || hours[1] == "" {
+		hours = [2]string{"12:00", "13:00"}
+	}
+	start, err := time.ParseInLocation("2006-01-02 15:04", date+" "+hours[0], kst)
+	if err != nil {
+		return time.Time{}, time.Time{}, fmt.Errorf("failed to parse serving time: %w", err)
+	}
+	end, err := time.ParseInLocation("2006-01-02 15:04", date+" "+hours[1], kst)
+	if err != nil {
+		return time.Time{}, time.Time{}, fmt.Errorf("failed to parse serving time: %w", err)
+	}
+	return start, end, nil
+}
+
+// 식사 종류를 설정의 표시 순서대로 정렬 (설정에 없는 종류는 뒤에 코드 순)
+func orderedMealTypes(meals map[string]*models.MealInfo) []string {
+	result := make([]string, 0, len(meals))
+	for mealType := range meals {
+		result = append(result, mealType)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		a, b := meals[result[i]], meals[result[j]]
+		if a.DisplayOrder != b.DisplayOrder {
+			return a.DisplayOrder < b.DisplayOrder
+		}
+		return a.MealType < b.MealType
+	})
+	return result
+}
+
+// CRLF 줄바꿈과 75 옥텟 줄 접기를 처리하는 iCalendar 작성기
+type icsWriter struct {
+	b strings.Builder
+}
+
+func (w *icsWriter) line(content string) {
+	limit := 75
+	for len(content) > limit {
+		cut := limit
+		// UTF-8 문자 중간에서 자르지 않도록 조정
+		for cut > 0 && content[cut]&0xC0 == 0x80 {
+			cut--
+		}
+		w.b.WriteString(content[:cut])
+		w.b.WriteString("\r\n ")
+		content = content[cut:]
+		limit = 74 // 이어지는 줄은 앞의 공백 한 칸 포함
+	}
+	w.b.WriteString(content)
+	w.b.WriteString("\r\n")
+}
+
+func (w *icsWriter) property(name, value string) {
+	w.line(name + ":" + escapeICSText(value))
+}
+
+func (w *icsWriter) String() string {
+	return w.b.String()
+}
+
+func escapeICSText(value string) string {
+	replacer := strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\r\n", `\n`, "\n", `\n`)
+	return replacer.Replace(value)
+}
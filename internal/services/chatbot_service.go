@@ -0,0 +1,179 @@
+package services
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/School-meal-lover/backend/internal/menuquery"
+	"github.com/School-meal-lover/backend/internal/models"
+)
+
+var koreanWeekdayNames = []string{"일", "월", "화", "수", "목", "금", "토"}
+
+type ChatbotService struct {
+	mealService *MealService
+	mealTypes   *MealTypeService
+}
+
+func NewChatbotService(mealService *MealService, mealTypes *MealTypeService) *ChatbotService {
+	return &ChatbotService{mealService: mealService, mealTypes: mealTypes}
+}
+
+// 사용자 문장을 해석해서 식단 카드 목록과 응답 언어를 돌려줍니다
+func (s *ChatbotService) Answer(utterance string, now time.Time) ([]*models.MenuCard, string) {
+	// 식사 종류를 불러오지 못하면 식사를 구분하지 않고 모든 식사를 보여줍니다
+	mealTypes, err := s.mealTypes.All()
+	if err != nil {
+		log.Printf("Failed to load meal types for chatbot: %v", err)
+	}
+	query := menuquery.Parse(utterance, now.In(kst), mealTypes)
+	date := query.Date.Format("2006-01-02")
+
+	restaurants := []models.RestaurantType{models.Restaurant1, models.Restaurant2}
+	if query.Restaurant != "" {
+		restaurants = []models.RestaurantType{query.Restaurant}
+	}
+
+	var cards []*models.MenuCard
+	for _, restaurant := range restaurants {
+		title := fmt.Sprintf("%s %s", chatbotDateLabel(query.Date, query.Lang), restaurantLabel(restaurant, query.Lang))
+
+		day, err := s.mealService.GetRestaurantDayMeals(restaurant, date)
+		if err != nil {
+			log.Printf("Failed to get meals for chatbot (%s %s): %v", restaurant, date, err)
+		}
+
+		mealCards := mealCards(title, day, query.MealTypes, query.Lang)
+		if len(mealCards) == 0 {
+			cards = append(cards, &models.MenuCard{Title: title, Description: noMenuMessage(query.Lang)})
+			continue
+		}
+		cards = append(cards, mealCards...)
+	}
+	return cards, query.Lang
+}
+
+func mealCards(title string, day *models.DayMeals, mealTypes []string, lang string) []*models.MenuCard {
+	if day == nil {
+		return nil
+	}
+	if day.Closed {
+		return []*models.MenuCard{{Title: title, Description: closureMessage(day.Closure, lang)}}
+	}
+	if day.LikelyClosed {
+		return []*models.MenuCard{{Title: title, Description: holidayMessage(day.Holiday, lang)}}
+	}
+	if len(mealTypes) == 0 {
+		mealTypes = orderedMealTypes(day.Meals)
+	}
+
+	var cards []*models.MenuCard
+	for _, mealType := range mealTypes {
+		meal, ok := day.Meals[mealType]
+		if ok && meal.Closed {
+			cards = append(cards, &models.MenuCard{
+				Title:       title + " " + mealTypeLabel(meal, lang),
+				Description: closureMessage(meal.Closure, lang),
+			})
+			continue
+		}
+		if !ok || len(meal.MenuItems) == 0 {
+			continue
+		}
+		var names []string
+		for _, item := range meal.MenuItems {
+			names = append(names, menuItemName(item, lang))
+		}
+		cards = append(cards, &models.MenuCard{
+			Title:       title + " " + mealTypeLabel(meal, lang),
+			Description: strings.Join(names, "\n"),
+		})
+	}
+	return cards
+}
+
+func chatbotDateLabel(date time.Time, lang string) string {
+	if lang == LangEnglish {
+		return date.Format("Mon 1/2")
+	}
+	return fmt.Sprintf("%d/%d(%s)", date.Month(), date.Day(), koreanWeekdayNames[date.Weekday()])
+}
+
+func noMenuMessage(lang string) string {
+	if lang == LangEnglish {
+		return "No menu has been posted."
+	}
+	return "등록된 식단이 없습니다."
+}
+
+func closureMessage(closure *models.ClosureInfo, lang string) string {
+	if lang == LangEnglish {
+		if closure != nil && closure.ReasonEn != "" {
+			return "Closed: " + closure.ReasonEn
+		}
+		return "Closed."
+	}
+	if closure != nil && closure.ReasonKo != "" {
+		return "운영하지 않습니다: " + closure.ReasonKo
+	}
+	return "운영하지 않습니다."
+}
+
+func holidayMessage(holiday *models.HolidayInfo, lang string) string {
+	if lang == LangEnglish {
+		return fmt.Sprintf("%s is a public holiday and no menu has been posted; the cafeteria may be closed.", holiday.NameEn)
+	}
+	return fmt.Sprintf("%s 공휴일이라 등록된 식단이 없습니다. 운영하지 않을 수 있습니다.", holiday.Name)
+}
+
+// 카카오 i 오픈빌더 스킬 응답 구성
+func BuildKakaoResponse(cards []*models.MenuCard, lang string) *models.KakaoSkillResponse {
+	var output models.KakaoOutput
+	if len(cards) == 1 {
+		output.TextCard = &models.KakaoTextCard{Title: cards[0].Title, Description: cards[0].Description}
+	} else {
+		carousel := &models.KakaoCarousel{Type: "textCard"}
+		for _, card := range cards {
+			carousel.Items = append(carousel.Items, models.KakaoTextCard{Title: card.Title, Description: card.Description})
+		}
+		output.Carousel = carousel
+	}
+
+	quickReplies := []string{"오늘 점심", "오늘 저녁", "내일 아침", "내일 점심"}
+	if lang == LangEnglish {
+		quickReplies = []string{"today lunch", "today dinner", "tomorrow breakfast", "tomorrow lunch"}
+	}
+	response := &models.KakaoSkillResponse{
+		Version:  "2.0",
+		Template: models.KakaoTemplate{Outputs: []models.KakaoOutput{output}},
+	}
+	for _, reply := range quickReplies {
+		response.Template.QuickReplies = append(response.Template.QuickReplies, models.KakaoQuickReply{
+			Label:       reply,
+			Action:      "message",
+			MessageText: reply,
+		})
+	}
+	return response
+}
+
+// 슬랙 슬래시 커맨드 응답 구성
+func BuildSlackResponse(cards []*models.MenuCard) *models.SlackCommandResponse {
+	response := &models.SlackCommandResponse{ResponseType: "in_channel"}
+
+	var fallback []string
+	for _, card := range cards {
+		fallback = append(fallback, card.Title)
+		response.Blocks = append(response.Blocks, models.SlackBlock{
+			Type: "section",
+			Text: &models.SlackText{
+				Type: "mrkdwn",
+				Text: fmt.Sprintf("*%s*\n%s", card.Title, card.Description),
+			},
+		})
+	}
+	response.Text = strings.Join(fallback, ", ")
+	return response
+}
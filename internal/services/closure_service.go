@@ -0,0 +1,266 @@
+package services
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/School-meal-lover/backend/internal/holiday"
+	"github.com/School-meal-lover/backend/internal/models"
+	"github.com/School-meal-lover/backend/internal/repository"
+	"github.com/google/uuid"
+)
+
+// 한 번에 등록할 수 있는 휴무 기간 (방학 한 학기 정도)
+const maxClosureDays = 190
+
+type ClosureService struct {
+	closureRepo *repository.ClosureRepository
+	mealTypes   *MealTypeService
+}
+
+func NewClosureService(closureRepo *repository.ClosureRepository, mealTypes *MealTypeService) *ClosureService {
+	return &ClosureService{closureRepo: closureRepo, mealTypes: mealTypes}
+}
+
+// 휴무를 등록합니다 (createdBy는 요청 주체)
+func (s *ClosureService) Create(req *models.ClosureRequest, createdBy string) (*models.Closure, error) {
+	restaurant, ok := models.ParseRestaurantType(req.Restaurant)
+	if !ok {
+		return nil, newValidationError("unknown restaurant: %q", req.Restaurant)
+	}
+	start, err := time.Parse("2006-01-02", req.StartDate)
+	if err != nil {
+		return nil, newValidationError("invalid start_date %q (expected YYYY-MM-DD)", req.StartDate)
+	}
+	end := start
+	if req.EndDate != "" {
+		end, err = time.Parse("2006-01-02", req.EndDate)
+		if err != nil {
+			return nil, newValidationError("invalid end_date %q (expected YYYY-MM-DD)", req.EndDate)
+		}
+	}
+	if end.Before(start) {
+		return nil, newValidationError("end_date must not be before start_date")
+	}
+	if end.Sub(start) >= maxClosureDays*24*time.Hour {
+		return nil, newValidationError("closure is longer than %d days", maxClosureDays)
+	}
+	if req.MealType != "" {
+		codes, err := s.mealTypes.Codes()
+		if err != nil {
+			return nil, fmt.Errorf("failed to load meal types: %w", err)
+		}
+		if !slices.Contains(codes[restaurant], req.MealType) {
+			return nil, newValidationError("unknown meal type %q for %s", req.MealType, restaurant)
+		}
+	}
+
+	closure := &models.Closure{
+		Restaurant: restaurant,
+		StartDate:  start.Format("2006-01-02"),
+		EndDate:    end.Format("2006-01-02"),
+		MealType:   req.MealType,
+		ReasonKo:   req.ReasonKo,
+		ReasonEn:   req.ReasonEn,
+		CreatedBy:  createdBy,
+	}
+	if err := s.closureRepo.InsertClosure(closure); err != nil {
+		return nil, err
+	}
+	return closure, nil
+}
+
+// 기간(from~to)과 겹치는 식당 휴무
+func (s *ClosureService) List(restaurant models.RestaurantType, from, to time.Time) ([]*models.Closure, error) {
+	closures, err := s.closureRepo.ListClosures(restaurant, from, to)
+	if err != nil {
+		return nil, err
+	}
+	if closures == nil {
+		closures = []*models.Closure{}
+	}
+	return closures, nil
+}
+
+// 삭제한 휴무를 반환합니다 (없으면 nil)
+func (s *ClosureService) Delete(id string) (*models.Closure, error) {
+	if _, err := uuid.Parse(id); err != nil {
+		return nil, nil
+	}
+	return s.closureRepo.DeleteClosure(id)
+}
+
+// 기간(from~to)의 휴무와 공휴일을 식단에 표시합니다
+// 식단이 없는 휴무일, 휴무 식사, 공휴일도 빈 항목으로 추가해서, 응답에서 빠진 날과 쉬는 날을 구분할 수 있게 합니다
+func (s *ClosureService) Apply(restaurant models.RestaurantType, days []*models.DayMeals, from, to time.Time) ([]*models.DayMeals, error) {
+	closures, err := s.List(restaurant, from, to)
+	if err != nil {
+		return nil, err
+	}
+	holidays := holiday.Between(from, to)
+	if len(closures) == 0 && len(holidays) == 0 {
+		return days, nil
+	}
+	mealTypes, err := s.mealTypes.List(restaurant)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load meal types: %w", err)
+	}
+
+	byDate := map[string]*models.DayMeals{}
+	for _, day := range days {
+		byDate[day.Date] = day
+	}
+	dayFor := func(date time.Time) *models.DayMeals {
+		dateStr := date.Format("2006-01-02")
+		day := byDate[dateStr]
+		if day == nil {
+			day = &models.DayMeals{Date: dateStr, DayOfWeek: date.Weekday().String(), Meals: map[string]*models.MealInfo{}}
+			byDate[dateStr] = day
+			days = append(days, day)
+		}
+		return day
+	}
+
+	for date := from; !date.After(to); date = date.AddDate(0, 0, 1) {
+		dateStr := date.Format("2006-01-02")
+		for _, closure := range closures {
+			if dateStr < closure.StartDate || dateStr > closure.EndDate {
+				continue
+			}
+			day := dayFor(date)
+			info := &models.ClosureInfo{ID: closure.ID, ReasonKo: closure.ReasonKo, ReasonEn: closure.ReasonEn}
+			if closure.MealType == "" {
+				if !day.Closed {
+					day.Closed = true
+					day.Closure = info
+				}
+				continue
+			}
+			meal := day.Meals[closure.MealType]
+			if meal == nil {
+				meal = closedMealInfo(closure.MealType, mealTypes)
+				day.Meals[closure.MealType] = meal
+			}
+			if !meal.Closed {
+				meal.Closed = true
+				meal.Closure = info
+			}
+		}
+
+		if dayHolidays := holiday.On(date); len(dayHolidays) > 0 {
+			day := dayFor(date)
+			day.Holiday = holidayInfo(dayHolidays)
+			day.LikelyClosed = !day.Closed && !hasMenu(day)
+		}
+	}
+	slices.SortFunc(days, func(a, b *models.DayMeals) int { return strings.Compare(a.Date, b.Date) })
+	return days, nil
+}
+
+// 같은 날 겹친 공휴일은 이름을 이어 붙입니다
+func holidayInfo(holidays []holiday.Holiday) *models.HolidayInfo {
+	info := &models.HolidayInfo{}
+	var names, namesEn []string
+	for _, h := range holidays {
+		names = append(names, h.Name)
+		namesEn = append(namesEn, h.NameEn)
+		info.Substitute = info.Substitute || h.Substitute
+	}
+	info.Name = strings.Join(names, ", ")
+	info.NameEn = strings.Join(namesEn, ", ")
+	return info
+}
+
+// 운영하는 식사에 메뉴가 하나라도 있는지
+func hasMenu(day *models.DayMeals) bool {
+	for _, meal := range day.Meals {
+		if !meal.Closed && len(meal.MenuItems) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// 등록된 휴무가 있는 날인지 (하루 전체 또는 식사 하나라도)
+func hasClosure(day *models.DayMeals) bool {
+	if day.Closed {
+		return true
+	}
+	for _, meal := range day.Meals {
+		if meal.Closed {
+			return true
+		}
+	}
+	return false
+}
+
+// 식단이 올라오지 않은 휴무 식사 항목
+func closedMealInfo(code string, mealTypes []*models.MealType) *models.MealInfo {
+	meal := &models.MealInfo{MealType: code, DisplayOrder: len(mealTypes), MenuItems: []*models.MenuItemResponse{}}
+	for _, mealType := range mealTypes {
+		if mealType.Code == code {
+			meal.Label = mealType.LabelKo
+			meal.LabelEn = mealType.LabelEn
+			meal.ServingStart = mealType.ServingStart
+			meal.ServingEnd = mealType.ServingEnd
+			meal.DisplayOrder = mealType.DisplayOrder
+		}
+	}
+	return meal
+}
+
+// 업로드한 식단 중 휴무로 등록된 날이나 식사, 또는 공휴일에 들어간 메뉴를 경고합니다
+func (s *ClosureService) uploadWarnings(menu *models.WeekMenu) ([]models.ParseDiagnostic, error) {
+	closures, err := s.List(menu.Restaurant, menu.WeekStart, menu.WeekEnd)
+	if err != nil {
+		return nil, err
+	}
+
+	var warnings []models.ParseDiagnostic
+	warn := func(format string, args ...interface{}) {
+		warnings = append(warnings, models.ParseDiagnostic{Severity: models.DiagnosticWarning, Message: fmt.Sprintf(format, args...)})
+	}
+	if !holiday.Covers(menu.WeekStart.Year()) {
+		warn("public holiday calendar does not cover %d; holidays were not checked", menu.WeekStart.Year())
+	}
+
+	for _, day := range menu.Days {
+		date := day.Date.Format("2006-01-02")
+		declared := false
+		hasItems := false
+		for _, meal := range day.Meals {
+			if len(meal.Items) == 0 {
+				continue
+			}
+			hasItems = true
+			for _, closure := range closures {
+				if date < closure.StartDate || date > closure.EndDate || (closure.MealType != "" && closure.MealType != meal.MealType) {
+					continue
+				}
+				declared = true
+				if reason := closureReason(closure); reason != "" {
+					warn("%s %s: menu uploaded for a declared closure (%s)", date, meal.MealType, reason)
+				} else {
+					warn("%s %s: menu uploaded for a declared closure", date, meal.MealType)
+				}
+				break
+			}
+		}
+		// 휴무를 등록하지 않은 공휴일은 날짜를 잘못 적었을 수도 있어서 하루에 한 번 경고합니다
+		if hasItems && !declared {
+			if dayHolidays := holiday.On(day.Date); len(dayHolidays) > 0 {
+				warn("%s: menu uploaded for a public holiday (%s)", date, holidayInfo(dayHolidays).Name)
+			}
+		}
+	}
+	return warnings, nil
+}
+
+func closureReason(closure *models.Closure) string {
+	if closure.ReasonKo != "" {
+		return closure.ReasonKo
+	}
+	return closure.ReasonEn
+}
@@ -0,0 +1,77 @@
+package services
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/School-meal-lover/backend/internal/models"
+	"github.com/School-meal-lover/backend/internal/weekdoc"
+)
+
+// JSON/YAML 주간 식단 문서 업로드
+type DocumentService struct {
+	importer       *MenuImporter
+	mealTypes      *MealTypeService
+	maxUploadBytes int64
+}
+
+func NewDocumentService(importer *MenuImporter, mealTypes *MealTypeService) *DocumentService {
+	return &DocumentService{
+		importer:       importer,
+		mealTypes:      mealTypes,
+		maxUploadBytes: maxBytesFromEnv("UPLOAD_MAX_BYTES", defaultUploadMaxBytes),
+	}
+}
+
+// 업로드 요청 본문 최대 크기 (바이트)
+func (s *DocumentService) MaxUploadBytes() int64 {
+	return s.maxUploadBytes
+}
+
+// Content-Type 또는 파일 확장자로 문서 인코딩을 정합니다 (알 수 없으면 내용으로 추정)
+func DocumentFormat(contentType, fileName string, data []byte) weekdoc.Format {
+	contentType = strings.ToLower(contentType)
+	fileName = strings.ToLower(fileName)
+	switch {
+	case strings.Contains(contentType, "yaml"), strings.HasSuffix(fileName, ".yaml"), strings.HasSuffix(fileName, ".yml"):
+		return weekdoc.FormatYAML
+	case strings.Contains(contentType, "json"), strings.HasSuffix(fileName, ".json"):
+		return weekdoc.FormatJSON
+	}
+	if trimmed := strings.TrimSpace(string(data)); strings.HasPrefix(trimmed, "{") {
+		return weekdoc.FormatJSON
+	}
+	return weekdoc.FormatYAML
+}
+
+// 문서를 스키마로 검사합니다 (오류가 있으면 *textformat.Error)
+func (s *DocumentService) ParseDocument(data []byte, format weekdoc.Format) (*models.WeekMenu, []models.ParseDiagnostic, error) {
+	mealTypes, err := s.mealTypes.Codes()
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to load meal types: %w", err)
+	}
+	return weekdoc.Parse(data, format, mealTypes)
+}
+
+// 현재 식사 종류 설정을 반영한 문서 스키마
+func (s *DocumentService) Schema() ([]byte, error) {
+	mealTypes, err := s.mealTypes.Codes()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load meal types: %w", err)
+	}
+	return weekdoc.Schema(mealTypes.All())
+}
+
+// 검사를 마친 문서를 저장합니다
+func (s *DocumentService) ImportDocument(menu *models.WeekMenu, warnings []models.ParseDiagnostic) (*models.ExcelProcessResult, error) {
+	return s.importer.Import(menu, warnings, "Week document processed successfully")
+}
+
+// 검사와 저장을 한 번에 합니다 (보관된 원본 재처리용)
+func (s *DocumentService) ProcessDocument(data []byte, format weekdoc.Format) (*models.ExcelProcessResult, error) {
+	menu, warnings, err := s.ParseDocument(data, format)
+	if err != nil {
+		return nil, err
+	}
+	return s.ImportDocument(menu, warnings)
+}
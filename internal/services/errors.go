@@ -0,0 +1,42 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+)
+
+// 요청 값이 잘못된 경우의 오류 (핸들러에서 400으로 응답)
+type ValidationError struct {
+	Message string
+}
+
+func (e *ValidationError) Error() string {
+	return e.Message
+}
+
+func newValidationError(format string, args ...interface{}) error {
+	return &ValidationError{Message: fmt.Sprintf(format, args...)}
+}
+
+func IsValidationError(err error) bool {
+	var validationErr *ValidationError
+	return errors.As(err, &validationErr)
+}
+
+// 현재 상태에서 할 수 없는 요청 (핸들러에서 409로 응답)
+type ConflictError struct {
+	Message string
+}
+
+func (e *ConflictError) Error() string {
+	return e.Message
+}
+
+func newConflictError(format string, args ...interface{}) error {
+	return &ConflictError{Message: fmt.Sprintf(format, args...)}
+}
+
+func IsConflictError(err error) bool {
+	var conflictErr *ConflictError
+	return errors.As(err, &conflictErr)
+}
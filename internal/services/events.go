@@ -0,0 +1,42 @@
+package services
+
+import (
+	"log"
+
+	"github.com/School-meal-lover/backend/internal/models"
+)
+
+// 식단이 게시된 뒤 후속 처리를 하는 서비스 (알림, 웹훅 등)
+type WeekUploadListener interface {
+	HandleWeekUploaded(event *models.WeekUploadEvent)
+}
+
+// 게시 직후 주차 데이터를 다시 읽어 등록된 리스너에 전달합니다
+func notifyWeekUploaded(mealRepo weekMealStore, listeners []WeekUploadListener, weekID string, restaurant models.RestaurantType, before []*models.DayMeals, changes *models.MenuChanges) {
+	if len(listeners) == 0 {
+		return
+	}
+	week, err := mealRepo.GetWeekByID(weekID)
+	if err != nil {
+		log.Printf("Failed to load week for upload event: %v", err)
+		return
+	}
+	after, summary, err := mealRepo.GetMealsData(weekID)
+	if err != nil {
+		log.Printf("Failed to load meals for upload event: %v", err)
+		return
+	}
+
+	event := &models.WeekUploadEvent{
+		WeekID:     weekID,
+		Restaurant: restaurant,
+		Week:       week,
+		Summary:    summary,
+		Before:     before,
+		After:      after,
+		Changes:    changes,
+	}
+	for _, listener := range listeners {
+		listener.HandleWeekUploaded(event)
+	}
+}
@@ -3,270 +3,257 @@ package services
 import (
 	"fmt"
 	"log"
+	"path/filepath"
 	"strings"
 	"time"
 
 	"github.com/School-meal-lover/backend/internal/excel"
 	"github.com/School-meal-lover/backend/internal/models"
-	"github.com/School-meal-lover/backend/internal/repository"
 )
 
+// 식단 업로드 요청 본문 기본 최대 크기
+const defaultUploadMaxBytes = 20 << 20
+
+// 업로드를 허용하는 확장자와 내용으로 판별한 형식
+var spreadsheetExtensions = map[string]excel.Format{
+	".xlsx": excel.FormatXLSX,
+	".xls":  excel.FormatXLS,
+	".ods":  excel.FormatODS,
+	".csv":  excel.FormatCSV,
+}
+
 type ExcelService struct {
-	mealRepo *repository.MealRepository
-	parser   *excel.Parser
+	parser         *excel.Parser
+	importer       *MenuImporter
+	mealTypes      *MealTypeService
+	maxUploadBytes int64
 }
 
-func NewExcelService(mealRepo *repository.MealRepository) *ExcelService {
+func NewExcelService(importer *MenuImporter, mealTypes *MealTypeService) *ExcelService {
 	return &ExcelService{
-		mealRepo: mealRepo,
-		parser:   excel.NewParser(),
+		parser:         excel.NewParser(),
+		importer:       importer,
+		mealTypes:      mealTypes,
+		maxUploadBytes: maxBytesFromEnv("UPLOAD_MAX_BYTES", defaultUploadMaxBytes),
 	}
 }
 
-// 엑셀 파일 전체 처리 프로세스
-func (s *ExcelService) ProcessExcelFile(filePath string) (*models.ExcelProcessResult, error) {
-	log.Printf("Starting to process Excel file: %s", filePath)
-
-	// 1. 엑셀 파일 열기
-	f, err := s.parser.OpenExcelFile(filePath)
-	if err != nil {
-		return nil, fmt.Errorf("failed to open Excel file: %w", err)
-	}
-	defer f.Close()
+// 업로드 요청 본문 최대 크기 (바이트)
+func (s *ExcelService) MaxUploadBytes() int64 {
+	return s.maxUploadBytes
+}
 
-	rawRestaurant, err := s.parser.ReadRestaurantName(f)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get restaurant: %w", err)
+// 업로드된 파일의 확장자와 실제 내용이 지원하는 스프레드시트 형식인지 확인합니다
+func (s *ExcelService) ValidateExcelFile(fileName, filePath string) error {
+	ext := strings.ToLower(filepath.Ext(fileName))
+	expected, ok := spreadsheetExtensions[ext]
+	if !ok {
+		return newValidationError("%s: unsupported file type %q (expected .xlsx, .xls, .ods or .csv)", fileName, ext)
 	}
 
-	restaurantType, err := s.parseRestaurantTypeFromName(rawRestaurant)
-	if err != nil  {
-		return nil, fmt.Errorf("failed to parse restaurantName: %w", err)
-	}
-	// 3. 주차 정보 생성
-	//weekStartDate 형식: "2006-01-02"
-	weekStartDate, err := s.parser.ReadWeekStartDate(f)
-	if err != nil {
-		return nil, fmt.Errorf("failed to read week start date: %w", err)
+	format, err := excel.DetectFormat(filePath)
+	if err != nil || format != expected {
+		return newValidationError("%s is not a valid %s file", fileName, ext)
 	}
+	return nil
+}
 
-	weekID, err := s.mealRepo.FindOrCreateWeek(weekStartDate, restaurantType)
+// 한국어/영어 엑셀 파일을 한 주 식단으로 합쳐 한 번에 저장합니다
+func (s *ExcelService) ProcessExcelFiles(koFilePath, enFilePath string) (*models.ExcelProcessResult, *models.ExcelProcessResult, error) {
+	menu, err := s.ReadWeekMenu(koFilePath, enFilePath)
 	if err != nil {
-		return nil, fmt.Errorf("failed to insert week: %w", err)
+		return nil, nil, err
 	}
 
-	// 4. 날짜 정보 구성
-	sheetName, err := s.parser.GetFirstNonEmptySheet(f)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get first non-empty sheet: %w", err)
-	}
-	dates, err := s.parser.BuildDatesFromExcel(f, sheetName, restaurantType)
+	resultKo, err := s.importer.Import(menu, nil, "KoreanExcel file processed successfully")
 	if err != nil {
-		return nil, fmt.Errorf("failed to build dates: %w", err)
+		return nil, nil, err
 	}
-
-	// 5. 식사 및 메뉴 데이터 처리
-	totalMeals, totalMenuItems, err := s.processMealsAndMenus(f, weekID, dates)
-	if err != nil {
-		return nil, fmt.Errorf("failed to process meals and menus: %w", err)
+	resultEn := &models.ExcelProcessResult{
+		Success: true,
+		WeekID:  resultKo.WeekID,
+		Message: "English Excel file processed successfully",
 	}
-
-	return &models.ExcelProcessResult{
-		Success:        true,
-		RestaurantType: string(restaurantType),
-		WeekStartDate:  weekStartDate.Format("2006-01-02"),
-		WeekID:         weekID,
-		TotalMeals:     totalMeals,
-		TotalMenuItems: totalMenuItems,
-		Message:        "KoreanExcel file processed successfully",
-	}, nil
+	return resultKo, resultEn, nil
 }
 
-// 식당 이름 헬퍼 함수
-func (s *ExcelService) parseRestaurantTypeFromName(rawName string) (models.RestaurantType, error){
-	nomalizedName := strings.ToLower(strings.TrimSpace(rawName))
-	if strings.Contains(nomalizedName, "1") {
-		return models.Restaurant1, nil
+// 한국어 엑셀 파일로 한 주 식단을 만들고, 영어 파일이 있으면 같은 위치의 메뉴에 영어 이름을 붙입니다
+func (s *ExcelService) ReadWeekMenu(koFilePath, enFilePath string) (*models.WeekMenu, error) {
+	log.Printf("Starting to process Excel file: %s", koFilePath)
+
+	menu, err := s.readKoreanWeekMenu(koFilePath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to process Korean Excel: %w", err)
 	}
-	if strings.Contains(nomalizedName, "2") {
-		return models.Restaurant2, nil
+	if enFilePath != "" {
+		if err := s.readEnglishNames(enFilePath, menu); err != nil {
+			return nil, fmt.Errorf("failed to process English Excel: %w", err)
+		}
 	}
-	return "", fmt.Errorf("unkonwn restaurant name: %s", rawName)
+	return menu, nil
 }
 
-// 영어 엑셀 파일 처리
-
-func (s *ExcelService) ProcessEnglishExcelFile(filePath string, weekID string) (*models.ExcelProcessResult, error) {
+func (s *ExcelService) readKoreanWeekMenu(filePath string) (*models.WeekMenu, error) {
 	f, err := s.parser.OpenExcelFile(filePath)
 	if err != nil {
-		return nil, fmt.Errorf("failed to open English Excel file: %w", err)
+		return nil, newValidationError("failed to open Excel file: %v", err)
 	}
 	defer f.Close()
 
-	// weekID로부터 restaurant 정보 조회
-	restaurantType, err := s.mealRepo.GetRestaurantByWeekID(weekID)
+	rawRestaurant, err := s.parser.ReadRestaurantName(f)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get restaurant by week ID: %w", err)
+		return nil, newValidationError("failed to get restaurant: %v", err)
 	}
-
-	sheetName, err := s.parser.GetFirstNonEmptySheet(f)
+	restaurantType, err := s.parseRestaurantTypeFromName(rawRestaurant)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get first non-empty sheet: %w", err)
+		return nil, newValidationError("failed to parse restaurantName: %v", err)
 	}
-	dates, err := s.parser.BuildDatesFromExcel(f, sheetName, restaurantType)
+	//weekStartDate 형식: "2006-01-02"
+	weekStartDate, err := s.parser.ReadWeekStartDate(f)
 	if err != nil {
-		return nil, fmt.Errorf("failed to build dates: %w", err)
+		return nil, newValidationError("failed to read week start date: %v", err)
 	}
 
-	mealTypes := s.getMealTypeConfigs()
-	var updateItems []models.MenuItem
-
-	for _, mealType := range mealTypes {
-		for _, dateInfo := range dates {
-			mealID, err := s.mealRepo.GetMealIDByWeekDateAndType(weekID, dateInfo.Date, mealType.MealType)
-			if err != nil {
-				log.Printf("Meal not found for %s %s: %v", dateInfo.Date, mealType.MealType, err)
-				continue
-			}
-
-			englishNames, err := s.parser.ReadMenuItems(f, dateInfo.Col, mealType.StartRow, mealType.EndRow)
-			if err != nil {
-				log.Printf("Failed to read English menu items for %s %s: %v", dateInfo.Date, mealType.MealType, err)
-				continue
-			}
-			if len(englishNames) == 0 {
-				continue
-			}
+	dates, err := s.readDates(f, restaurantType)
+	if err != nil {
+		return nil, err
+	}
+	mealTypes, err := s.excelMealTypes(restaurantType)
+	if err != nil {
+		return nil, err
+	}
 
-			koreanMenuItems, err := s.mealRepo.GetMenuItemsByMealIDOrdered(mealID)
+	menu := &models.WeekMenu{Restaurant: restaurantType, WeekStart: weekStartDate}
+	for _, dateInfo := range dates {
+		date, err := time.Parse("2006-01-02", dateInfo.Date)
+		if err != nil {
+			return nil, newValidationError("invalid date %q: %v", dateInfo.Date, err)
+		}
+		day := &models.DayMenu{Date: date, DayOfWeek: dateInfo.DayOfWeek}
+		for _, mealType := range mealTypes {
+			names, err := s.parser.ReadMenuItems(f, dateInfo.Col, *mealType.ExcelStartRow, *mealType.ExcelEndRow)
 			if err != nil {
-				log.Printf("Failed to fetch Korean menu items for mealID %s: %v", mealID, err)
+				// 읽지 못한 식사는 업로드에서 빼서 기존 메뉴를 그대로 둡니다
+				log.Printf("Failed to read menu items for %s %s: %v", dateInfo.Date, mealType.Code, err)
 				continue
 			}
-
-			minLen := min(len(englishNames), len(koreanMenuItems))
-			for i := 0; i < minLen; i++ {
-				updateItems = append(updateItems, models.MenuItem{
-					ID:     koreanMenuItems[i].ID,
-					NameEn: englishNames[i],
-				})
+			meal := &models.MealMenu{MealType: mealType.Code}
+			for _, name := range names {
+				meal.Items = append(meal.Items, models.MenuItem{Name: name})
 			}
+			day.Meals = append(day.Meals, meal)
 		}
+		menu.Days = append(menu.Days, day)
 	}
+	return menu, nil
+}
 
-	if err := s.mealRepo.UpdateMenuItemsEnglishNameBatch(updateItems); err != nil {
-		return nil, fmt.Errorf("failed to batch update NameEn: %w", err)
+// 영어 파일의 메뉴는 한국어 파일과 같은 날짜, 식사, 순서의 메뉴에 대응합니다
+func (s *ExcelService) readEnglishNames(filePath string, menu *models.WeekMenu) error {
+	f, err := s.parser.OpenExcelFile(filePath)
+	if err != nil {
+		return newValidationError("failed to open English Excel file: %v", err)
 	}
+	defer f.Close()
 
-	return &models.ExcelProcessResult{
-		Success: true,
-		WeekID:  weekID,
-		Message: "English Excel file processed successfully",
-	}, nil
-}
-
-// 식사 및 메뉴 처리 (비즈니스 로직)
-func (s *ExcelService) processMealsAndMenus(f *excel.ExcelFile, weekID string, dates []models.DateInfo) (int, int, error) {
-	mealTypes := s.getMealTypeConfigs()
-	totalMeals := 0
-	totalMenuItems := 0
+	rawRestaurant, err := s.parser.ReadRestaurantName(f)
+	if err != nil {
+		return newValidationError("failed to get restaurant: %v", err)
+	}
+	restaurantType, err := s.parseRestaurantTypeFromName(rawRestaurant)
+	if err != nil {
+		return err
+	}
+	if restaurantType != menu.Restaurant {
+		return newValidationError("English Excel file is for %s, expected %s", restaurantType, menu.Restaurant)
+	}
 
-	for _, mealType := range mealTypes {
-		for _, dateInfo := range dates {
-			// 식사 정보 생성
-			meal := s.buildMealFromDateInfo(weekID, dateInfo, mealType.MealType)
+	dates, err := s.readDates(f, menu.Restaurant)
+	if err != nil {
+		return err
+	}
+	mealTypes, err := s.excelMealTypes(menu.Restaurant)
+	if err != nil {
+		return err
+	}
 
-			mealID, err := s.mealRepo.FindOrCreateMeal(meal)
-			if err != nil {
-				log.Printf("Failed to insert meal for %s, %s: %v", mealType.MealType, dateInfo.Date, err)
-				return totalMeals, totalMenuItems, err 
+	meals := map[string]*models.MealMenu{}
+	for _, day := range menu.Days {
+		for _, meal := range day.Meals {
+			meals[day.Date.Format("2006-01-02")+" "+meal.MealType] = meal
+		}
+	}
+	for _, dateInfo := range dates {
+		for _, mealType := range mealTypes {
+			meal, ok := meals[dateInfo.Date+" "+mealType.Code]
+			if !ok {
+				log.Printf("Meal not found for %s %s", dateInfo.Date, mealType.Code)
+				continue
 			}
-			totalMeals++
-
-			// 메뉴 아이템 읽기 및 처리
-			menuItemNames, err := s.parser.ReadMenuItems(f, dateInfo.Col, mealType.StartRow, mealType.EndRow)
+			englishNames, err := s.parser.ReadMenuItems(f, dateInfo.Col, *mealType.ExcelStartRow, *mealType.ExcelEndRow)
 			if err != nil {
-				log.Printf("Failed to read menu items: %v", err)
+				log.Printf("Failed to read English menu items for %s %s: %v", dateInfo.Date, mealType.Code, err)
 				continue
 			}
-
-			if len(menuItemNames) > 0 {
-				menuItems := s.buildMenuItems(mealID, menuItemNames, mealType.MealType)
-
-				err := s.mealRepo.InsertMenuItems(menuItems)
-				if err != nil {
-					log.Printf("Failed to insert menu items for meal %s: %v", mealID, err)
-					continue
-				}
-				totalMenuItems += len(menuItems)
+			for i := 0; i < min(len(englishNames), len(meal.Items)); i++ {
+				meal.Items[i].NameEn = englishNames[i]
 			}
 		}
 	}
-	return totalMeals, totalMenuItems, nil
+	return nil
 }
 
-// 식사 객체 생성 아침/점심/점심/저녁
-func (s *ExcelService) buildMealFromDateInfo(weekID string, dateInfo models.DateInfo, mealType string) *models.Meal {
-	date, err := time.Parse("2006-01-02", dateInfo.Date)
+// 식단 시트의 날짜 열 정보
+func (s *ExcelService) readDates(f *excel.ExcelFile, restaurantType models.RestaurantType) ([]models.DateInfo, error) {
+	sheetName, err := s.parser.GetFirstNonEmptySheet(f)
 	if err != nil {
-		log.Printf("Failed to parse date %s: %v", dateInfo.Date, err)
-		date = time.Now() // 기본값
+		return nil, newValidationError("failed to get first non-empty sheet: %v", err)
 	}
-
-	return &models.Meal{
-		WeekID:    weekID,
-		Date:      date,
-		DayOfWeek: dateInfo.DayOfWeek,
-		MealType:  mealType,
+	dates, err := s.parser.BuildDatesFromExcel(f, sheetName, restaurantType)
+	if err != nil {
+		return nil, newValidationError("failed to build dates: %v", err)
 	}
+	return dates, nil
 }
 
-// 메뉴 아이템 생성
-func (s *ExcelService) buildMenuItems(mealID string, itemNames []string, mealType string) []models.MenuItem {
-	categories := s.getCategoriesForMealType(mealType)
-	var menuItems []models.MenuItem
-
-	if len(itemNames) == 0 {
-		log.Printf("No menu items found for meal type %s", mealType)
-		return menuItems
+// 엑셀 파일의 식당 정보만 확인합니다 (업로드 권한 확인용)
+func (s *ExcelService) DetectRestaurant(filePath string) (models.RestaurantType, error) {
+	f, err := s.parser.OpenExcelFile(filePath)
+	if err != nil {
+		return "", newValidationError("failed to open Excel file: %v", err)
 	}
+	defer f.Close()
 
-	for idx, name := range itemNames {
-		var category string
-
-		if idx < len(categories) {
-			category = categories[idx]
-		} else {
-			category = "기타" // 기본 카테고리
-		}
-		menuItems = append(menuItems, models.MenuItem{
-			MealID:   mealID,
-			Category: category,
-			Name:     strings.TrimSpace(name),
-			NameEn:   "",
-			Price:    0.00,
-		})
+	rawRestaurant, err := s.parser.ReadRestaurantName(f)
+	if err != nil {
+		return "", newValidationError("failed to get restaurant: %v", err)
 	}
-	return menuItems
+	return s.parseRestaurantTypeFromName(rawRestaurant)
 }
 
-// 식사 타입별 설정 반환
-func (s *ExcelService) getMealTypeConfigs() []models.MealTypeConfig {
-	return []models.MealTypeConfig{
-		{MealType: "Breakfast", StartRow: 7, EndRow: 16},
-		{MealType: "Lunch_1", StartRow: 18, EndRow: 18},
-		{MealType: "Lunch_2", StartRow: 21, EndRow: 26},
-		{MealType: "Dinner", StartRow: 27, EndRow: 32},
+// 식당 이름 헬퍼 함수
+func (s *ExcelService) parseRestaurantTypeFromName(rawName string) (models.RestaurantType, error) {
+	nomalizedName := strings.ToLower(strings.TrimSpace(rawName))
+	if strings.Contains(nomalizedName, "1") {
+		return models.Restaurant1, nil
+	}
+	if strings.Contains(nomalizedName, "2") {
+		return models.Restaurant2, nil
 	}
+	return "", newValidationError("unkonwn restaurant name: %s", rawName)
 }
 
-// 식사 타입별 카테고리 반환
-func (s *ExcelService) getCategoriesForMealType(mealType string) []string {
-	if mealType == "Lunch_1" {
-		return []string{"메인메뉴"} // 일품 메뉴
+// 엑셀 식단표에서 읽을 행 범위가 설정된 식사 종류
+func (s *ExcelService) excelMealTypes(restaurant models.RestaurantType) ([]*models.MealType, error) {
+	all, err := s.mealTypes.List(restaurant)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load meal types: %w", err)
 	}
-	if mealType == "Breakfast" {
-		return []string{"밥", "국", "반찬", "메인메뉴", "반찬", "반찬", "반찬", "반찬", "반찬"}
+	var mealTypes []*models.MealType
+	for _, mealType := range all {
+		if mealType.ExcelStartRow != nil && mealType.ExcelEndRow != nil {
+			mealTypes = append(mealTypes, mealType)
+		}
 	}
-	return []string{"밥", "국", "메인메뉴", "메인메뉴", "반찬", "반찬"}
+	return mealTypes, nil
 }
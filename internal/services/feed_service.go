@@ -0,0 +1,146 @@
+package services
+
+import (
+	"encoding/xml"
+	"fmt"
+	"html"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/School-meal-lover/backend/internal/models"
+	"github.com/School-meal-lover/backend/internal/repository"
+)
+
+// 피드에 포함할 과거 기간
+const feedLookbackDays = 28
+
+type FeedService struct {
+	mealRepo *repository.MealRepository
+}
+
+func NewFeedService(mealRepo *repository.MealRepository) *FeedService {
+	return &FeedService{mealRepo: mealRepo}
+}
+
+type atomFeed struct {
+	XMLName xml.Name    `xml:"http://www.w3.org/2005/Atom feed"`
+	ID      string      `xml:"id"`
+	Title   string      `xml:"title"`
+	Updated string      `xml:"updated"`
+	Link    []atomLink  `xml:"link"`
+	Author  atomAuthor  `xml:"author"`
+	Entries []atomEntry `xml:"entry"`
+}
+
+type atomLink struct {
+	Href string `xml:"href,attr"`
+	Rel  string `xml:"rel,attr,omitempty"`
+	Type string `xml:"type,attr,omitempty"`
+}
+
+type atomAuthor struct {
+	Name string `xml:"name"`
+}
+
+type atomEntry struct {
+	ID        string      `xml:"id"`
+	Title     string      `xml:"title"`
+	Updated   string      `xml:"updated"`
+	Published string      `xml:"published,omitempty"`
+	Content   atomContent `xml:"content"`
+}
+
+type atomContent struct {
+	Type string `xml:"type,attr"`
+	Body string `xml:",chardata"`
+}
+
+// 식당의 일별 식단을 Atom 피드로 생성합니다 (하루치 식단이 하나의 항목)
+func (s *FeedService) GetRestaurantFeed(restaurant models.RestaurantType, lang, selfURL string, now time.Time) ([]byte, error) {
+	weeks, err := s.mealRepo.ListWeeksSince(restaurant, now.AddDate(0, 0, -feedLookbackDays))
+	if err != nil {
+		return nil, err
+	}
+
+	name := restaurantLabel(restaurant, lang)
+	feed := &atomFeed{
+		ID:     fmt.Sprintf("tag:grrrr.me,2025:%s:%s", restaurant, lang),
+		Title:  feedTitle(name, lang),
+		Link:   []atomLink{{Href: selfURL, Rel: "self", Type: "application/atom+xml"}},
+		Author: atomAuthor{Name: "Grrrrr"},
+	}
+
+	var latest time.Time
+	for _, week := range weeks {
+		days, _, err := s.mealRepo.GetMealsData(week.ID)
+		if err != nil {
+			return nil, err
+		}
+		updatedTimes, err := s.mealRepo.GetDayUpdatedTimes(week.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, day := range days {
+			updated, ok := updatedTimes[day.Date]
+			if !ok {
+				updated = now
+			}
+			if updated.After(latest) {
+				latest = updated
+			}
+			published, err := time.ParseInLocation("2006-01-02", day.Date, kst)
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse meal date %s: %w", day.Date, err)
+			}
+
+			feed.Entries = append(feed.Entries, atomEntry{
+				ID:        fmt.Sprintf("tag:grrrr.me,2025:%s:%s:%s", restaurant, day.Date, lang),
+				Title:     fmt.Sprintf("%s %s", day.Date, name),
+				Updated:   updated.Format(time.RFC3339),
+				Published: published.Format(time.RFC3339),
+				Content:   atomContent{Type: "html", Body: dayMenuHTML(day, lang)},
+			})
+		}
+	}
+
+	// 최신 날짜가 위로 오도록 정렬
+	sort.Slice(feed.Entries, func(i, j int) bool {
+		return feed.Entries[i].Published > feed.Entries[j].Published
+	})
+	if latest.IsZero() {
+		latest = now
+	}
+	feed.Updated = latest.Format(time.RFC3339)
+
+	out, err := xml.MarshalIndent(feed, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode feed: %w", err)
+	}
+	return append([]byte(xml.Header), out...), nil
+}
+
+func feedTitle(restaurantName, lang string) string {
+	if lang == LangEnglish {
+		return restaurantName + " Daily Menu"
+	}
+	return restaurantName + " 오늘의 식단"
+}
+
+// 하루치 식단을 식사 종류별 목록 HTML로 변환
+func dayMenuHTML(day *models.DayMeals, lang string) string {
+	var b strings.Builder
+	for _, mealType := range orderedMealTypes(day.Meals) {
+		meal := day.Meals[mealType]
+		if len(meal.MenuItems) == 0 {
+			continue
+		}
+		b.WriteString("<h3>" + html.EscapeString(mealTypeLabel(meal, lang)) + "</h3><ul>")
+		for _, item := range meal.MenuItems {
+			b.WriteString("<li>" + html.EscapeString(menuItemName(item, lang)) + "</li>")
+		}
+		b.WriteString("</ul>")
+	}
+	return b.String()
+}
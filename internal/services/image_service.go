@@ -1,38 +1,204 @@
 package services
 
 import (
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"strings"
 	"time"
 
 	"github.com/School-meal-lover/backend/internal/models"
+	"github.com/School-meal-lover/backend/internal/repository"
+	"github.com/School-meal-lover/backend/internal/storage"
+	"github.com/google/uuid"
 )
 
+const (
+	imageHistoryLimit          = 50
+	defaultImageMaxUploadBytes = 10 << 20
+	imageFilesPath             = "/api/v1/images/files/"
+	imageKeyPrefix             = "images/"
+)
+
+// 업로드를 허용하는 이미지 형식과 저장할 확장자
+var imageExtensions = map[string]string{
+	"image/jpeg": ".jpg",
+	"image/png":  ".png",
+	"image/gif":  ".gif",
+}
+
 type ImageService struct {
-	current_image_name [3]string
-	current_image_date [3]time.Time
+	imageRepo      *repository.ImageRepository
+	mealRepo       *repository.MealRepository
+	blobStore      storage.BlobStore
+	webhookService *WebhookService
+	maxUploadBytes int64
 }
 
-func NewImageService() *ImageService {
+func NewImageService(imageRepo *repository.ImageRepository, mealRepo *repository.MealRepository, blobStore storage.BlobStore, webhookService *WebhookService) *ImageService {
 	return &ImageService{
-		current_image_name: [3]string{"", "", ""},
-		current_image_date: [3]time.Time{},
+		imageRepo:      imageRepo,
+		mealRepo:       mealRepo,
+		blobStore:      blobStore,
+		webhookService: webhookService,
+		maxUploadBytes: maxBytesFromEnv("IMAGE_MAX_UPLOAD_BYTES", defaultImageMaxUploadBytes),
 	}
 }
 
+// 업로드 파일 최대 크기 (바이트)
+func (s *ImageService) MaxUploadBytes() int64 {
+	return s.maxUploadBytes
+}
+
 // 이미지 이름 업로드 및 저장
-func (s *ImageService) UploadImageName(imageName string, restaurant_num int) (*models.ImageInfoResponse, error) {
-	s.current_image_name[restaurant_num] = imageName
-	s.current_image_date[restaurant_num] = time.Now()
-	return &models.ImageInfoResponse{
-		Success:   true,
-		ImageName: s.current_image_name[restaurant_num],
-		ImageDate: s.current_image_date[restaurant_num].Format("2006-01-02 15:04:05"),
-	}, nil
+// date가 속한 주차가 있으면 주차에 연결하고 주차 시작일부터 유효한 이미지로 기록합니다
+func (s *ImageService) UploadImageName(imageName string, restaurant models.RestaurantType, date time.Time) (*models.ImageInfoResponse, error) {
+	image := s.newImage(imageName, restaurant, date)
+	if err := s.saveImage(image); err != nil {
+		return nil, err
+	}
+	return imageInfoResponse(image), nil
+}
+
+// 이미지 파일 업로드: 형식을 내용으로 확인하고 원본과 썸네일을 저장소에 저장한 뒤 기록합니다
+func (s *ImageService) UploadImageFile(ctx context.Context, restaurant models.RestaurantType, date time.Time, fileName string, data []byte) (*models.ImageInfoResponse, error) {
+	if len(data) == 0 {
+		return nil, newValidationError("image file is empty")
+	}
+	if int64(len(data)) > s.maxUploadBytes {
+		return nil, newValidationError("image file is too large (max %d bytes)", s.maxUploadBytes)
+	}
+	// 클라이언트가 보낸 Content-Type 대신 파일 내용으로 형식을 판별합니다
+	contentType := http.DetectContentType(data)
+	extension, ok := imageExtensions[contentType]
+	if !ok {
+		return nil, newValidationError("unsupported image type: %s", contentType)
+	}
+	thumbnail, err := makeThumbnail(data)
+	if err != nil {
+		return nil, newValidationError("invalid image file: %v", err)
+	}
+
+	image := s.newImage(SanitizeFileName(fileName, "image"+extension), restaurant, date)
+
+	id := uuid.New().String()
+	prefix := fmt.Sprintf("%s%s/%s/%s", imageKeyPrefix, image.Restaurant, image.ValidFrom.Format("2006-01-02"), id)
+	image.ID = id
+	image.BlobKey = prefix + extension
+	image.ThumbnailKey = prefix + "_thumb.jpg"
+	image.ContentType = contentType
+	image.SizeBytes = int64(len(data))
+
+	if err := s.blobStore.Put(ctx, image.BlobKey, data, contentType); err != nil {
+		return nil, fmt.Errorf("failed to store image: %w", err)
+	}
+	if err := s.blobStore.Put(ctx, image.ThumbnailKey, thumbnail, "image/jpeg"); err != nil {
+		s.deleteBlobs(image.BlobKey)
+		return nil, fmt.Errorf("failed to store thumbnail: %w", err)
+	}
+	if err := s.saveImage(image); err != nil {
+		s.deleteBlobs(image.BlobKey, image.ThumbnailKey)
+		return nil, err
+	}
+	return imageInfoResponse(image), nil
+}
+
+// 저장된 이미지 파일 열기 (이미지 키가 아니면 storage.ErrNotFound)
+func (s *ImageService) OpenImageFile(ctx context.Context, key string) (io.ReadCloser, string, error) {
+	if !strings.HasPrefix(key, imageKeyPrefix) {
+		return nil, "", storage.ErrNotFound
+	}
+	return s.blobStore.Get(ctx, key)
+}
+
+func (s *ImageService) deleteBlobs(keys ...string) {
+	for _, key := range keys {
+		if err := s.blobStore.Delete(context.Background(), key); err != nil {
+			log.Printf("Failed to clean up blob %s: %v", key, err)
+		}
+	}
+}
+
+func (s *ImageService) newImage(imageName string, restaurant models.RestaurantType, date time.Time) *models.Image {
+	image := &models.Image{
+		Restaurant: restaurant,
+		ImageName:  imageName,
+		ValidFrom:  date,
+	}
+
+	week, err := s.mealRepo.GetWeekInfo(restaurant, date.Format("2006-01-02"))
+	if err == nil {
+		image.WeekID = &week.ID
+		if startDate, err := time.Parse("2006-01-02", week.StartDate); err == nil {
+			image.ValidFrom = startDate
+		}
+	} else {
+		log.Printf("No week found for image %s (%s %s): %v", imageName, restaurant, date.Format("2006-01-02"), err)
+	}
+	return image
+}
+
+func (s *ImageService) saveImage(image *models.Image) error {
+	if err := s.imageRepo.InsertImage(image); err != nil {
+		return err
+	}
+
+	if s.webhookService != nil {
+		data := &models.ImageUpdatedData{
+			Restaurant: string(image.Restaurant),
+			ImageName:  image.ImageName,
+			ImageDate:  image.CreatedAt.Format(time.RFC3339),
+		}
+		if image.BlobKey != "" {
+			data.ImageURL = imageFilesPath + image.BlobKey
+		}
+		s.webhookService.Publish(models.EventImageUpdated, data)
+	}
+	return nil
 }
 
-func (s *ImageService) GetCurrentImageName(restaurant_num int) (*models.ImageInfoResponse, error) {
-	return &models.ImageInfoResponse{
+// 해당 날짜에 유효한 이미지 조회 (없으면 nil)
+func (s *ImageService) GetCurrentImageName(restaurant models.RestaurantType, date time.Time) (*models.ImageInfoResponse, error) {
+	image, err := s.imageRepo.GetImageValidAt(restaurant, date)
+	if err != nil || image == nil {
+		return nil, err
+	}
+	return imageInfoResponse(image), nil
+}
+
+// 식당별 이미지 업로드 기록 (최신순)
+func (s *ImageService) GetImageHistory(restaurant models.RestaurantType) (*models.ImageHistoryResponse, error) {
+	images, err := s.imageRepo.GetImageHistory(restaurant, imageHistoryLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &models.ImageHistoryResponse{Success: true, Data: []*models.ImageInfoResponse{}}
+	for _, image := range images {
+		response.Data = append(response.Data, imageInfoResponse(image))
+	}
+	return response, nil
+}
+
+func imageInfoResponse(image *models.Image) *models.ImageInfoResponse {
+	response := &models.ImageInfoResponse{
 		Success:   true,
-		ImageName: s.current_image_name[restaurant_num],
-		ImageDate: s.current_image_date[restaurant_num].Format("2006-01-02 15:04:05"),
-	}, nil
+		ImageName: image.ImageName,
+		ImageDate: image.CreatedAt.Format("2006-01-02 15:04:05"),
+		ValidFrom: image.ValidFrom.Format("2006-01-02"),
+	}
+	if image.WeekID != nil {
+		response.WeekID = *image.WeekID
+	}
+	if image.BlobKey != "" {
+		response.ImageURL = imageFilesPath + image.BlobKey
+		response.ContentType = image.ContentType
+		response.SizeBytes = image.SizeBytes
+	}
+	if image.ThumbnailKey != "" {
+		response.ThumbnailURL = imageFilesPath + image.ThumbnailKey
+	}
+	return response
 }
@@ -0,0 +1,60 @@
+package services
+
+import (
+	"time"
+
+	"github.com/School-meal-lover/backend/internal/models"
+)
+
+// 응답 언어
+const (
+	LangKorean  = "ko"
+	LangEnglish = "en"
+)
+
+// 한국 표준시 (서머타임 없음)
+var kst = time.FixedZone("KST", 9*60*60)
+
+type localizedLabel struct {
+	Ko string
+	En string
+}
+
+func (l localizedLabel) In(lang string) string {
+	if lang == LangEnglish {
+		return l.En
+	}
+	return l.Ko
+}
+
+var restaurantLabels = map[models.RestaurantType]localizedLabel{
+	models.Restaurant1: {Ko: "제1학생식당", En: "Student Cafeteria 1"},
+	models.Restaurant2: {Ko: "제2학생식당", En: "Student Cafeteria 2"},
+}
+
+func restaurantLabel(restaurant models.RestaurantType, lang string) string {
+	if label, ok := restaurantLabels[restaurant]; ok {
+		return label.In(lang)
+	}
+	return string(restaurant)
+}
+
+// 식사 종류 설정의 표시 이름 (설정에 없는 종류는 코드)
+func mealTypeLabel(meal *models.MealInfo, lang string) string {
+	label := meal.Label
+	if lang == LangEnglish {
+		label = meal.LabelEn
+	}
+	if label == "" {
+		return meal.MealType
+	}
+	return label
+}
+
+// 언어에 맞는 메뉴 이름 (영어 이름이 없으면 한국어 이름)
+func menuItemName(item *models.MenuItemResponse, lang string) string {
+	if lang == LangEnglish && item.NameEn != "" {
+		return item.NameEn
+	}
+	return item.Name
+}
@@ -1,6 +1,9 @@
 package services
 
 import (
+	"database/sql"
+	"errors"
+	"slices"
 	"time"
 
 	"github.com/School-meal-lover/backend/internal/models"
@@ -9,27 +12,25 @@ import (
 
 type MealService struct {
 	mealRepo *repository.MealRepository
+	closures *ClosureService
 }
 
-func NewMealService(mealRepo *repository.MealRepository) *MealService {
+func NewMealService(mealRepo *repository.MealRepository, closures *ClosureService) *MealService {
 	return &MealService{
 		mealRepo: mealRepo,
+		closures: closures,
 	}
 }
 
 // 특정 레스토랑의 주간 식단을 조회
 func (s *MealService) GetRestaurantWeekMeals(restaurantNameParam string, date string) (*models.RestaurantMealsResponse, error) {
 	// 날짜 형식 검증
-	var restaurantType models.RestaurantType
-	switch restaurantNameParam {
-	case string(models.Restaurant1):
-		restaurantType = models.Restaurant1
-	case string(models.Restaurant2):
-		restaurantType = models.Restaurant2
-	default:
+	restaurantType, ok := models.ParseRestaurantType(restaurantNameParam)
+	if !ok {
 		return &models.RestaurantMealsResponse{Success: false, Error: "Invalid restaurant name", Code: "INVALID_RESTAURANR_NAME"}, nil
 	}
-	if _, err := time.Parse("2006-01-02", date); err != nil {
+	parsedDate, err := time.Parse("2006-01-02", date)
+	if err != nil {
 		return &models.RestaurantMealsResponse{
 			Success: false,
 			Error:   "Invalid date format. Use YYYY-MM-DD",
@@ -39,6 +40,12 @@ func (s *MealService) GetRestaurantWeekMeals(restaurantNameParam string, date st
 	// 주차 정보 조회 및 에러 처리
 	week, err := s.mealRepo.GetWeekInfo(restaurantType, date)
 	if err != nil {
+		// 식단이 없는 주라도 휴무가 등록되어 있으면 휴무일을 보냅니다
+		if closedWeek, closureErr := s.closedWeekMeals(restaurantType, parsedDate); closureErr != nil {
+			return nil, closureErr
+		} else if closedWeek != nil {
+			return &models.RestaurantMealsResponse{Success: true, Data: closedWeek}, nil
+		}
 		return &models.RestaurantMealsResponse{
 			Success: false,
 			Error:   "WEEK_DATA_NOT_FOUND",
@@ -57,6 +64,14 @@ func (s *MealService) GetRestaurantWeekMeals(restaurantNameParam string, date st
 		}, nil
 	}
 
+	weekStart, _ := time.Parse("2006-01-02", week.StartDate)
+	weekEnd, _ := time.Parse("2006-01-02", week.EndDate)
+	orderedmealsByDay, err = s.closures.Apply(restaurantType, orderedmealsByDay, weekStart, weekEnd)
+	if err != nil {
+		return nil, err
+	}
+	summary.TotalDays = len(orderedmealsByDay)
+
 	// 성공 응답 구성
 	response := &models.RestaurantMealsData{
 		Restaurant: string(restaurantType),
@@ -70,3 +85,92 @@ func (s *MealService) GetRestaurantWeekMeals(restaurantNameParam string, date st
 		Data:    response,
 	}, nil
 }
+
+// 식단이 올라오지 않은 주의 휴무일 (휴무가 없으면 nil)
+func (s *MealService) closedWeekMeals(restaurant models.RestaurantType, date time.Time) (*models.RestaurantMealsData, error) {
+	weekStart := date.AddDate(0, 0, -(int(date.Weekday())+6)%7)
+	weekEnd := weekStart.AddDate(0, 0, restaurant.WeekDays()-1)
+	if date.After(weekEnd) {
+		return nil, nil
+	}
+
+	days, err := s.closures.Apply(restaurant, nil, weekStart, weekEnd)
+	if err != nil {
+		return nil, err
+	}
+	// 공휴일만 있는 주는 아직 식단이 올라오지 않은 주와 구분할 수 없으므로 찾을 수 없음으로 응답합니다
+	if !slices.ContainsFunc(days, hasClosure) {
+		return nil, nil
+	}
+	return &models.RestaurantMealsData{
+		Restaurant: string(restaurant),
+		Week:       &models.WeekInfo{StartDate: weekStart.Format("2006-01-02"), EndDate: weekEnd.Format("2006-01-02")},
+		MealsByDay: days,
+		Summary:    &models.MealsSummary{TotalDays: len(days)},
+	}, nil
+}
+
+// 특정 식당의 하루 식단 조회 API 응답
+func (s *MealService) GetRestaurantDailyMeals(restaurantNameParam string, date string) (*models.DailyMealsResponse, error) {
+	restaurantType, ok := models.ParseRestaurantType(restaurantNameParam)
+	if !ok {
+		return &models.DailyMealsResponse{Success: false, Error: "Invalid restaurant name", Code: "INVALID_RESTAURANT_NAME"}, nil
+	}
+	if _, err := time.Parse("2006-01-02", date); err != nil {
+		return &models.DailyMealsResponse{
+			Success: false,
+			Error:   "Invalid date format. Use YYYY-MM-DD",
+			Code:    "INVALID_DATE_FORMAT",
+		}, nil
+	}
+
+	day, err := s.GetRestaurantDayMeals(restaurantType, date)
+	if err != nil {
+		return nil, err
+	}
+	if day == nil {
+		return &models.DailyMealsResponse{
+			Success: false,
+			Error:   "No meal data found for the specified date",
+			Code:    "DAY_DATA_NOT_FOUND",
+		}, nil
+	}
+	return &models.DailyMealsResponse{
+		Success: true,
+		Data:    &models.DailyMealsData{Restaurant: string(restaurantType), Day: day},
+	}, nil
+}
+
+// 특정 식당의 하루 식단 조회 (해당 날짜의 식단과 휴무가 모두 없으면 nil)
+func (s *MealService) GetRestaurantDayMeals(restaurant models.RestaurantType, date string) (*models.DayMeals, error) {
+	parsedDate, err := time.Parse("2006-01-02", date)
+	if err != nil {
+		return nil, err
+	}
+
+	var days []*models.DayMeals
+	week, err := s.mealRepo.GetWeekInfo(restaurant, date)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, err
+	}
+	if week != nil {
+		weekDays, _, err := s.mealRepo.GetMealsData(week.ID)
+		if err != nil {
+			return nil, err
+		}
+		for _, day := range weekDays {
+			if day.Date == date {
+				days = append(days, day)
+			}
+		}
+	}
+
+	days, err = s.closures.Apply(restaurant, days, parsedDate, parsedDate)
+	if err != nil {
+		return nil, err
+	}
+	if len(days) == 0 {
+		return nil, nil
+	}
+	return days[0], nil
+}
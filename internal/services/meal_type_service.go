@@ -0,0 +1,120 @@
+package services
+
+import (
+	"regexp"
+	"sync"
+	"time"
+
+	"github.com/School-meal-lover/backend/internal/models"
+	"github.com/School-meal-lover/backend/internal/repository"
+)
+
+// 여러 서버가 같은 DB를 쓰므로 다른 서버에서 바꾼 설정도 이 시간 안에 반영됩니다
+const mealTypeCacheTTL = time.Minute
+
+// 식사 종류 코드: 영문자로 시작하는 영문자, 숫자, 밑줄 (Breakfast, Lunch_1, Late_snack)
+var mealTypeCodePattern = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9_]{0,31}$`)
+
+type MealTypeService struct {
+	mealTypeRepo *repository.MealTypeRepository
+
+	mu       sync.Mutex
+	cached   []*models.MealType
+	loadedAt time.Time
+}
+
+func NewMealTypeService(mealTypeRepo *repository.MealTypeRepository) *MealTypeService {
+	return &MealTypeService{mealTypeRepo: mealTypeRepo}
+}
+
+// 모든 식당의 식사 종류 (식당, 표시 순서 순)
+func (s *MealTypeService) All() ([]*models.MealType, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.cached != nil && time.Since(s.loadedAt) < mealTypeCacheTTL {
+		return s.cached, nil
+	}
+	mealTypes, err := s.mealTypeRepo.ListMealTypes()
+	if err != nil {
+		return nil, err
+	}
+	if mealTypes == nil {
+		mealTypes = []*models.MealType{}
+	}
+	s.cached = mealTypes
+	s.loadedAt = time.Now()
+	return mealTypes, nil
+}
+
+// 식당의 식사 종류 (표시 순서 순)
+func (s *MealTypeService) List(restaurant models.RestaurantType) ([]*models.MealType, error) {
+	all, err := s.All()
+	if err != nil {
+		return nil, err
+	}
+	mealTypes := []*models.MealType{}
+	for _, mealType := range all {
+		if mealType.Restaurant == restaurant {
+			mealTypes = append(mealTypes, mealType)
+		}
+	}
+	return mealTypes, nil
+}
+
+// 식당별 식사 종류 코드 (업로드 검사용)
+func (s *MealTypeService) Codes() (models.MealTypeCodes, error) {
+	all, err := s.All()
+	if err != nil {
+		return nil, err
+	}
+	codes := models.MealTypeCodes{}
+	for _, mealType := range all {
+		codes[mealType.Restaurant] = append(codes[mealType.Restaurant], mealType.Code)
+	}
+	return codes, nil
+}
+
+// 식사 종류를 추가하거나 수정합니다
+func (s *MealTypeService) Upsert(restaurant models.RestaurantType, code string, req *models.MealTypeRequest) (*models.MealType, error) {
+	if !mealTypeCodePattern.MatchString(code) {
+		return nil, newValidationError("invalid meal type code %q (letters, digits and underscores, starting with a letter)", code)
+	}
+	start, err := time.Parse("15:04", req.ServingStart)
+	if err != nil {
+		return nil, newValidationError("invalid serving_start %q (expected HH:MM)", req.ServingStart)
+	}
+	end, err := time.Parse("15:04", req.ServingEnd)
+	if err != nil {
+		return nil, newValidationError("invalid serving_end %q (expected HH:MM)", req.ServingEnd)
+	}
+	if !end.After(start) {
+		return nil, newValidationError("serving_end must be after serving_start")
+	}
+	if (req.ExcelStartRow == nil) != (req.ExcelEndRow == nil) {
+		return nil, newValidationError("excel_start_row and excel_end_row must be set together")
+	}
+	if req.ExcelStartRow != nil && (*req.ExcelStartRow < 1 || *req.ExcelEndRow < *req.ExcelStartRow) {
+		return nil, newValidationError("invalid excel row range %d-%d", *req.ExcelStartRow, *req.ExcelEndRow)
+	}
+
+	mealType := &models.MealType{
+		Restaurant:    restaurant,
+		Code:          code,
+		LabelKo:       req.LabelKo,
+		LabelEn:       req.LabelEn,
+		DisplayOrder:  req.DisplayOrder,
+		ServingStart:  start.Format("15:04"),
+		ServingEnd:    end.Format("15:04"),
+		ExcelStartRow: req.ExcelStartRow,
+		ExcelEndRow:   req.ExcelEndRow,
+	}
+	if err := s.mealTypeRepo.UpsertMealType(mealType); err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	s.cached = nil
+	s.mu.Unlock()
+	return mealType, nil
+}
@@ -0,0 +1,116 @@
+package services
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/School-meal-lover/backend/internal/models"
+)
+
+type mealKey struct{ date, mealType string }
+
+// 식사(날짜, 식사 종류)별 메뉴 목록 (keys는 처음 나온 순서)
+type mealItems struct {
+	keys  []mealKey
+	items map[mealKey][]*models.MenuItemResponse
+}
+
+func (m *mealItems) add(key mealKey, items ...*models.MenuItemResponse) {
+	if m.items == nil {
+		m.items = map[mealKey][]*models.MenuItemResponse{}
+	}
+	if _, ok := m.items[key]; !ok {
+		m.keys = append(m.keys, key)
+	}
+	m.items[key] = append(m.items[key], items...)
+}
+
+// 저장된 식단의 식사별 메뉴
+func dayMealItems(days []*models.DayMeals) *mealItems {
+	meals := &mealItems{}
+	for _, day := range days {
+		for mealType, meal := range day.Meals {
+			meals.add(mealKey{day.Date, mealType}, meal.MenuItems...)
+		}
+	}
+	return meals
+}
+
+// 업로드한 식단의 식사별 메뉴
+func weekMenuItems(menu *models.WeekMenu) *mealItems {
+	meals := &mealItems{}
+	for _, day := range menu.Days {
+		for _, meal := range day.Meals {
+			key := mealKey{day.Date.Format("2006-01-02"), meal.MealType}
+			meals.add(key)
+			for _, item := range meal.Items {
+				meals.add(key, &models.MenuItemResponse{
+					Category: item.Category, Name: item.Name, NameEn: item.NameEn, Price: item.Price, Allergens: item.Allergens,
+				})
+			}
+		}
+	}
+	return meals
+}
+
+// 두 식단의 메뉴를 분류와 이름으로 맞춰 비교합니다
+// upload면 after(업로드)에 있는 식사만 비교하고, 비어 있는 영어 이름과 nil 알레르기 정보는 기존 값을 유지하므로 변경으로 보지 않습니다
+// 아니면 한쪽에만 있는 식사도 포함해서 모든 식사를 날짜, 식사 종류 순으로 비교합니다
+func diffMenuItems(before, after *mealItems, upload bool) *models.MenuChanges {
+	keys := slices.Clone(after.keys)
+	if !upload {
+		for _, key := range before.keys {
+			if _, ok := after.items[key]; !ok {
+				keys = append(keys, key)
+			}
+		}
+		slices.SortFunc(keys, func(a, b mealKey) int {
+			if c := strings.Compare(a.date, b.date); c != 0 {
+				return c
+			}
+			return strings.Compare(a.mealType, b.mealType)
+		})
+	}
+
+	changes := &models.MenuChanges{}
+	record := func(change string, key mealKey, item *models.MenuItemResponse) {
+		switch change {
+		case models.MenuChangeAdded:
+			changes.Added++
+		case models.MenuChangeRemoved:
+			changes.Removed++
+		case models.MenuChangeUpdated:
+			changes.Updated++
+		}
+		changes.Items = append(changes.Items, models.MenuItemChange{
+			Change: change, Date: key.date, MealType: key.mealType, Category: item.Category, Name: item.Name,
+		})
+	}
+	for _, key := range keys {
+		old := map[string]*models.MenuItemResponse{}
+		for _, item := range before.items[key] {
+			old[item.Category+"\x00"+item.Name] = item
+		}
+		kept := map[string]bool{}
+		for _, item := range after.items[key] {
+			itemKey := item.Category + "\x00" + item.Name
+			kept[itemKey] = true
+			previous, ok := old[itemKey]
+			if !ok {
+				record(models.MenuChangeAdded, key, item)
+				continue
+			}
+			nameEnChanged := item.NameEn != previous.NameEn && (!upload || item.NameEn != "")
+			allergensChanged := !slices.Equal(item.Allergens, previous.Allergens) && (!upload || item.Allergens != nil)
+			if nameEnChanged || item.Price != previous.Price || allergensChanged {
+				record(models.MenuChangeUpdated, key, item)
+			}
+		}
+		for _, item := range before.items[key] {
+			if !kept[item.Category+"\x00"+item.Name] {
+				record(models.MenuChangeRemoved, key, item)
+			}
+		}
+	}
+	return changes
+}
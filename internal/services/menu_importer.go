@@ -0,0 +1,255 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/School-meal-lover/backend/internal/category"
+	"github.com/School-meal-lover/backend/internal/models"
+	"github.com/School-meal-lover/backend/internal/repository"
+)
+
+// 엑셀, 텍스트, JSON/YAML 업로드가 함께 쓰는 가져오기
+// 한 주 식단을 검사하고, 기존 데이터와 비교한 뒤 한 트랜잭션으로 draft 주차에 저장합니다
+// 업로드 이벤트는 draft가 게시될 때 WeekService가 발생시킵니다
+type MenuImporter struct {
+	mealRepo   *repository.MealRepository
+	mealTypes  *MealTypeService
+	closures   *ClosureService
+	classifier *category.Classifier
+}
+
+func NewMenuImporter(mealRepo *repository.MealRepository, mealTypes *MealTypeService, closures *ClosureService, classifier *category.Classifier) *MenuImporter {
+	return &MenuImporter{mealRepo: mealRepo, mealTypes: mealTypes, closures: closures, classifier: classifier}
+}
+
+// 검사 오류는 ValidationError, 저장 중 오류는 그 밖의 오류로 반환합니다
+// warnings에는 업로드 형식 검사에서 나온 경고를 넘기면 결과에 함께 담습니다
+func (i *MenuImporter) Import(menu *models.WeekMenu, warnings []models.ParseDiagnostic, message string) (*models.ExcelProcessResult, error) {
+	mealTypes, err := i.mealTypes.Codes()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load meal types: %w", err)
+	}
+	if err := normalizeWeekMenu(menu, mealTypes[menu.Restaurant]); err != nil {
+		return nil, err
+	}
+	categoryWarnings, err := i.assignCategories(menu)
+	if err != nil {
+		return nil, err
+	}
+	warnings = append(warnings, categoryWarnings...)
+	warnings = append(warnings, dedupeMenuItems(menu)...)
+	// 휴무로 등록된 날의 식단도 저장은 하지만, 응답에서는 휴무가 우선하므로 경고합니다
+	closureWarnings, err := i.closures.uploadWarnings(menu)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load closures: %w", err)
+	}
+	warnings = append(warnings, closureWarnings...)
+
+	// 변경 내역을 위해 업로드 전 데이터 보관 (작성 중인 draft, 없으면 게시된 주차)
+	existingWeek, err := i.mealRepo.FindWeekForUpload(menu.Restaurant, menu.WeekStart, menu.WeekEnd)
+	if err != nil {
+		return nil, weekOverlapError(err)
+	}
+	var existingWeekID string
+	if existingWeek != nil {
+		existingWeekID = existingWeek.ID
+	}
+	var before []*models.DayMeals
+	if existingWeekID != "" {
+		before, _, err = i.mealRepo.GetMealsData(existingWeekID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load existing meals: %w", err)
+		}
+	}
+	changes := diffMenuItems(dayMealItems(before), weekMenuItems(menu), true)
+
+	weekID, err := i.mealRepo.SaveWeekMenu(menu)
+	if err != nil {
+		if err := weekOverlapError(err); IsValidationError(err) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to save week menu: %w", err)
+	}
+
+	totalMeals := 0
+	totalMenuItems := 0
+	for _, day := range menu.Days {
+		totalMeals += len(day.Meals)
+		for _, meal := range day.Meals {
+			totalMenuItems += len(meal.Items)
+		}
+	}
+	return &models.ExcelProcessResult{
+		Success:        true,
+		RestaurantType: string(menu.Restaurant),
+		WeekStartDate:  menu.WeekStart.Format("2006-01-02"),
+		WeekEndDate:    menu.WeekEnd.Format("2006-01-02"),
+		WeekID:         weekID,
+		WeekStatus:     models.WeekStatusDraft,
+		TotalMeals:     totalMeals,
+		TotalMenuItems: totalMenuItems,
+		Message:        message,
+		Warnings:       warnings,
+		Changes:        changes,
+	}, nil
+}
+
+// 업로드 경로와 관계없이 같은 규칙으로 검사하고 정리합니다 (메뉴 이름 공백 제거, 빈 메뉴 제거)
+// mealTypes는 식당에 설정된 식사 종류 코드입니다
+func normalizeWeekMenu(menu *models.WeekMenu, mealTypes []string) error {
+	if _, ok := restaurantLabels[menu.Restaurant]; !ok {
+		return newValidationError("unknown restaurant: %q", menu.Restaurant)
+	}
+	if menu.WeekStart.IsZero() {
+		return newValidationError("missing week start date")
+	}
+
+	var problems []string
+	weekLimit := menu.WeekStart.AddDate(0, 0, 6)
+	if menu.WeekEnd.IsZero() {
+		menu.WeekEnd = defaultWeekEnd(menu)
+	}
+	if menu.WeekEnd.Before(menu.WeekStart) || menu.WeekEnd.After(weekLimit) {
+		return newValidationError("week end date %s must be within 6 days after the start date %s",
+			menu.WeekEnd.Format("2006-01-02"), menu.WeekStart.Format("2006-01-02"))
+	}
+	seenDates := map[string]bool{}
+	for _, day := range menu.Days {
+		date := day.Date.Format("2006-01-02")
+		if day.Date.Before(menu.WeekStart) || day.Date.After(menu.WeekEnd) {
+			problems = append(problems, fmt.Sprintf("%s is outside the week %s to %s", date,
+				menu.WeekStart.Format("2006-01-02"), menu.WeekEnd.Format("2006-01-02")))
+		}
+		if seenDates[date] {
+			problems = append(problems, fmt.Sprintf("duplicate day %s", date))
+		}
+		seenDates[date] = true
+		if day.DayOfWeek == "" {
+			day.DayOfWeek = day.Date.Weekday().String()
+		}
+
+		seenMeals := map[string]bool{}
+		for _, meal := range day.Meals {
+			if !slices.Contains(mealTypes, meal.MealType) {
+				problems = append(problems, fmt.Sprintf("unknown meal type %q on %s", meal.MealType, date))
+				continue
+			}
+			if seenMeals[meal.MealType] {
+				problems = append(problems, fmt.Sprintf("duplicate %s on %s", meal.MealType, date))
+			}
+			seenMeals[meal.MealType] = true
+
+			var items []models.MenuItem
+			for _, item := range meal.Items {
+				item.Name = strings.TrimSpace(item.Name)
+				item.NameEn = strings.TrimSpace(item.NameEn)
+				if item.Name != "" {
+					items = append(items, item)
+				}
+			}
+			meal.Items = items
+		}
+	}
+
+	if len(problems) > 0 {
+		return newValidationError("invalid week menu: %s", strings.Join(problems, "; "))
+	}
+	slices.SortFunc(menu.Days, func(a, b *models.DayMenu) int { return a.Date.Compare(b.Date) })
+	return nil
+}
+
+// 시작일이 속한 주의 마지막 운영일(restaurant1 금요일, restaurant2 일요일)과 마지막 식단 날짜 중 늦은 날
+// 수요일에 시작하는 파일은 그 주 금요일(일요일)까지만 차지해서 다음 주와 겹치지 않습니다
+func defaultWeekEnd(menu *models.WeekMenu) time.Time {
+	isoWeekday := (int(menu.WeekStart.Weekday())+6)%7 + 1
+	end := menu.WeekStart.AddDate(0, 0, max(0, menu.Restaurant.WeekDays()-isoWeekday))
+	for _, day := range menu.Days {
+		// 한 주를 넘는 날짜는 normalizeWeekMenu에서 오류로 알립니다
+		if day.Date.After(end) && !day.Date.After(menu.WeekStart.AddDate(0, 0, 6)) {
+			end = day.Date
+		}
+	}
+	return end
+}
+
+// 기존 주차와 겹치는 업로드는 잘못된 요청으로 처리합니다
+func weekOverlapError(err error) error {
+	var overlapErr *repository.WeekOverlapError
+	if errors.As(err, &overlapErr) {
+		return newValidationError("%s", overlapErr.Error())
+	}
+	return err
+}
+
+// 분류가 지정되지 않은 메뉴는 이름 규칙과 식당의 지난 이력으로 분류하고, 확신이 없을 때만 식사 안의 위치를 따릅니다
+func (i *MenuImporter) assignCategories(menu *models.WeekMenu) ([]models.ParseDiagnostic, error) {
+	var names []string
+	for _, day := range menu.Days {
+		for _, meal := range day.Meals {
+			for _, item := range meal.Items {
+				if item.Category == "" {
+					names = append(names, item.Name)
+				}
+			}
+		}
+	}
+	if len(names) == 0 {
+		return nil, nil
+	}
+	history, err := i.mealRepo.GetCategoryHistory(menu.Restaurant, names)
+	if err != nil {
+		return nil, err
+	}
+
+	var warnings []models.ParseDiagnostic
+	for _, day := range menu.Days {
+		for _, meal := range day.Meals {
+			for idx := range meal.Items {
+				item := &meal.Items[idx]
+				if item.Category != "" {
+					continue
+				}
+				result := i.classifier.Classify(menu.Restaurant, meal.MealType, idx, item.Name, history)
+				item.Category = result.Category
+				if result.Category == category.Other {
+					warnings = append(warnings, models.ParseDiagnostic{
+						Severity: models.DiagnosticWarning,
+						Message: fmt.Sprintf("%s %s: could not determine a category for %s, saved as %s",
+							day.Date.Format("2006-01-02"), meal.MealType, item.Name, category.Other),
+					})
+				}
+			}
+		}
+	}
+	return warnings, nil
+}
+
+// 같은 식사 안에서 분류와 이름이 같은 메뉴는 처음 것만 남깁니다
+func dedupeMenuItems(menu *models.WeekMenu) []models.ParseDiagnostic {
+	var warnings []models.ParseDiagnostic
+	for _, day := range menu.Days {
+		for _, meal := range day.Meals {
+			seen := map[string]bool{}
+			var items []models.MenuItem
+			for _, item := range meal.Items {
+				key := item.Category + "\x00" + item.Name
+				if seen[key] {
+					warnings = append(warnings, models.ParseDiagnostic{
+						Severity: models.DiagnosticWarning,
+						Message: fmt.Sprintf("%s %s: duplicate menu item %s (%s) ignored",
+							day.Date.Format("2006-01-02"), meal.MealType, item.Name, item.Category),
+					})
+					continue
+				}
+				seen[key] = true
+				items = append(items, item)
+			}
+			meal.Items = items
+		}
+	}
+	return warnings
+}
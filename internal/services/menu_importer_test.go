@@ -0,0 +1,84 @@
+package services
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/School-meal-lover/backend/internal/category"
+	"github.com/School-meal-lover/backend/internal/models"
+	"github.com/School-meal-lover/backend/internal/repository"
+	"github.com/School-meal-lover/backend/internal/testdb"
+)
+
+func testWeekMenu(items map[string]models.MenuItem) *models.WeekMenu {
+	menu := &models.WeekMenu{Restaurant: models.Restaurant1, WeekStart: testDate("2025-05-26")}
+	for _, date := range []string{"2025-05-26", "2025-05-27"} {
+		item, ok := items[date]
+		if !ok {
+			continue
+		}
+		menu.Days = append(menu.Days, &models.DayMenu{
+			Date:  testDate(date),
+			Meals: []*models.MealMenu{{MealType: "Lunch_2", Items: []models.MenuItem{item}}},
+		})
+	}
+	return menu
+}
+
+func TestImportFailedSaveKeepsWeek(t *testing.T) {
+	db := testdb.Open(t, testdb.Latest(t))
+	mealRepo := repository.NewMealRepository(db)
+	mealTypes := NewMealTypeService(repository.NewMealTypeRepository(db))
+	closures := NewClosureService(repository.NewClosureRepository(db), mealTypes)
+	classifier := category.NewClassifier(&category.RuleSet{Positions: map[string][]string{"default": {"메인메뉴"}}}, nil)
+	importer := NewMenuImporter(mealRepo, mealTypes, closures, classifier)
+
+	result, err := importer.Import(testWeekMenu(map[string]models.MenuItem{
+		"2025-05-26": {Category: "메인메뉴", Name: "돈까스", Price: 5000},
+		"2025-05-27": {Category: "메인메뉴", Name: "카레"},
+	}), nil, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := db.Exec(`UPDATE weeks SET status = $2 WHERE id = $1`, result.WeekID, models.WeekStatusInReview); err != nil {
+		t.Fatal(err)
+	}
+
+	// 월요일 메뉴를 바꾼 뒤 화요일 가격(numeric(10,2) 범위 밖)에서 저장이 실패합니다
+	_, err = importer.Import(testWeekMenu(map[string]models.MenuItem{
+		"2025-05-26": {Category: "메인메뉴", Name: "짜장면"},
+		"2025-05-27": {Category: "메인메뉴", Name: "카레", Price: 1e12},
+	}), nil, "")
+	if err == nil || IsValidationError(err) {
+		t.Fatalf("err = %v, want a save error", err)
+	}
+
+	var status, start, end string
+	err = db.QueryRow(`SELECT status, start_date::text, end_date::text FROM weeks WHERE id = $1`, result.WeekID).Scan(&status, &start, &end)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if status != models.WeekStatusInReview || start != "2025-05-26" || end != "2025-05-30" {
+		t.Errorf("week = %s %s~%s, want in_review 2025-05-26~2025-05-30", status, start, end)
+	}
+	var count int
+	if err := db.QueryRow(`SELECT COUNT(*) FROM weeks`).Scan(&count); err != nil || count != 1 {
+		t.Errorf("%d weeks, %v, want 1", count, err)
+	}
+
+	days, _, err := mealRepo.GetMealsData(result.WeekID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var menus []string
+	for _, day := range days {
+		for _, item := range day.Meals["Lunch_2"].MenuItems {
+			menus = append(menus, fmt.Sprintf("%s %s %.0f", day.Date, item.Name, item.Price))
+		}
+	}
+	want := []string{"2025-05-26 돈까스 5000", "2025-05-27 카레 0"}
+	if !slices.Equal(menus, want) {
+		t.Errorf("menus = %v, want %v", menus, want)
+	}
+}
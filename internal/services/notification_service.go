@@ -0,0 +1,296 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/School-meal-lover/backend/internal/models"
+	"github.com/School-meal-lover/backend/internal/notification"
+	"github.com/School-meal-lover/backend/internal/repository"
+	"github.com/google/uuid"
+)
+
+const (
+	notificationMaxAttempts  = 3
+	notificationRetryBackoff = 2 * time.Second
+	// 동시에 전송하는 디바이스 수 (느리거나 실패하는 디바이스가 나머지를 막지 않도록)
+	notificationWorkers = 8
+)
+
+// 전송 시도 결과를 기록하는 저장소 (테스트에서는 메모리 구현으로 바꿉니다)
+type deliveryRecorder interface {
+	UpdateDeliveryAttempt(delivery *models.NotificationDelivery) error
+}
+
+type NotificationService struct {
+	notificationRepo *repository.NotificationRepository
+	notifiers        map[string]notification.Notifier
+	deliveries       deliveryRecorder
+	retryBackoff     time.Duration
+	workers          int
+}
+
+func NewNotificationService(notificationRepo *repository.NotificationRepository, notifiers map[string]notification.Notifier) *NotificationService {
+	return &NotificationService{
+		notificationRepo: notificationRepo,
+		notifiers:        notifiers,
+		deliveries:       notificationRepo,
+		retryBackoff:     notificationRetryBackoff,
+		workers:          notificationWorkers,
+	}
+}
+
+// 디바이스 구독 등록 및 즐겨찾기 메뉴 설정
+func (s *NotificationService) Subscribe(req *models.NotificationSubscribeRequest) (*models.NotificationSubscriber, error) {
+	subscriber := &models.NotificationSubscriber{
+		DeviceToken: strings.TrimSpace(req.DeviceToken),
+		Platform:    req.Platform,
+	}
+	if req.Restaurant != "" {
+		restaurant := models.RestaurantType(req.Restaurant)
+		subscriber.Restaurant = &restaurant
+	}
+
+	seen := make(map[string]bool)
+	for _, dish := range req.FavoriteDishes {
+		dish = strings.TrimSpace(dish)
+		if dish == "" || seen[dish] {
+			continue
+		}
+		seen[dish] = true
+		subscriber.FavoriteDishes = append(subscriber.FavoriteDishes, dish)
+	}
+
+	return s.notificationRepo.UpsertSubscriber(subscriber)
+}
+
+// 구독을 삭제합니다 (deviceToken이 비어 있으면 관리자 요청으로 보고 디바이스를 확인하지 않습니다)
+func (s *NotificationService) Unsubscribe(id, deviceToken string) (bool, error) {
+	if _, err := uuid.Parse(id); err != nil {
+		return false, nil
+	}
+	return s.notificationRepo.DeleteSubscriber(id, strings.TrimSpace(deviceToken))
+}
+
+// 업로드 완료 후 즐겨찾기 메뉴 알림과 식단 변경 알림을 큐에 넣고 전송을 시작합니다
+func (s *NotificationService) HandleWeekUploaded(event *models.WeekUploadEvent) {
+	subscribers, err := s.notificationRepo.GetSubscribersByRestaurant(event.Restaurant)
+	if err != nil {
+		log.Printf("Failed to load notification subscribers: %v", err)
+		return
+	}
+	if len(subscribers) == 0 {
+		return
+	}
+
+	newDishes := newDishesByDate(event.Before, event.After)
+	changedDates := changedDates(event.Before, event.After)
+
+	var deliveries []*models.NotificationDelivery
+	for _, subscriber := range subscribers {
+		if delivery := favoriteDishDelivery(subscriber, newDishes); delivery != nil {
+			deliveries = append(deliveries, delivery)
+		}
+		// 식단 변경 알림은 해당 식당을 구독한 사용자에게만 보냅니다
+		if len(changedDates) > 0 && subscriber.Restaurant != nil && *subscriber.Restaurant == event.Restaurant {
+			deliveries = append(deliveries, &models.NotificationDelivery{
+				SubscriberID: subscriber.ID,
+				DeviceToken:  subscriber.DeviceToken,
+				Platform:     subscriber.Platform,
+				Kind:         models.NotificationMenuChanged,
+				Title:        "식단이 변경되었어요",
+				Body:         fmt.Sprintf("%s 식단이 수정되었습니다.", strings.Join(changedDates, ", ")),
+			})
+		}
+	}
+
+	var queued []*models.NotificationDelivery
+	for _, delivery := range deliveries {
+		if err := s.notificationRepo.InsertDelivery(delivery); err != nil {
+			log.Printf("Failed to enqueue notification for subscriber %s: %v", delivery.SubscriberID, err)
+			continue
+		}
+		queued = append(queued, delivery)
+	}
+	log.Printf("Queued %d notifications for week %s", len(queued), event.WeekID)
+
+	go s.dispatch(queued)
+}
+
+// 큐에 들어간 알림을 재시도와 함께 전송합니다
+// 디바이스마다 따로 재시도하므로, 한 디바이스의 재시도 대기가 다른 디바이스 전송을 늦추지 않습니다
+func (s *NotificationService) dispatch(deliveries []*models.NotificationDelivery) {
+	queue := make(chan *models.NotificationDelivery)
+	var wg sync.WaitGroup
+	for range min(s.workers, len(deliveries)) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for delivery := range queue {
+				s.deliver(delivery)
+			}
+		}()
+	}
+	for _, delivery := range deliveries {
+		queue <- delivery
+	}
+	close(queue)
+	wg.Wait()
+}
+
+func (s *NotificationService) deliver(delivery *models.NotificationDelivery) {
+	notifier, ok := s.notifiers[delivery.Platform]
+	if !ok {
+		delivery.Status = models.DeliveryFailed
+		delivery.LastError = "unsupported platform: " + delivery.Platform
+		s.recordAttempt(delivery)
+		return
+	}
+
+	msg := &notification.Message{
+		DeviceToken: delivery.DeviceToken,
+		Title:       delivery.Title,
+		Body:        delivery.Body,
+		Data:        map[string]string{"kind": delivery.Kind},
+	}
+
+	backoff := s.retryBackoff
+	for delivery.Attempts < notificationMaxAttempts {
+		ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+		err := notifier.Send(ctx, msg)
+		cancel()
+		delivery.Attempts++
+
+		if err == nil {
+			now := time.Now()
+			delivery.Status = models.DeliveryDelivered
+			delivery.LastError = ""
+			delivery.DeliveredAt = &now
+			s.recordAttempt(delivery)
+			return
+		}
+
+		delivery.LastError = err.Error()
+		if notification.IsPermanent(err) || delivery.Attempts >= notificationMaxAttempts {
+			delivery.Status = models.DeliveryFailed
+			s.recordAttempt(delivery)
+			return
+		}
+		s.recordAttempt(delivery)
+		time.Sleep(backoff)
+		backoff *= 2
+	}
+}
+
+func (s *NotificationService) recordAttempt(delivery *models.NotificationDelivery) {
+	if err := s.deliveries.UpdateDeliveryAttempt(delivery); err != nil {
+		log.Printf("Failed to record notification delivery %s: %v", delivery.ID, err)
+	}
+}
+
+// 즐겨찾기 메뉴가 새로 올라온 경우 알림 생성
+func favoriteDishDelivery(subscriber *models.NotificationSubscriber, newDishes map[string][]string) *models.NotificationDelivery {
+	if len(subscriber.FavoriteDishes) == 0 {
+		return nil
+	}
+
+	var matches []string
+	var dates []string
+	for date := range newDishes {
+		dates = append(dates, date)
+	}
+	sort.Strings(dates)
+
+	for _, date := range dates {
+		for _, dish := range newDishes[date] {
+			for _, favorite := range subscriber.FavoriteDishes {
+				if strings.Contains(dish, favorite) {
+					matches = append(matches, fmt.Sprintf("%s %s", date[5:], dish))
+					break
+				}
+			}
+		}
+	}
+	if len(matches) == 0 {
+		return nil
+	}
+
+	return &models.NotificationDelivery{
+		SubscriberID: subscriber.ID,
+		DeviceToken:  subscriber.DeviceToken,
+		Platform:     subscriber.Platform,
+		Kind:         models.NotificationFavoriteDish,
+		Title:        "좋아하는 메뉴가 나와요!",
+		Body:         strings.Join(matches, ", "),
+	}
+}
+
+// 날짜별 메뉴 이름 집합
+func dishNamesByDate(days []*models.DayMeals) map[string]map[string]bool {
+	names := make(map[string]map[string]bool)
+	for _, day := range days {
+		if names[day.Date] == nil {
+			names[day.Date] = make(map[string]bool)
+		}
+		for _, meal := range day.Meals {
+			for _, item := range meal.MenuItems {
+				if item.Name != "" {
+					names[day.Date][meal.MealType+"/"+item.Name] = true
+				}
+			}
+		}
+	}
+	return names
+}
+
+// 업로드 전에는 없던 메뉴를 날짜별로 반환
+func newDishesByDate(before, after []*models.DayMeals) map[string][]string {
+	beforeNames := dishNamesByDate(before)
+	result := make(map[string][]string)
+	for date, names := range dishNamesByDate(after) {
+		seen := make(map[string]bool)
+		for key := range names {
+			if beforeNames[date][key] {
+				continue
+			}
+			dish := key[strings.Index(key, "/")+1:]
+			if !seen[dish] {
+				seen[dish] = true
+				result[date] = append(result[date], dish)
+			}
+		}
+		sort.Strings(result[date])
+	}
+	return result
+}
+
+// 이미 게시되어 있던 날짜 중 메뉴가 바뀐 날짜 목록
+func changedDates(before, after []*models.DayMeals) []string {
+	beforeNames := dishNamesByDate(before)
+	afterNames := dishNamesByDate(after)
+
+	var dates []string
+	for date, previous := range beforeNames {
+		if len(previous) == 0 {
+			continue
+		}
+		current := afterNames[date]
+		if len(current) != len(previous) {
+			dates = append(dates, date)
+			continue
+		}
+		for key := range previous {
+			if !current[key] {
+				dates = append(dates, date)
+				break
+			}
+		}
+	}
+	sort.Strings(dates)
+	return dates
+}
@@ -0,0 +1,176 @@
+package services
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/School-meal-lover/backend/internal/models"
+	"github.com/School-meal-lover/backend/internal/notification"
+)
+
+// 전송 시도 기록을 메모리에 남기는 deliveryRecorder
+type memoryDeliveries struct {
+	mu       sync.Mutex
+	attempts map[string][]models.NotificationDelivery
+}
+
+func (m *memoryDeliveries) UpdateDeliveryAttempt(delivery *models.NotificationDelivery) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.attempts == nil {
+		m.attempts = map[string][]models.NotificationDelivery{}
+	}
+	m.attempts[delivery.DeviceToken] = append(m.attempts[delivery.DeviceToken], *delivery)
+	return nil
+}
+
+func (m *memoryDeliveries) last(token string) models.NotificationDelivery {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	records := m.attempts[token]
+	if len(records) == 0 {
+		return models.NotificationDelivery{}
+	}
+	return records[len(records)-1]
+}
+
+func newTestNotificationService(notifier notification.Notifier) (*NotificationService, *memoryDeliveries) {
+	recorder := &memoryDeliveries{}
+	return &NotificationService{
+		notifiers:    map[string]notification.Notifier{models.PlatformFCM: notifier},
+		deliveries:   recorder,
+		retryBackoff: time.Millisecond,
+		workers:      notificationWorkers,
+	}, recorder
+}
+
+func testDeliveries(tokens ...string) []*models.NotificationDelivery {
+	var deliveries []*models.NotificationDelivery
+	for _, token := range tokens {
+		deliveries = append(deliveries, &models.NotificationDelivery{
+			ID:          "delivery-" + token,
+			DeviceToken: token,
+			Platform:    models.PlatformFCM,
+			Kind:        models.NotificationMenuChanged,
+			Title:       "식단이 변경되었어요",
+			Body:        "05-26 식단이 수정되었습니다.",
+		})
+	}
+	return deliveries
+}
+
+func TestDispatchDeliversAll(t *testing.T) {
+	fake := notification.NewFakeNotifier()
+	service, recorder := newTestNotificationService(fake)
+
+	service.dispatch(testDeliveries("a", "b", "c"))
+
+	if got := len(fake.Sent()); got != 3 {
+		t.Fatalf("sent %d messages, want 3", got)
+	}
+	for _, token := range []string{"a", "b", "c"} {
+		record := recorder.last(token)
+		if record.Status != models.DeliveryDelivered || record.Attempts != 1 || record.DeliveredAt == nil {
+			t.Errorf("%s: status %q after %d attempts, want delivered after 1", token, record.Status, record.Attempts)
+		}
+	}
+}
+
+func TestDispatchRetries(t *testing.T) {
+	var mu sync.Mutex
+	calls := map[string]int{}
+	fake := notification.NewFakeNotifier()
+	fake.Fail = func(msg *notification.Message) error {
+		mu.Lock()
+		defer mu.Unlock()
+		calls[msg.DeviceToken]++
+		switch msg.DeviceToken {
+		case "flaky":
+			if calls[msg.DeviceToken] < 3 {
+				return errors.New("status 503")
+			}
+		case "down":
+			return errors.New("status 503")
+		case "invalid":
+			return &notification.PermanentError{Err: errors.New("status 400: invalid token")}
+		}
+		return nil
+	}
+	service, recorder := newTestNotificationService(fake)
+
+	service.dispatch(testDeliveries("ok", "flaky", "down", "invalid"))
+
+	tests := []struct {
+		token    string
+		status   string
+		attempts int
+	}{
+		{"ok", models.DeliveryDelivered, 1},
+		{"flaky", models.DeliveryDelivered, 3},
+		{"down", models.DeliveryFailed, notificationMaxAttempts},
+		{"invalid", models.DeliveryFailed, 1}, // 영구 오류는 재시도하지 않습니다
+	}
+	for _, tt := range tests {
+		record := recorder.last(tt.token)
+		if record.Status != tt.status || record.Attempts != tt.attempts {
+			t.Errorf("%s: status %q after %d attempts, want %q after %d", tt.token, record.Status, record.Attempts, tt.status, tt.attempts)
+		}
+		if calls[tt.token] != tt.attempts {
+			t.Errorf("%s: notifier called %d times, want %d", tt.token, calls[tt.token], tt.attempts)
+		}
+	}
+	if record := recorder.last("down"); record.LastError == "" {
+		t.Error("down: last error was not recorded")
+	}
+}
+
+func TestDispatchUnsupportedPlatform(t *testing.T) {
+	fake := notification.NewFakeNotifier()
+	service, recorder := newTestNotificationService(fake)
+	deliveries := testDeliveries("web")
+	deliveries[0].Platform = "web"
+
+	service.dispatch(deliveries)
+
+	if record := recorder.last("web"); record.Status != models.DeliveryFailed || record.Attempts != 0 {
+		t.Errorf("status %q after %d attempts, want failed without attempts", record.Status, record.Attempts)
+	}
+	if len(fake.Sent()) != 0 {
+		t.Error("message sent for unsupported platform")
+	}
+}
+
+func TestDispatchSlowDeviceDoesNotBlockOthers(t *testing.T) {
+	release := make(chan struct{})
+	fake := notification.NewFakeNotifier()
+	fake.Fail = func(msg *notification.Message) error {
+		if msg.DeviceToken == "slow" {
+			<-release
+		}
+		return nil
+	}
+	service, _ := newTestNotificationService(fake)
+
+	done := make(chan struct{})
+	go func() {
+		service.dispatch(testDeliveries("slow", "a", "b", "c"))
+		close(done)
+	}()
+
+	deadline := time.After(2 * time.Second)
+	for len(fake.Sent()) < 3 {
+		select {
+		case <-deadline:
+			close(release)
+			t.Fatalf("sent %d messages while one device was slow, want 3", len(fake.Sent()))
+		case <-time.After(5 * time.Millisecond):
+		}
+	}
+	close(release)
+	<-done
+	if got := len(fake.Sent()); got != 4 {
+		t.Errorf("sent %d messages, want 4", got)
+	}
+}
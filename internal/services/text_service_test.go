@@ -0,0 +1,69 @@
+package services
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/School-meal-lover/backend/internal/models"
+	"github.com/School-meal-lover/backend/internal/textformat"
+)
+
+func TestDetectRestaurantExamples(t *testing.T) {
+	tests := map[string]models.RestaurantType{
+		"example_restaurant1.txt":    models.Restaurant1,
+		"example_restaurant1_v2.txt": models.Restaurant1,
+		"example_restaurant2.txt":    models.Restaurant2,
+	}
+	service := &TextService{}
+	for name, want := range tests {
+		text, err := os.ReadFile(filepath.Join("..", "..", "testdata", name))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got, err := service.DetectRestaurant(string(text)); err != nil || got != want {
+			t.Errorf("%s: DetectRestaurant = %q, %v, want %q", name, got, err, want)
+		}
+	}
+}
+
+func TestDetectRestaurantHeader(t *testing.T) {
+	service := &TextService{}
+	tests := []struct {
+		text string
+		want models.RestaurantType
+	}{
+		{"\n\nRESTAURANT_2\n2025-05-26\n", models.Restaurant2},
+		{"#format v2\r\n# 6월 첫째 주\r\n\r\nrestaurant_1\r\n", models.Restaurant1},
+		{"#format: v1\nRESTAURANT_1\n", models.Restaurant1},
+	}
+	for _, tt := range tests {
+		if got, err := service.DetectRestaurant(tt.text); err != nil || got != tt.want {
+			t.Errorf("DetectRestaurant(%q) = %q, %v, want %q", tt.text, got, err, tt.want)
+		}
+	}
+}
+
+func TestDetectRestaurantInvalid(t *testing.T) {
+	service := &TextService{}
+	tests := []struct {
+		text string
+		line int
+	}{
+		{"", 1},
+		{"#format v2\n# 주석만 있음\n", 1},
+		{"#format v3\nRESTAURANT_1\n", 1},
+		{"\nRESTAURANT_3\n", 2},
+		// v1에서는 '#' 줄이 주석이 아닙니다
+		{"# 주석\nRESTAURANT_1\n", 1},
+		{"#format v2\n# 주석\nCAFETERIA\n", 3},
+	}
+	for _, tt := range tests {
+		_, err := service.DetectRestaurant(tt.text)
+		var formatErr *textformat.Error
+		if !errors.As(err, &formatErr) || len(formatErr.Diagnostics) != 1 || formatErr.Diagnostics[0].Line != tt.line {
+			t.Errorf("DetectRestaurant(%q) err = %v, want a format error on line %d", tt.text, err, tt.line)
+		}
+	}
+}
@@ -0,0 +1,67 @@
+package services
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	_ "image/gif"
+	"image/jpeg"
+	_ "image/png"
+)
+
+const thumbnailMaxSize = 320
+
+// 긴 변이 thumbnailMaxSize 이하가 되도록 줄인 JPEG 썸네일을 만듭니다
+// 각 픽셀은 원본에서 대응하는 영역의 평균 색으로 계산하고 (box filter), 투명한 부분은 흰 배경으로 채웁니다
+func makeThumbnail(data []byte) ([]byte, error) {
+	src, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode image: %w", err)
+	}
+
+	bounds := src.Bounds()
+	srcW, srcH := bounds.Dx(), bounds.Dy()
+	if srcW == 0 || srcH == 0 {
+		return nil, fmt.Errorf("image has no pixels")
+	}
+	dstW, dstH := srcW, srcH
+	if srcW > thumbnailMaxSize || srcH > thumbnailMaxSize {
+		if srcW >= srcH {
+			dstW, dstH = thumbnailMaxSize, max(1, srcH*thumbnailMaxSize/srcW)
+		} else {
+			dstW, dstH = max(1, srcW*thumbnailMaxSize/srcH), thumbnailMaxSize
+		}
+	}
+
+	dst := image.NewRGBA(image.Rect(0, 0, dstW, dstH))
+	for y := 0; y < dstH; y++ {
+		y0 := bounds.Min.Y + y*srcH/dstH
+		y1 := max(y0+1, bounds.Min.Y+(y+1)*srcH/dstH)
+		for x := 0; x < dstW; x++ {
+			x0 := bounds.Min.X + x*srcW/dstW
+			x1 := max(x0+1, bounds.Min.X+(x+1)*srcW/dstW)
+
+			var r, g, b, n uint64
+			for sy := y0; sy < y1; sy++ {
+				for sx := x0; sx < x1; sx++ {
+					cr, cg, cb, ca := src.At(sx, sy).RGBA()
+					// RGBA()는 알파가 곱해진 값이므로 흰 배경 위에 합성
+					white := uint64(0xffff - ca)
+					r, g, b = r+uint64(cr)+white, g+uint64(cg)+white, b+uint64(cb)+white
+					n++
+				}
+			}
+			i := dst.PixOffset(x, y)
+			dst.Pix[i+0] = uint8(r / n >> 8)
+			dst.Pix[i+1] = uint8(g / n >> 8)
+			dst.Pix[i+2] = uint8(b / n >> 8)
+			dst.Pix[i+3] = 0xff
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := jpeg.Encode(&buf, dst, &jpeg.Options{Quality: 80}); err != nil {
+		return nil, fmt.Errorf("failed to encode thumbnail: %w", err)
+	}
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,112 @@
+package services
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/gif"
+	"image/jpeg"
+	"image/png"
+	"testing"
+)
+
+func encodePNG(t *testing.T, img image.Image) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func decodeThumbnail(t *testing.T, data []byte) image.Image {
+	t.Helper()
+	thumbnail, err := makeThumbnail(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	img, format, err := image.Decode(bytes.NewReader(thumbnail))
+	if err != nil || format != "jpeg" {
+		t.Fatalf("thumbnail format = %q, %v, want jpeg", format, err)
+	}
+	return img
+}
+
+func TestMakeThumbnailSize(t *testing.T) {
+	tests := []struct {
+		width, height int
+		wantW, wantH  int
+	}{
+		{640, 320, 320, 160},
+		{300, 1200, 80, 320},
+		{2000, 3, 320, 1},
+		// 작은 이미지는 크기를 유지합니다
+		{100, 50, 100, 50},
+	}
+	for _, tt := range tests {
+		img := image.NewRGBA(image.Rect(0, 0, tt.width, tt.height))
+		bounds := decodeThumbnail(t, encodePNG(t, img)).Bounds()
+		if bounds.Dx() != tt.wantW || bounds.Dy() != tt.wantH {
+			t.Errorf("%dx%d thumbnail = %dx%d, want %dx%d", tt.width, tt.height, bounds.Dx(), bounds.Dy(), tt.wantW, tt.wantH)
+		}
+	}
+}
+
+func TestMakeThumbnailPixels(t *testing.T) {
+	// 검은 열과 흰 열이 번갈아 있는 640x2 이미지를 반으로 줄이면 회색이 됩니다
+	stripes := image.NewGray(image.Rect(0, 0, 640, 2))
+	for x := 0; x < 640; x += 2 {
+		stripes.SetGray(x, 0, color.Gray{0xff})
+		stripes.SetGray(x, 1, color.Gray{0xff})
+	}
+	// 투명한 픽셀은 흰 배경으로 채웁니다
+	transparent := image.NewNRGBA(image.Rect(0, 0, 4, 4))
+	// 원점이 (0, 0)이 아닌 GIF
+	palette := image.NewPaletted(image.Rect(10, 10, 14, 14), []color.Color{color.RGBA{0xff, 0, 0, 0xff}})
+	var gifData bytes.Buffer
+	if err := gif.Encode(&gifData, palette, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name string
+		data []byte
+		want color.RGBA
+	}{
+		{"box filter", encodePNG(t, stripes), color.RGBA{0x7f, 0x7f, 0x7f, 0xff}},
+		{"transparent", encodePNG(t, transparent), color.RGBA{0xff, 0xff, 0xff, 0xff}},
+		{"gif", gifData.Bytes(), color.RGBA{0xff, 0, 0, 0xff}},
+	}
+	for _, tt := range tests {
+		img := decodeThumbnail(t, tt.data)
+		r, g, b, _ := img.At(img.Bounds().Min.X, img.Bounds().Min.Y).RGBA()
+		got := color.RGBA{uint8(r >> 8), uint8(g >> 8), uint8(b >> 8), 0xff}
+		// JPEG 압축 오차
+		if diff(got.R, tt.want.R) > 8 || diff(got.G, tt.want.G) > 8 || diff(got.B, tt.want.B) > 8 {
+			t.Errorf("%s: pixel = %v, want about %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func diff(a, b uint8) int {
+	if a > b {
+		return int(a - b)
+	}
+	return int(b - a)
+}
+
+func TestMakeThumbnailInvalid(t *testing.T) {
+	var valid bytes.Buffer
+	if err := jpeg.Encode(&valid, image.NewRGBA(image.Rect(0, 0, 1, 1)), nil); err != nil {
+		t.Fatal(err)
+	}
+	for name, data := range map[string][]byte{
+		"not an image": []byte("GIF89a but not really"),
+		"truncated":    valid.Bytes()[:20],
+		"empty":        nil,
+	} {
+		if _, err := makeThumbnail(data); err == nil {
+			t.Errorf("%s: makeThumbnail succeeded, want error", name)
+		}
+	}
+}
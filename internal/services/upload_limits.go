@@ -0,0 +1,32 @@
+package services
+
+import (
+	"log"
+	"os"
+	"path"
+	"strconv"
+	"strings"
+)
+
+// 업로드 최대 크기 설정값 (없거나 잘못된 값이면 기본값)
+func maxBytesFromEnv(name string, fallback int64) int64 {
+	value := os.Getenv(name)
+	if value == "" {
+		return fallback
+	}
+	parsed, err := strconv.ParseInt(value, 10, 64)
+	if err != nil || parsed <= 0 {
+		log.Printf("Invalid %s %q; using default %d", name, value, fallback)
+		return fallback
+	}
+	return parsed
+}
+
+// 클라이언트가 보낸 파일 이름에서 경로를 떼어낸 이름 (비어 있으면 fallback)
+func SanitizeFileName(name, fallback string) string {
+	base := path.Base(strings.ReplaceAll(name, "\\", "/"))
+	if base == "." || base == "/" || base == ".." {
+		return fallback
+	}
+	return base
+}
@@ -0,0 +1,203 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/School-meal-lover/backend/internal/models"
+	"github.com/School-meal-lover/backend/internal/repository"
+	"github.com/School-meal-lover/backend/internal/storage"
+	"github.com/google/uuid"
+)
+
+const uploadKeyPrefix = "uploads/sha256/"
+
+// 업로드된 원본 파일을 보관하고, 현재 파서로 다시 처리합니다
+type UploadService struct {
+	uploadRepo      *repository.UploadRepository
+	blobStore       storage.BlobStore
+	excelService    *ExcelService
+	textService     *TextService
+	documentService *DocumentService
+}
+
+func NewUploadService(uploadRepo *repository.UploadRepository, blobStore storage.BlobStore, excelService *ExcelService, textService *TextService, documentService *DocumentService) *UploadService {
+	return &UploadService{
+		uploadRepo:      uploadRepo,
+		blobStore:       blobStore,
+		excelService:    excelService,
+		textService:     textService,
+		documentService: documentService,
+	}
+}
+
+// 보관할 원본 파일
+type ArchivedFile struct {
+	Role string
+	Name string
+	Data []byte
+}
+
+// 디스크에 저장된 업로드 파일을 읽어 보관할 원본으로 만듭니다
+func ArchivedFileFromPath(role, name, path string) (ArchivedFile, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return ArchivedFile{}, fmt.Errorf("failed to read %s: %w", filepath.Base(path), err)
+	}
+	return ArchivedFile{Role: role, Name: name, Data: data}, nil
+}
+
+// 원본 파일을 내용 해시 키로 저장하고 업로드 기록을 남깁니다 (같은 내용은 한 번만 저장됩니다)
+func (s *UploadService) Archive(ctx context.Context, kind string, restaurant models.RestaurantType, weekID, uploadedBy string, files ...ArchivedFile) (*models.Upload, error) {
+	upload := &models.Upload{
+		Kind:       kind,
+		Restaurant: restaurant,
+		UploadedBy: uploadedBy,
+		Files:      []*models.UploadFile{},
+	}
+	if weekID != "" {
+		upload.WeekID = &weekID
+	}
+
+	for _, file := range files {
+		sum := sha256.Sum256(file.Data)
+		checksum := hex.EncodeToString(sum[:])
+		key := uploadKeyPrefix + checksum
+		if err := s.blobStore.Put(ctx, key, file.Data, "application/octet-stream"); err != nil {
+			return nil, fmt.Errorf("failed to store %s: %w", file.Name, err)
+		}
+		upload.Files = append(upload.Files, &models.UploadFile{
+			Role:      file.Role,
+			FileName:  file.Name,
+			SHA256:    checksum,
+			SizeBytes: int64(len(file.Data)),
+			BlobKey:   key,
+		})
+	}
+
+	if err := s.uploadRepo.InsertUpload(upload); err != nil {
+		return nil, err
+	}
+	return upload, nil
+}
+
+// 주차별 보관된 업로드 목록 (최신순, 주차 ID가 잘못되었으면 nil)
+func (s *UploadService) ListByWeek(weekID string) ([]*models.Upload, error) {
+	if _, err := uuid.Parse(weekID); err != nil {
+		return nil, nil
+	}
+	return s.uploadRepo.GetUploadsByWeek(weekID)
+}
+
+// 보관된 원본을 현재 파서로 다시 처리합니다 (업로드가 없으면 nil)
+func (s *UploadService) Reprocess(ctx context.Context, id string) (*models.Upload, []*models.ExcelProcessResult, error) {
+	if _, err := uuid.Parse(id); err != nil {
+		return nil, nil, nil
+	}
+	upload, err := s.uploadRepo.GetUploadByID(id)
+	if err != nil || upload == nil {
+		return nil, nil, err
+	}
+
+	files := make(map[string]*models.UploadFile)
+	for _, file := range upload.Files {
+		files[file.Role] = file
+	}
+
+	switch upload.Kind {
+	case models.UploadKindExcel:
+		ko, en := files[models.UploadFileKorean], files[models.UploadFileEnglish]
+		if ko == nil || en == nil {
+			return upload, nil, fmt.Errorf("upload %s is missing its Excel files", upload.ID)
+		}
+
+		dir, err := os.MkdirTemp("", "reprocess-*")
+		if err != nil {
+			return upload, nil, fmt.Errorf("failed to create temp dir: %w", err)
+		}
+		defer os.RemoveAll(dir)
+
+		koPath, err := s.restoreFile(ctx, dir, ko)
+		if err != nil {
+			return upload, nil, err
+		}
+		enPath, err := s.restoreFile(ctx, dir, en)
+		if err != nil {
+			return upload, nil, err
+		}
+		resultKo, resultEn, err := s.excelService.ProcessExcelFiles(koPath, enPath)
+		if err != nil {
+			return upload, nil, err
+		}
+		return upload, []*models.ExcelProcessResult{resultKo, resultEn}, nil
+
+	case models.UploadKindText:
+		text := files[models.UploadFileText]
+		if text == nil {
+			return upload, nil, fmt.Errorf("upload %s is missing its text body", upload.ID)
+		}
+		data, err := s.readBlob(ctx, text)
+		if err != nil {
+			return upload, nil, err
+		}
+		result, err := s.textService.ProcessText(string(data))
+		if err != nil {
+			return upload, nil, err
+		}
+		return upload, []*models.ExcelProcessResult{result}, nil
+
+	case models.UploadKindJSON:
+		document := files[models.UploadFileDocument]
+		if document == nil {
+			return upload, nil, fmt.Errorf("upload %s is missing its document", upload.ID)
+		}
+		data, err := s.readBlob(ctx, document)
+		if err != nil {
+			return upload, nil, err
+		}
+		result, err := s.documentService.ProcessDocument(data, DocumentFormat("", document.FileName, data))
+		if err != nil {
+			return upload, nil, err
+		}
+		return upload, []*models.ExcelProcessResult{result}, nil
+	}
+	return upload, nil, fmt.Errorf("unknown upload kind: %s", upload.Kind)
+}
+
+// 보관된 파일을 임시 디렉터리에 복원하고 경로를 반환합니다
+func (s *UploadService) restoreFile(ctx context.Context, dir string, file *models.UploadFile) (string, error) {
+	data, err := s.readBlob(ctx, file)
+	if err != nil {
+		return "", err
+	}
+	path := filepath.Join(dir, file.Role+filepath.Ext(filepath.Base(file.FileName)))
+	if err := os.WriteFile(path, data, 0600); err != nil {
+		return "", fmt.Errorf("failed to restore %s: %w", file.FileName, err)
+	}
+	return path, nil
+}
+
+// 저장소에서 원본을 읽고 체크섬을 확인합니다
+func (s *UploadService) readBlob(ctx context.Context, file *models.UploadFile) ([]byte, error) {
+	reader, _, err := s.blobStore.Get(ctx, file.BlobKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open archived %s: %w", file.FileName, err)
+	}
+	defer reader.Close()
+
+	var buf bytes.Buffer
+	if _, err := io.Copy(&buf, reader); err != nil {
+		return nil, fmt.Errorf("failed to read archived %s: %w", file.FileName, err)
+	}
+	sum := sha256.Sum256(buf.Bytes())
+	if hex.EncodeToString(sum[:]) != file.SHA256 {
+		return nil, fmt.Errorf("archived %s does not match its checksum", file.FileName)
+	}
+	return buf.Bytes(), nil
+}
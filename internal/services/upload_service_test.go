@@ -0,0 +1,19 @@
+package services
+
+import (
+	"context"
+	"testing"
+)
+
+func TestUploadServiceInvalidID(t *testing.T) {
+	// 저장소를 조회하지 않고 없는 주차, 없는 업로드로 처리합니다 (핸들러는 404)
+	service := &UploadService{}
+	for _, id := range []string{"", "abc", "1234"} {
+		if uploads, err := service.ListByWeek(id); uploads != nil || err != nil {
+			t.Errorf("ListByWeek(%q) = %v, %v, want nil, nil", id, uploads, err)
+		}
+		if upload, results, err := service.Reprocess(context.Background(), id); upload != nil || results != nil || err != nil {
+			t.Errorf("Reprocess(%q) = %v, %v, %v, want nil", id, upload, results, err)
+		}
+	}
+}
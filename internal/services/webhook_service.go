@@ -0,0 +1,244 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/School-meal-lover/backend/internal/models"
+	"github.com/School-meal-lover/backend/internal/repository"
+	"github.com/google/uuid"
+)
+
+const (
+	webhookMaxAttempts     = 5
+	webhookInitialBackoff  = 2 * time.Second
+	webhookDeliveryHistory = 100
+	webhookSignatureHeader = "X-Grrrr-Signature"
+)
+
+type WebhookService struct {
+	webhookRepo *repository.WebhookRepository
+	client      *http.Client
+}
+
+func NewWebhookService(webhookRepo *repository.WebhookRepository) *WebhookService {
+	return &WebhookService{
+		webhookRepo: webhookRepo,
+		client:      &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+// 웹훅 구독 등록 (secret을 지정하지 않으면 생성해서 한 번만 돌려줍니다)
+func (s *WebhookService) CreateSubscription(req *models.WebhookCreateRequest) (*models.WebhookSubscription, error) {
+	parsed, err := url.Parse(req.URL)
+	if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+		return nil, newValidationError("invalid webhook url: %s", req.URL)
+	}
+	if len(req.EventTypes) == 0 {
+		return nil, newValidationError("at least one event type is required")
+	}
+	for _, eventType := range req.EventTypes {
+		if !isWebhookEventType(eventType) {
+			return nil, newValidationError("unknown event type: %s", eventType)
+		}
+	}
+
+	secret := req.Secret
+	if secret == "" {
+		buf := make([]byte, 32)
+		if _, err := rand.Read(buf); err != nil {
+			return nil, fmt.Errorf("failed to generate webhook secret: %w", err)
+		}
+		secret = hex.EncodeToString(buf)
+	}
+
+	subscription := &models.WebhookSubscription{
+		URL:        req.URL,
+		Secret:     secret,
+		EventTypes: req.EventTypes,
+	}
+	if err := s.webhookRepo.InsertSubscription(subscription); err != nil {
+		return nil, err
+	}
+	return subscription, nil
+}
+
+func (s *WebhookService) ListSubscriptions() ([]*models.WebhookSubscription, error) {
+	subscriptions, err := s.webhookRepo.ListSubscriptions()
+	if err != nil {
+		return nil, err
+	}
+	for _, subscription := range subscriptions {
+		subscription.Secret = ""
+	}
+	if subscriptions == nil {
+		subscriptions = []*models.WebhookSubscription{}
+	}
+	return subscriptions, nil
+}
+
+func (s *WebhookService) DeleteSubscription(id string) (bool, error) {
+	if _, err := uuid.Parse(id); err != nil {
+		return false, nil
+	}
+	return s.webhookRepo.DeleteSubscription(id)
+}
+
+// 구독의 최근 전송 기록 (구독이 없으면 nil)
+func (s *WebhookService) GetDeliveries(subscriptionID string) ([]*models.WebhookDelivery, error) {
+	if _, err := uuid.Parse(subscriptionID); err != nil {
+		return nil, nil
+	}
+	exists, err := s.webhookRepo.SubscriptionExists(subscriptionID)
+	if err != nil || !exists {
+		return nil, err
+	}
+	return s.webhookRepo.GetDeliveriesBySubscription(subscriptionID, webhookDeliveryHistory)
+}
+
+// 업로드가 끝나면 새 주차는 week.published, 기존 주차는 week.updated 이벤트를 보냅니다
+func (s *WebhookService) HandleWeekUploaded(event *models.WeekUploadEvent) {
+	eventType := models.EventWeekPublished
+	if len(event.Before) > 0 {
+		eventType = models.EventWeekUpdated
+	}
+	s.Publish(eventType, &models.RestaurantMealsData{
+		Restaurant: string(event.Restaurant),
+		Week:       event.Week,
+		MealsByDay: event.After,
+		Summary:    event.Summary,
+	})
+}
+
+// 이벤트를 구독 중인 모든 웹훅에 전송합니다
+func (s *WebhookService) Publish(eventType string, data interface{}) {
+	subscriptions, err := s.webhookRepo.GetActiveSubscriptionsByEvent(eventType)
+	if err != nil {
+		log.Printf("Failed to load webhook subscriptions for %s: %v", eventType, err)
+		return
+	}
+	if len(subscriptions) == 0 {
+		return
+	}
+
+	payload, err := json.Marshal(models.WebhookPayload{
+		ID:        uuid.New().String(),
+		Event:     eventType,
+		CreatedAt: time.Now().Format(time.RFC3339),
+		Data:      data,
+	})
+	if err != nil {
+		log.Printf("Failed to encode webhook payload for %s: %v", eventType, err)
+		return
+	}
+
+	for _, subscription := range subscriptions {
+		delivery := &models.WebhookDelivery{
+			SubscriptionID: subscription.ID,
+			EventType:      eventType,
+			Payload:        string(payload),
+		}
+		if err := s.webhookRepo.InsertDelivery(delivery); err != nil {
+			log.Printf("Failed to record webhook delivery for %s: %v", subscription.URL, err)
+			continue
+		}
+		go s.deliver(subscription, delivery)
+	}
+}
+
+// 지수 백오프로 재시도하며 웹훅을 전송합니다
+func (s *WebhookService) deliver(subscription *models.WebhookSubscription, delivery *models.WebhookDelivery) {
+	backoff := webhookInitialBackoff
+	for {
+		status, err := s.post(subscription, delivery)
+		delivery.Attempts++
+		delivery.ResponseStatus = status
+
+		if err == nil {
+			now := time.Now()
+			delivery.Status = models.DeliveryDelivered
+			delivery.LastError = ""
+			delivery.DeliveredAt = &now
+			s.recordAttempt(delivery)
+			return
+		}
+
+		delivery.LastError = err.Error()
+		// 4xx 응답은 재시도해도 결과가 같으므로 중단 (408, 429 제외)
+		permanent := status >= 400 && status < 500 && status != http.StatusRequestTimeout && status != http.StatusTooManyRequests
+		if permanent || delivery.Attempts >= webhookMaxAttempts {
+			delivery.Status = models.DeliveryFailed
+			s.recordAttempt(delivery)
+			return
+		}
+		s.recordAttempt(delivery)
+		time.Sleep(backoff)
+		backoff *= 2
+	}
+}
+
+func (s *WebhookService) post(subscription *models.WebhookSubscription, delivery *models.WebhookDelivery) (int, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+	defer cancel()
+
+	body := []byte(delivery.Payload)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, subscription.URL, bytes.NewReader(body))
+	if err != nil {
+		return 0, fmt.Errorf("failed to build webhook request: %w", err)
+	}
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "Grrrrr-Webhook/1.0")
+	req.Header.Set("X-Grrrr-Event", delivery.EventType)
+	req.Header.Set("X-Grrrr-Delivery", delivery.ID)
+	req.Header.Set("X-Grrrr-Timestamp", timestamp)
+	req.Header.Set(webhookSignatureHeader, "sha256="+SignWebhookPayload(subscription.Secret, timestamp, body))
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("failed to send webhook: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode/100 != 2 {
+		respBody, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return resp.StatusCode, fmt.Errorf("webhook responded with status %d: %s", resp.StatusCode, respBody)
+	}
+	return resp.StatusCode, nil
+}
+
+func (s *WebhookService) recordAttempt(delivery *models.WebhookDelivery) {
+	if err := s.webhookRepo.UpdateDeliveryAttempt(delivery); err != nil {
+		log.Printf("Failed to record webhook delivery %s: %v", delivery.ID, err)
+	}
+}
+
+// 수신 측 검증용 서명: HMAC-SHA256(secret, "<timestamp>.<body>")
+func SignWebhookPayload(secret, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func isWebhookEventType(eventType string) bool {
+	for _, known := range models.WebhookEventTypes {
+		if eventType == known {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,184 @@
+package services
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/School-meal-lover/backend/internal/models"
+	"github.com/School-meal-lover/backend/internal/repository"
+	"github.com/google/uuid"
+)
+
+// 주차 목록 조회 최대 개수
+const maxWeekList = 100
+
+// WeekService가 쓰는 주차 저장소 (repository.WeekRepository)
+type weekStore interface {
+	GetWeek(id string) (*models.Week, error)
+	ListWeeks(restaurant models.RestaurantType, status string, limit int) ([]*models.Week, error)
+	ListPublishedOverlapping(restaurant models.RestaurantType, start, end time.Time) ([]*models.Week, error)
+	UpdateWeekStatus(id string, from []string, to, actor string) (*models.Week, error)
+	PublishWeek(id string, from []string, actor string) (*models.Week, []string, error)
+}
+
+// 주차 식단 조회 (repository.MealRepository)
+type weekMealStore interface {
+	GetWeekByID(weekID string) (*models.WeekInfo, error)
+	GetMealsData(weekID string) ([]*models.DayMeals, *models.MealsSummary, error)
+}
+
+// 업로드된 draft 주차를 검토 요청, 게시, 반려, 게시 취소하는 서비스
+// 게시할 때 업로드 이벤트를 발생시킵니다
+type WeekService struct {
+	mealRepo  weekMealStore
+	weekRepo  weekStore
+	listeners []WeekUploadListener
+}
+
+func NewWeekService(mealRepo *repository.MealRepository, weekRepo *repository.WeekRepository, listeners ...WeekUploadListener) *WeekService {
+	return &WeekService{mealRepo: mealRepo, weekRepo: weekRepo, listeners: listeners}
+}
+
+// 주차 조회 (없으면 nil)
+func (s *WeekService) Get(id string) (*models.Week, error) {
+	if _, err := uuid.Parse(id); err != nil {
+		return nil, nil
+	}
+	return s.weekRepo.GetWeek(id)
+}
+
+// 식당과 상태로 주차 목록 조회 (빈 값이면 전체)
+func (s *WeekService) List(restaurant models.RestaurantType, status string) ([]*models.Week, error) {
+	if status != "" && !slices.Contains([]string{models.WeekStatusDraft, models.WeekStatusInReview, models.WeekStatusPublished, models.WeekStatusRetracted}, status) {
+		return nil, newValidationError("unknown week status: %q", status)
+	}
+	weeks, err := s.weekRepo.ListWeeks(restaurant, status, maxWeekList)
+	if err != nil {
+		return nil, err
+	}
+	if weeks == nil {
+		weeks = []*models.Week{}
+	}
+	return weeks, nil
+}
+
+// 주차 식단과 같은 기간에 지금 게시된 식단, 게시하면 바뀌는 메뉴
+func (s *WeekService) Preview(week *models.Week) (*models.WeekPreview, error) {
+	days, summary, err := s.mealRepo.GetMealsData(week.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load week meals: %w", err)
+	}
+	preview := &models.WeekPreview{
+		Week:      week,
+		Draft:     mealsData(week, days, summary),
+		Published: []*models.RestaurantMealsData{},
+	}
+
+	if week.Status != models.WeekStatusPublished {
+		if preview.Published, err = s.publishedOverlapping(week); err != nil {
+			return nil, err
+		}
+	}
+	preview.Changes = diffMenuItems(dayMealItems(mergedDays(preview.Published)), dayMealItems(days), false)
+	return preview, nil
+}
+
+// 기간이 겹치는 다른 게시 주차의 식단 (시작일 순, 주차를 게시하면 모두 retracted가 됩니다)
+func (s *WeekService) publishedOverlapping(week *models.Week) ([]*models.RestaurantMealsData, error) {
+	published, err := s.weekRepo.ListPublishedOverlapping(week.RestaurantType, week.StartDate, week.EndDate)
+	if err != nil {
+		return nil, err
+	}
+	data := []*models.RestaurantMealsData{}
+	for _, previous := range published {
+		if previous.ID == week.ID {
+			continue
+		}
+		days, summary, err := s.mealRepo.GetMealsData(previous.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load published meals: %w", err)
+		}
+		data = append(data, mealsData(previous, days, summary))
+	}
+	return data, nil
+}
+
+func mergedDays(weeks []*models.RestaurantMealsData) []*models.DayMeals {
+	var days []*models.DayMeals
+	for _, week := range weeks {
+		days = append(days, week.MealsByDay...)
+	}
+	return days
+}
+
+// draft 주차를 검토 요청합니다
+func (s *WeekService) Submit(week *models.Week, actor string) (*models.Week, error) {
+	return s.transition(week, []string{models.WeekStatusDraft}, models.WeekStatusInReview, actor)
+}
+
+// 검토 중인 주차를 draft로 되돌립니다
+func (s *WeekService) Reject(week *models.Week, actor string) (*models.Week, error) {
+	return s.transition(week, []string{models.WeekStatusInReview}, models.WeekStatusDraft, actor)
+}
+
+// 게시된 주차를 조회 API에서 내립니다 (다시 게시할 수 있습니다)
+func (s *WeekService) Retract(week *models.Week, actor string) (*models.Week, error) {
+	return s.transition(week, []string{models.WeekStatusPublished}, models.WeekStatusRetracted, actor)
+}
+
+func (s *WeekService) transition(week *models.Week, from []string, to, actor string) (*models.Week, error) {
+	updated, err := s.weekRepo.UpdateWeekStatus(week.ID, from, to, actor)
+	if err != nil {
+		return nil, err
+	}
+	if updated == nil {
+		return nil, newConflictError("week %s is %s, expected %s", week.ID, week.Status, strings.Join(from, " or "))
+	}
+	return updated, nil
+}
+
+// 검토 중이거나 내려간 주차를 게시합니다
+// 기간이 겹치는 이전 게시 주차는 retracted가 되고, 이전 게시 식단과 비교한 변경 내역으로 업로드 이벤트를 보냅니다
+func (s *WeekService) Publish(week *models.Week, actor string) (*models.Week, error) {
+	published, err := s.publishedOverlapping(week)
+	if err != nil {
+		return nil, err
+	}
+	before := mergedDays(published)
+
+	from := []string{models.WeekStatusInReview, models.WeekStatusRetracted}
+	updated, _, err := s.weekRepo.PublishWeek(week.ID, from, actor)
+	if err != nil {
+		return nil, err
+	}
+	if updated == nil {
+		return nil, newConflictError("week %s is %s, expected %s", week.ID, week.Status, strings.Join(from, " or "))
+	}
+
+	after, _, err := s.mealRepo.GetMealsData(week.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load week meals: %w", err)
+	}
+	changes := diffMenuItems(dayMealItems(before), dayMealItems(after), false)
+	// 이전 게시와 내용이 같으면 이벤트를 보내지 않습니다
+	if len(published) == 0 || changes.Added+changes.Removed+changes.Updated > 0 {
+		notifyWeekUploaded(s.mealRepo, s.listeners, week.ID, week.RestaurantType, before, changes)
+	}
+	return updated, nil
+}
+
+func mealsData(week *models.Week, days []*models.DayMeals, summary *models.MealsSummary) *models.RestaurantMealsData {
+	return &models.RestaurantMealsData{
+		Restaurant: string(week.RestaurantType),
+		Week: &models.WeekInfo{
+			ID:        week.ID,
+			StartDate: week.StartDate.Format("2006-01-02"),
+			EndDate:   week.EndDate.Format("2006-01-02"),
+			Status:    week.Status,
+		},
+		MealsByDay: days,
+		Summary:    summary,
+	}
+}
@@ -0,0 +1,353 @@
+package services
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/School-meal-lover/backend/internal/models"
+)
+
+// repository.WeekRepository와 같은 규칙으로 상태를 바꾸는 메모리 주차 저장소
+type memoryWeeks struct {
+	weeks map[string]*models.Week
+}
+
+func (m *memoryWeeks) GetWeek(id string) (*models.Week, error) {
+	if week, ok := m.weeks[id]; ok {
+		copied := *week
+		return &copied, nil
+	}
+	return nil, nil
+}
+
+func (m *memoryWeeks) ListWeeks(restaurant models.RestaurantType, status string, limit int) ([]*models.Week, error) {
+	var weeks []*models.Week
+	for _, week := range m.weeks {
+		if (restaurant == "" || week.RestaurantType == restaurant) && (status == "" || week.Status == status) {
+			copied := *week
+			weeks = append(weeks, &copied)
+		}
+	}
+	return weeks, nil
+}
+
+func (m *memoryWeeks) ListPublishedOverlapping(restaurant models.RestaurantType, start, end time.Time) ([]*models.Week, error) {
+	var weeks []*models.Week
+	for _, week := range m.weeks {
+		if week.RestaurantType == restaurant && week.Status == models.WeekStatusPublished &&
+			!week.StartDate.After(end) && !week.EndDate.Before(start) {
+			copied := *week
+			weeks = append(weeks, &copied)
+		}
+	}
+	slices.SortFunc(weeks, func(a, b *models.Week) int { return a.StartDate.Compare(b.StartDate) })
+	return weeks, nil
+}
+
+func (m *memoryWeeks) UpdateWeekStatus(id string, from []string, to, actor string) (*models.Week, error) {
+	week, ok := m.weeks[id]
+	if !ok || !slices.Contains(from, week.Status) {
+		return nil, nil
+	}
+	week.Status = to
+	week.StatusChangedBy = actor
+	copied := *week
+	return &copied, nil
+}
+
+func (m *memoryWeeks) PublishWeek(id string, from []string, actor string) (*models.Week, []string, error) {
+	published, err := m.UpdateWeekStatus(id, from, models.WeekStatusPublished, actor)
+	if err != nil || published == nil {
+		return nil, nil, err
+	}
+	var retracted []string
+	for _, week := range m.weeks {
+		if week.ID != id && week.RestaurantType == published.RestaurantType && week.Status == models.WeekStatusPublished &&
+			!week.StartDate.After(published.EndDate) && !week.EndDate.Before(published.StartDate) {
+			week.Status = models.WeekStatusRetracted
+			retracted = append(retracted, week.ID)
+		}
+	}
+	return published, retracted, nil
+}
+
+// 주차별 식단을 메모리에 두는 weekMealStore
+type memoryWeekMeals struct {
+	weeks *memoryWeeks
+	meals map[string][]*models.DayMeals
+}
+
+func (m *memoryWeekMeals) GetWeekByID(weekID string) (*models.WeekInfo, error) {
+	week, ok := m.weeks.weeks[weekID]
+	if !ok {
+		return nil, fmt.Errorf("week %s not found", weekID)
+	}
+	return &models.WeekInfo{ID: week.ID, StartDate: week.StartDate.Format("2006-01-02"), EndDate: week.EndDate.Format("2006-01-02"), Status: week.Status}, nil
+}
+
+func (m *memoryWeekMeals) GetMealsData(weekID string) ([]*models.DayMeals, *models.MealsSummary, error) {
+	days := m.meals[weekID]
+	return days, &models.MealsSummary{TotalDays: len(days)}, nil
+}
+
+type recordingListener struct {
+	events []*models.WeekUploadEvent
+}
+
+func (l *recordingListener) HandleWeekUploaded(event *models.WeekUploadEvent) {
+	l.events = append(l.events, event)
+}
+
+type testWeeks struct {
+	service  *WeekService
+	weeks    *memoryWeeks
+	meals    *memoryWeekMeals
+	listener *recordingListener
+}
+
+func newTestWeekService() *testWeeks {
+	weeks := &memoryWeeks{weeks: map[string]*models.Week{}}
+	meals := &memoryWeekMeals{weeks: weeks, meals: map[string][]*models.DayMeals{}}
+	listener := &recordingListener{}
+	return &testWeeks{
+		service:  &WeekService{mealRepo: meals, weekRepo: weeks, listeners: []WeekUploadListener{listener}},
+		weeks:    weeks,
+		meals:    meals,
+		listener: listener,
+	}
+}
+
+func testDate(value string) time.Time {
+	date, err := time.Parse("2006-01-02", value)
+	if err != nil {
+		panic(err)
+	}
+	return date
+}
+
+// 점심 메뉴가 있는 날의 식단을 가진 주차를 추가합니다 (menus: 날짜 → 메뉴 이름)
+func (w *testWeeks) add(id string, restaurant models.RestaurantType, status, start, end string, menus map[string][]string) *models.Week {
+	week := &models.Week{ID: id, RestaurantType: restaurant, Status: status, StartDate: testDate(start), EndDate: testDate(end)}
+	w.weeks.weeks[id] = week
+	var dates []string
+	for date := range menus {
+		dates = append(dates, date)
+	}
+	slices.Sort(dates)
+	for _, date := range dates {
+		meal := &models.MealInfo{MealType: "Lunch_2"}
+		for _, name := range menus[date] {
+			meal.MenuItems = append(meal.MenuItems, &models.MenuItemResponse{Category: "메인메뉴", Name: name})
+		}
+		w.meals.meals[id] = append(w.meals.meals[id], &models.DayMeals{Date: date, Meals: map[string]*models.MealInfo{"Lunch_2": meal}})
+	}
+	copied := *week
+	return &copied
+}
+
+func (w *testWeeks) status(id string) string {
+	return w.weeks.weeks[id].Status
+}
+
+func TestWeekTransitions(t *testing.T) {
+	type action func(*WeekService, *models.Week, string) (*models.Week, error)
+	actions := map[string]action{
+		"submit":  (*WeekService).Submit,
+		"reject":  (*WeekService).Reject,
+		"publish": (*WeekService).Publish,
+		"retract": (*WeekService).Retract,
+	}
+	tests := []struct {
+		from   string
+		action string
+		want   string // 빈 값이면 ConflictError
+	}{
+		{models.WeekStatusDraft, "submit", models.WeekStatusInReview},
+		{models.WeekStatusDraft, "reject", ""},
+		{models.WeekStatusDraft, "publish", ""},
+		{models.WeekStatusDraft, "retract", ""},
+		{models.WeekStatusInReview, "submit", ""},
+		{models.WeekStatusInReview, "reject", models.WeekStatusDraft},
+		{models.WeekStatusInReview, "publish", models.WeekStatusPublished},
+		{models.WeekStatusInReview, "retract", ""},
+		{models.WeekStatusPublished, "submit", ""},
+		{models.WeekStatusPublished, "reject", ""},
+		{models.WeekStatusPublished, "publish", ""},
+		{models.WeekStatusPublished, "retract", models.WeekStatusRetracted},
+		{models.WeekStatusRetracted, "submit", ""},
+		{models.WeekStatusRetracted, "reject", ""},
+		{models.WeekStatusRetracted, "publish", models.WeekStatusPublished},
+		{models.WeekStatusRetracted, "retract", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.from+" "+tt.action, func(t *testing.T) {
+			w := newTestWeekService()
+			week := w.add("week", models.Restaurant1, tt.from, "2025-05-26", "2025-05-30", nil)
+
+			updated, err := actions[tt.action](w.service, week, "approver@example.ac.kr")
+			if tt.want == "" {
+				if !IsConflictError(err) {
+					t.Fatalf("err = %v, want a conflict", err)
+				}
+				if got := w.status("week"); got != tt.from {
+					t.Errorf("status changed to %s after a rejected transition", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if updated.Status != tt.want || w.status("week") != tt.want {
+				t.Errorf("status = %s (stored %s), want %s", updated.Status, w.status("week"), tt.want)
+			}
+			if updated.StatusChangedBy != "approver@example.ac.kr" {
+				t.Errorf("status changed by %q", updated.StatusChangedBy)
+			}
+		})
+	}
+}
+
+func TestPublishRetractsOverlappingWeeks(t *testing.T) {
+	w := newTestWeekService()
+	// 월~화 부분 주차와 공휴일 다음 수요일에 시작한 주차가 게시되어 있고, 새 주차는 두 주차와 모두 겹칩니다
+	w.add("monday", models.Restaurant1, models.WeekStatusPublished, "2025-05-26", "2025-05-27",
+		map[string][]string{"2025-05-26": {"돈까스"}, "2025-05-27": {"제육볶음"}})
+	w.add("wednesday", models.Restaurant1, models.WeekStatusPublished, "2025-05-28", "2025-05-30",
+		map[string][]string{"2025-05-28": {"카레"}})
+	w.add("next", models.Restaurant1, models.WeekStatusPublished, "2025-06-02", "2025-06-06", nil)
+	w.add("other", models.Restaurant2, models.WeekStatusPublished, "2025-05-26", "2025-06-01", nil)
+	draft := w.add("draft", models.Restaurant1, models.WeekStatusInReview, "2025-05-26", "2025-05-30",
+		map[string][]string{"2025-05-26": {"돈까스"}, "2025-05-28": {"짜장면"}})
+
+	if _, err := w.service.Publish(draft, "approver"); err != nil {
+		t.Fatal(err)
+	}
+
+	want := map[string]string{
+		"draft":     models.WeekStatusPublished,
+		"monday":    models.WeekStatusRetracted,
+		"wednesday": models.WeekStatusRetracted,
+		"next":      models.WeekStatusPublished,
+		"other":     models.WeekStatusPublished,
+	}
+	for id, status := range want {
+		if got := w.status(id); got != status {
+			t.Errorf("%s: status = %s, want %s", id, got, status)
+		}
+	}
+
+	if len(w.listener.events) != 1 {
+		t.Fatalf("got %d upload events, want 1", len(w.listener.events))
+	}
+	changes := w.listener.events[0].Changes
+	if changes.Added != 1 || changes.Removed != 2 || changes.Updated != 0 {
+		t.Errorf("changes = +%d -%d ~%d, want +1 -2 ~0: %+v", changes.Added, changes.Removed, changes.Updated, changes.Items)
+	}
+	if got := len(w.listener.events[0].Before); got != 3 {
+		t.Errorf("event has %d days before publishing, want 3 (both retracted weeks)", got)
+	}
+}
+
+func TestPublishWithoutChangesSendsNoEvent(t *testing.T) {
+	w := newTestWeekService()
+	menus := map[string][]string{"2025-05-26": {"돈까스"}}
+	w.add("published", models.Restaurant1, models.WeekStatusPublished, "2025-05-26", "2025-05-30", menus)
+	draft := w.add("draft", models.Restaurant1, models.WeekStatusInReview, "2025-05-26", "2025-05-30", menus)
+
+	if _, err := w.service.Publish(draft, "approver"); err != nil {
+		t.Fatal(err)
+	}
+	if w.status("published") != models.WeekStatusRetracted {
+		t.Errorf("previous week is %s, want retracted", w.status("published"))
+	}
+	if len(w.listener.events) != 0 {
+		t.Errorf("sent %d events for an unchanged week", len(w.listener.events))
+	}
+
+	// 처음 게시하는 주차는 내용과 관계없이 이벤트를 보냅니다
+	first := w.add("first", models.Restaurant2, models.WeekStatusInReview, "2025-05-26", "2025-06-01", nil)
+	if _, err := w.service.Publish(first, "approver"); err != nil {
+		t.Fatal(err)
+	}
+	if len(w.listener.events) != 1 {
+		t.Errorf("sent %d events for a first publish, want 1", len(w.listener.events))
+	}
+}
+
+func TestPreviewMergesOverlappingPublishedWeeks(t *testing.T) {
+	w := newTestWeekService()
+	w.add("monday", models.Restaurant1, models.WeekStatusPublished, "2025-05-26", "2025-05-27",
+		map[string][]string{"2025-05-26": {"돈까스"}, "2025-05-27": {"제육볶음"}})
+	w.add("wednesday", models.Restaurant1, models.WeekStatusPublished, "2025-05-28", "2025-05-30",
+		map[string][]string{"2025-05-28": {"카레"}})
+	draft := w.add("draft", models.Restaurant1, models.WeekStatusDraft, "2025-05-26", "2025-05-30",
+		map[string][]string{"2025-05-26": {"돈까스"}, "2025-05-28": {"카레", "짜장면"}})
+
+	preview, err := w.service.Preview(draft)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var published []string
+	for _, week := range preview.Published {
+		published = append(published, week.Week.ID)
+	}
+	if !slices.Equal(published, []string{"monday", "wednesday"}) {
+		t.Errorf("published = %v, want [monday wednesday]", published)
+	}
+	var changes []string
+	for _, item := range preview.Changes.Items {
+		changes = append(changes, item.Change+" "+item.Date+" "+item.Name)
+	}
+	want := []string{"removed 2025-05-27 제육볶음", "added 2025-05-28 짜장면"}
+	if !slices.Equal(changes, want) {
+		t.Errorf("changes = %v, want %v", changes, want)
+	}
+
+	// 게시된 주차의 미리보기는 비교할 이전 게시가 없습니다
+	preview, err = w.service.Preview(w.add("live", models.Restaurant2, models.WeekStatusPublished, "2025-05-26", "2025-06-01", nil))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(preview.Published) != 0 || preview.Changes.Added+preview.Changes.Removed+preview.Changes.Updated != 0 {
+		t.Errorf("published week preview = %+v, %+v", preview.Published, preview.Changes)
+	}
+}
+
+func TestDiffMenuItemsUpload(t *testing.T) {
+	before := []*models.DayMeals{{Date: "2025-05-26", Meals: map[string]*models.MealInfo{
+		"Lunch_2": {MenuItems: []*models.MenuItemResponse{
+			{Category: "밥", Name: "밥", NameEn: "Rice"},
+			{Category: "메인메뉴", Name: "돈까스", NameEn: "Pork Cutlet", Allergens: []string{"1", "2"}},
+			{Category: "반찬", Name: "김치"},
+		}},
+		"Dinner": {MenuItems: []*models.MenuItemResponse{{Category: "메인메뉴", Name: "카레"}}},
+	}}}
+	menu := &models.WeekMenu{Days: []*models.DayMenu{{Date: testDate("2025-05-26"), Meals: []*models.MealMenu{{
+		MealType: "Lunch_2",
+		Items: []models.MenuItem{
+			// 비어 있는 영어 이름과 nil 알레르기 정보는 기존 값을 유지합니다
+			{Category: "밥", Name: "밥"},
+			{Category: "메인메뉴", Name: "돈까스", Price: 5000},
+			{Category: "국", Name: "미역국"},
+		},
+	}}}}}
+
+	changes := diffMenuItems(dayMealItems(before), weekMenuItems(menu), true)
+	var got []string
+	for _, item := range changes.Items {
+		got = append(got, item.Change+" "+item.MealType+" "+item.Name)
+	}
+	// 업로드에 없는 저녁은 비교하지 않습니다
+	want := []string{"updated Lunch_2 돈까스", "added Lunch_2 미역국", "removed Lunch_2 김치"}
+	if !slices.Equal(got, want) {
+		t.Errorf("changes = %v, want %v", got, want)
+	}
+
+	// 주차끼리 비교하면 영어 이름이 지워진 것도, 한쪽에만 있는 식사도 변경입니다
+	changes = diffMenuItems(dayMealItems(before), weekMenuItems(menu), false)
+	if changes.Updated != 2 || changes.Removed != 2 || changes.Added != 1 {
+		t.Errorf("full diff = +%d -%d ~%d, want +1 -2 ~2", changes.Added, changes.Removed, changes.Updated)
+	}
+}
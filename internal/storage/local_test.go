@@ -0,0 +1,88 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestLocalStore(t *testing.T) {
+	root := filepath.Join(t.TempDir(), "blobs")
+	store, err := NewLocalStore(root)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+
+	tests := []struct {
+		key         string
+		contentType string
+	}{
+		{"images/2025/menu.jpg", "image/jpeg"},
+		{"images/2025/menu_thumb.png", "image/png"},
+		{"uploads/sha256/abcdef", "application/octet-stream"},
+	}
+	for _, tt := range tests {
+		if err := store.Put(ctx, tt.key, []byte("old "+tt.key), ""); err != nil {
+			t.Fatal(err)
+		}
+		// 같은 키는 덮어씁니다
+		if err := store.Put(ctx, tt.key, []byte(tt.key), ""); err != nil {
+			t.Fatal(err)
+		}
+		r, contentType, err := store.Get(ctx, tt.key)
+		if err != nil {
+			t.Fatal(err)
+		}
+		data, _ := io.ReadAll(r)
+		r.Close()
+		if string(data) != tt.key || contentType != tt.contentType {
+			t.Errorf("Get(%s) = %q, %q, want %q, %q", tt.key, data, contentType, tt.key, tt.contentType)
+		}
+	}
+
+	// 임시 파일이 남지 않습니다
+	entries, err := os.ReadDir(filepath.Join(root, "images", "2025"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 2 {
+		t.Errorf("images/2025 has %d entries, want 2", len(entries))
+	}
+
+	if err := store.Delete(ctx, "images/2025/menu.jpg"); err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := store.Get(ctx, "images/2025/menu.jpg"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Get after Delete err = %v, want ErrNotFound", err)
+	}
+	if err := store.Delete(ctx, "images/2025/menu.jpg"); err != nil {
+		t.Errorf("Delete missing = %v", err)
+	}
+}
+
+func TestLocalStoreInvalidKeys(t *testing.T) {
+	dir := t.TempDir()
+	store, err := NewLocalStore(filepath.Join(dir, "blobs"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+	for _, key := range []string{"", "/etc/passwd", "../outside", "images/../../outside", "images//a", "./a", `images\a`} {
+		if err := store.Put(ctx, key, []byte("x"), ""); err == nil {
+			t.Errorf("Put(%q) succeeded, want an invalid key error", key)
+		}
+		if _, _, err := store.Get(ctx, key); err == nil || errors.Is(err, ErrNotFound) {
+			t.Errorf("Get(%q) err = %v, want an invalid key error", key, err)
+		}
+		if err := store.Delete(ctx, key); err == nil {
+			t.Errorf("Delete(%q) succeeded, want an invalid key error", key)
+		}
+	}
+	if _, err := os.Stat(filepath.Join(dir, "outside")); !os.IsNotExist(err) {
+		t.Errorf("file written outside the storage root: %v", err)
+	}
+}
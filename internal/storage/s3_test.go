@@ -0,0 +1,200 @@
+package storage
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+const (
+	testAccessKey = "AKIDEXAMPLE"
+	testSecretKey = "wJalrXUtnFEMI/K7MDENG+bPxRfiCYEXAMPLEKEY"
+)
+
+var authorizationPattern = regexp.MustCompile(`^AWS4-HMAC-SHA256 Credential=([^/]+)/(\d{8})/([^/]+)/s3/aws4_request, SignedHeaders=([^,]+), Signature=([0-9a-f]{64})$`)
+
+// 받은 요청으로 SigV4 서명을 다시 계산해서 확인하는 S3 호환 서버 (path-style, 메모리 저장)
+type fakeS3 struct {
+	t       *testing.T
+	mu      sync.Mutex
+	objects map[string]fakeObject
+	paths   []string
+}
+
+type fakeObject struct {
+	data        []byte
+	contentType string
+}
+
+func (f *fakeS3) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, _ := io.ReadAll(r.Body)
+	if err := f.verify(r, body); err != "" {
+		f.t.Errorf("%s %s: %s", r.Method, r.RequestURI, err)
+		http.Error(w, err, http.StatusForbidden)
+		return
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	path := strings.SplitN(r.RequestURI, "?", 2)[0]
+	f.paths = append(f.paths, r.Method+" "+path)
+	switch r.Method {
+	case http.MethodPut:
+		if strings.HasSuffix(path, "/fail") {
+			http.Error(w, "<Error><Code>InternalError</Code></Error>", http.StatusInternalServerError)
+			return
+		}
+		f.objects[path] = fakeObject{data: body, contentType: r.Header.Get("Content-Type")}
+	case http.MethodGet:
+		object, ok := f.objects[path]
+		if !ok {
+			http.Error(w, "<Error><Code>NoSuchKey</Code></Error>", http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", object.contentType)
+		w.Write(object.data)
+	case http.MethodDelete:
+		delete(f.objects, path)
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+func (f *fakeS3) verify(r *http.Request, body []byte) string {
+	match := authorizationPattern.FindStringSubmatch(r.Header.Get("Authorization"))
+	if match == nil {
+		return "malformed authorization header: " + r.Header.Get("Authorization")
+	}
+	accessKey, date, region, signedHeaders, signature := match[1], match[2], match[3], match[4], match[5]
+	if accessKey != testAccessKey || region != "ap-northeast-2" {
+		return "unexpected credential scope"
+	}
+	amzDate := r.Header.Get("X-Amz-Date")
+	if amzDate != "20250526T090000Z" || !strings.HasPrefix(amzDate, date) {
+		return "unexpected date " + amzDate
+	}
+	payloadHash := sha256.Sum256(body)
+	if r.Header.Get("X-Amz-Content-Sha256") != hex.EncodeToString(payloadHash[:]) {
+		return "payload hash does not match the body"
+	}
+
+	// 전송된 그대로의 경로로 canonical request를 만듭니다
+	var canonicalHeaders strings.Builder
+	for _, name := range strings.Split(signedHeaders, ";") {
+		value := r.Header.Get(name)
+		if name == "host" {
+			value = r.Host
+		}
+		canonicalHeaders.WriteString(name + ":" + strings.TrimSpace(value) + "\n")
+	}
+	canonicalRequest := strings.Join([]string{
+		r.Method,
+		strings.SplitN(r.RequestURI, "?", 2)[0],
+		"",
+		canonicalHeaders.String(),
+		signedHeaders,
+		r.Header.Get("X-Amz-Content-Sha256"),
+	}, "\n")
+	requestHash := sha256.Sum256([]byte(canonicalRequest))
+	stringToSign := strings.Join([]string{
+		"AWS4-HMAC-SHA256", amzDate, date + "/" + region + "/s3/aws4_request", hex.EncodeToString(requestHash[:]),
+	}, "\n")
+	expected := hex.EncodeToString(hmacSHA256(signingKey(testSecretKey, date, region, "s3"), stringToSign))
+	if signature != expected {
+		return "signature does not match"
+	}
+	return ""
+}
+
+func newTestS3Store(t *testing.T) (*S3Store, *fakeS3) {
+	t.Helper()
+	fake := &fakeS3{t: t, objects: map[string]fakeObject{}}
+	server := httptest.NewServer(fake)
+	t.Cleanup(server.Close)
+
+	store, err := NewS3Store(S3Config{
+		Endpoint:        server.URL + "/",
+		Region:          "ap-northeast-2",
+		Bucket:          "menus",
+		AccessKeyID:     testAccessKey,
+		SecretAccessKey: testSecretKey,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	store.now = func() time.Time { return time.Date(2025, time.May, 26, 18, 0, 0, 0, time.FixedZone("KST", 9*60*60)) }
+	return store, fake
+}
+
+// AWS 문서의 서명 키 예시 (secret, 20120215, us-east-1, iam)
+func TestSigningKey(t *testing.T) {
+	got := hex.EncodeToString(signingKey(testSecretKey, "20120215", "us-east-1", "iam"))
+	if want := "f4780e2d9f65fa895f9c67b32ce1baf0b0d8a43505a000a1a9e090d414db404d"; got != want {
+		t.Errorf("signingKey = %s, want %s", got, want)
+	}
+}
+
+func TestS3Store(t *testing.T) {
+	store, fake := newTestS3Store(t)
+	ctx := context.Background()
+
+	// 공백과 한글이 있는 키도 서명한 경로 그대로 보냅니다
+	key := "images/2025/식단 사진(1).jpg"
+	if err := store.Put(ctx, key, []byte("jpeg data"), "image/jpeg"); err != nil {
+		t.Fatal(err)
+	}
+	r, contentType, err := store.Get(ctx, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, _ := io.ReadAll(r)
+	r.Close()
+	if string(data) != "jpeg data" || contentType != "image/jpeg" {
+		t.Errorf("Get = %q, %q", data, contentType)
+	}
+	if err := store.Delete(ctx, key); err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := store.Get(ctx, key); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Get after Delete err = %v, want ErrNotFound", err)
+	}
+	// 없는 키를 지워도 오류가 아닙니다
+	if err := store.Delete(ctx, "images/missing.jpg"); err != nil {
+		t.Errorf("Delete missing = %v", err)
+	}
+
+	want := "PUT /menus/images/2025/%EC%8B%9D%EB%8B%A8%20%EC%82%AC%EC%A7%84%281%29.jpg"
+	if len(fake.paths) == 0 || fake.paths[0] != want {
+		t.Errorf("first request = %v, want %s", fake.paths, want)
+	}
+}
+
+func TestS3StoreErrors(t *testing.T) {
+	store, fake := newTestS3Store(t)
+	ctx := context.Background()
+
+	err := store.Put(ctx, "uploads/fail", []byte("x"), "")
+	if err == nil || !strings.Contains(err.Error(), "status 500") || !strings.Contains(err.Error(), "InternalError") {
+		t.Errorf("Put err = %v, want the S3 status and body", err)
+	}
+	for _, key := range []string{"", "/abs", "../x", "a//b", `a\b`} {
+		if err := store.Put(ctx, key, nil, ""); err == nil {
+			t.Errorf("Put(%q) succeeded, want an invalid key error", key)
+		}
+	}
+	if len(fake.paths) != 1 {
+		t.Errorf("sent %v, want only the failing put", fake.paths)
+	}
+
+	if _, err := NewS3Store(S3Config{Bucket: "menus"}); err == nil {
+		t.Error("NewS3Store without credentials succeeded")
+	}
+}
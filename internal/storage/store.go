@@ -0,0 +1,55 @@
+// storage는 식단 사진 같은 업로드 파일을 저장하는 BlobStore와 그 구현체를 제공합니다.
+package storage
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log"
+	"os"
+	"strings"
+)
+
+var ErrNotFound = errors.New("blob not found")
+
+// BlobStore는 키 단위로 파일을 저장하고 읽는 저장소입니다
+type BlobStore interface {
+	Put(ctx context.Context, key string, data []byte, contentType string) error
+	// 호출한 쪽에서 반드시 Close 해야 합니다
+	Get(ctx context.Context, key string) (io.ReadCloser, string, error)
+	Delete(ctx context.Context, key string) error
+}
+
+// 환경변수 설정에 따라 BlobStore를 구성합니다
+// S3_BUCKET이 설정되어 있으면 S3 호환 저장소, 아니면 로컬 디스크를 사용합니다
+func NewBlobStoreFromEnv() (BlobStore, error) {
+	if bucket := os.Getenv("S3_BUCKET"); bucket != "" {
+		return NewS3Store(S3Config{
+			Endpoint:        os.Getenv("S3_ENDPOINT"),
+			Region:          os.Getenv("S3_REGION"),
+			Bucket:          bucket,
+			AccessKeyID:     os.Getenv("S3_ACCESS_KEY_ID"),
+			SecretAccessKey: os.Getenv("S3_SECRET_ACCESS_KEY"),
+		})
+	}
+
+	dir := os.Getenv("STORAGE_DIR")
+	if dir == "" {
+		dir = "./data/blobs"
+	}
+	log.Printf("S3_BUCKET not set; storing uploaded files on local disk at %s", dir)
+	return NewLocalStore(dir)
+}
+
+// 경로 조작(.., 절대 경로)을 막기 위한 키 검증
+func validKey(key string) bool {
+	if key == "" || strings.HasPrefix(key, "/") || strings.Contains(key, "\\") {
+		return false
+	}
+	for _, part := range strings.Split(key, "/") {
+		if part == "" || part == "." || part == ".." {
+			return false
+		}
+	}
+	return true
+}
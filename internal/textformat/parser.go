@@ -0,0 +1,501 @@
+package textformat
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/School-meal-lover/backend/internal/models"
+)
+
+// 텍스트 식단 형식
+//
+//	#format v2              형식 버전 (생략하면 v1)
+//	RESTAURANT_1            식당
+//	2025-05-26              주차 시작 날짜
+//	Monday 2025-05-26       요일 + 날짜
+//	Breakfast               식사 종류 (식당의 meal_types 코드: Breakfast, Lunch_1, Lunch_2, Dinner 등)
+//	밥                      메뉴 (한 줄에 하나)
+//	...
+//
+// 빈 줄은 무시합니다. v2에서는 메뉴 줄에 영어 이름과 속성을 붙일 수 있고 '#'로 시작하는 줄은 주석입니다.
+//
+//	돈까스 | Pork Cutlet ; category=메인메뉴 ; price=5000 ; allergens=1,2,5,6,10
+
+const (
+	Version1 = 1
+	Version2 = 2
+
+	formatHeaderPrefix = "#format"
+	// 식약처 알레르기 유발 식품 표시 번호 (1 난류 ~ 19 잣)
+	maxAllergenNumber = 19
+)
+
+// 파싱된 한 주 식단
+type Menu struct {
+	Version    int
+	Restaurant models.RestaurantType
+	WeekStart  time.Time
+	Days       []*Day
+}
+
+type Day struct {
+	Line      int
+	Date      time.Time
+	DayOfWeek string
+	Meals     []*Meal
+}
+
+type Meal struct {
+	Line     int
+	MealType string
+	Items    []Item
+}
+
+type Item struct {
+	Line   int
+	Name   string
+	NameEn string
+	// 비어 있으면 가져올 때 메뉴 이름으로 분류합니다
+	Category  string
+	Price     *float64
+	Allergens []string
+}
+
+// 가져오기에 쓰는 공통 식단 구조로 변환 (가격이 없으면 0)
+func (m *Menu) WeekMenu() *models.WeekMenu {
+	weekMenu := &models.WeekMenu{Restaurant: m.Restaurant, WeekStart: m.WeekStart}
+	for _, day := range m.Days {
+		dayMenu := &models.DayMenu{Date: day.Date, DayOfWeek: day.DayOfWeek}
+		for _, meal := range day.Meals {
+			mealMenu := &models.MealMenu{MealType: meal.MealType}
+			for _, item := range meal.Items {
+				menuItem := models.MenuItem{
+					Category:  item.Category,
+					Name:      item.Name,
+					NameEn:    item.NameEn,
+					Allergens: item.Allergens,
+				}
+				if item.Price != nil {
+					menuItem.Price = *item.Price
+				}
+				mealMenu.Items = append(mealMenu.Items, menuItem)
+			}
+			dayMenu.Meals = append(dayMenu.Meals, mealMenu)
+		}
+		weekMenu.Days = append(weekMenu.Days, dayMenu)
+	}
+	return weekMenu
+}
+
+// 파싱 오류가 있으면 반환되는 오류 (같은 텍스트의 경고도 줄 순서대로 함께 담습니다)
+type Error struct {
+	Diagnostics []models.ParseDiagnostic
+}
+
+func (e *Error) Error() string {
+	var errs []models.ParseDiagnostic
+	for _, diagnostic := range e.Diagnostics {
+		if diagnostic.Severity == models.DiagnosticError {
+			errs = append(errs, diagnostic)
+		}
+	}
+	if len(errs) == 0 {
+		return "invalid format"
+	}
+	if len(errs) == 1 {
+		return fmt.Sprintf("%s: %s", location(errs[0]), errs[0].Message)
+	}
+	return fmt.Sprintf("%d errors (first at %s: %s)", len(errs), location(errs[0]), errs[0].Message)
+}
+
+// 텍스트는 줄 번호, JSON/YAML 문서는 경로
+func location(diagnostic models.ParseDiagnostic) string {
+	if diagnostic.Line > 0 {
+		return fmt.Sprintf("line %d", diagnostic.Line)
+	}
+	return diagnostic.Path
+}
+
+var weekdays = map[string]time.Weekday{
+	"sunday": time.Sunday, "monday": time.Monday, "tuesday": time.Tuesday, "wednesday": time.Wednesday,
+	"thursday": time.Thursday, "friday": time.Friday, "saturday": time.Saturday,
+	"sun": time.Sunday, "mon": time.Monday, "tue": time.Tuesday, "wed": time.Wednesday,
+	"thu": time.Thursday, "fri": time.Friday, "sat": time.Saturday,
+	"일요일": time.Sunday, "월요일": time.Monday, "화요일": time.Tuesday, "수요일": time.Wednesday,
+	"목요일": time.Thursday, "금요일": time.Friday, "토요일": time.Saturday,
+}
+
+var (
+	// "Monday 2025-05-26"
+	dayHeaderPattern = regexp.MustCompile(`^(\S+)\s+(\d{4}-\d{2}-\d{2})$`)
+	// 식사 종류처럼 보이는 줄 (영문 단어, 밑줄 번호 허용): 알 수 없는 종류를 메뉴로 저장하지 않기 위함
+	mealHeaderPattern = regexp.MustCompile(`^(?i)(breakfast|brunch|lunch|dinner|supper)(_\w+)?$|^[A-Za-z]+_\d+$`)
+)
+
+// 첫 줄의 식당 정보
+func ParseRestaurant(line string) (models.RestaurantType, error) {
+	restaurantLine := strings.TrimSpace(line)
+	switch strings.ToUpper(restaurantLine) {
+	case string(models.Restaurant1):
+		return models.Restaurant1, nil
+	case string(models.Restaurant2):
+		return models.Restaurant2, nil
+	}
+	return "", fmt.Errorf("invalid restaurant type: %s (expected RESTAURANT_1 or RESTAURANT_2)", restaurantLine)
+}
+
+// 본문은 파싱하지 않고 식당 줄만 읽습니다 (버전 줄과 v2 주석은 Parse와 같이 건너뜁니다)
+// 식당 줄이 없거나 잘못되면 *Error를 반환합니다
+func DetectRestaurant(text string) (models.RestaurantType, error) {
+	p := &parser{menu: &Menu{Version: Version1}}
+	seenContent := false
+	for i, raw := range strings.Split(strings.ReplaceAll(text, "\r\n", "\n"), "\n") {
+		lineNo := i + 1
+		line := strings.TrimSpace(raw)
+		if line == "" {
+			continue
+		}
+		if !seenContent && strings.HasPrefix(strings.ToLower(line), formatHeaderPrefix) {
+			p.parseFormatHeader(lineNo, line)
+			if len(p.diagnostics) > 0 {
+				return "", &Error{Diagnostics: p.diagnostics}
+			}
+			seenContent = true
+			continue
+		}
+		seenContent = true
+		if p.menu.Version >= Version2 && strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		restaurant, err := ParseRestaurant(line)
+		if err != nil {
+			p.errorf(lineNo, "%v", err)
+			return "", &Error{Diagnostics: p.diagnostics}
+		}
+		return restaurant, nil
+	}
+	p.errorf(1, "missing restaurant line")
+	return "", &Error{Diagnostics: p.diagnostics}
+}
+
+type parser struct {
+	mealTypes   models.MealTypeCodes
+	menu        *Menu
+	diagnostics []models.ParseDiagnostic
+	day         *Day
+	meal        *Meal
+	seenDates   map[string]int
+	// 잘못된 머리줄 뒤의 메뉴는 오류를 반복해서 내지 않도록 다음 머리줄까지 건너뜁니다
+	skipping bool
+	// 날짜가 잘못된 요일 아래의 식사 머리줄도 다음 요일까지 건너뜁니다
+	skippingDay bool
+}
+
+func (p *parser) errorf(line int, format string, args ...interface{}) {
+	p.diagnostics = append(p.diagnostics, models.ParseDiagnostic{
+		Line: line, Severity: models.DiagnosticError, Message: fmt.Sprintf(format, args...),
+	})
+}
+
+func (p *parser) warnf(line int, format string, args ...interface{}) {
+	p.diagnostics = append(p.diagnostics, models.ParseDiagnostic{
+		Line: line, Severity: models.DiagnosticWarning, Message: fmt.Sprintf(format, args...),
+	})
+}
+
+// 텍스트를 파싱해서 식단과 경고를 반환합니다. 오류가 하나라도 있으면 *Error를 반환합니다
+// mealTypes는 식당별로 허용하는 식사 종류 코드입니다
+func Parse(text string, mealTypes models.MealTypeCodes) (*Menu, []models.ParseDiagnostic, error) {
+	p := &parser{mealTypes: mealTypes, menu: &Menu{Version: Version1}, seenDates: map[string]int{}}
+	lines := strings.Split(strings.ReplaceAll(text, "\r\n", "\n"), "\n")
+
+	// 앞의 두 줄(빈 줄, 버전 줄 제외)은 식당과 주차 시작 날짜입니다
+	header := 0
+	seenContent := false
+	for i, raw := range lines {
+		lineNo := i + 1
+		line := strings.TrimSpace(raw)
+		if line == "" {
+			continue
+		}
+		if strings.HasPrefix(strings.ToLower(line), formatHeaderPrefix) {
+			if seenContent {
+				p.errorf(lineNo, "format header must be the first line")
+			} else {
+				p.parseFormatHeader(lineNo, line)
+			}
+			seenContent = true
+			continue
+		}
+		seenContent = true
+		if p.menu.Version >= Version2 && strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		switch header {
+		case 0:
+			header++
+			restaurant, err := ParseRestaurant(line)
+			if err != nil {
+				p.errorf(lineNo, "%v", err)
+			}
+			p.menu.Restaurant = restaurant
+			continue
+		case 1:
+			header++
+			weekStart, err := time.Parse("2006-01-02", line)
+			if err != nil {
+				p.errorf(lineNo, "invalid week start date: %s (expected YYYY-MM-DD)", line)
+				continue
+			}
+			p.menu.WeekStart = weekStart
+			if weekStart.Weekday() != time.Monday {
+				p.warnf(lineNo, "week start date %s is a %s, not a Monday", line, weekStart.Weekday())
+			}
+			continue
+		}
+
+		if match := dayHeaderPattern.FindStringSubmatch(line); match != nil {
+			p.parseDay(lineNo, match[1], match[2])
+			continue
+		}
+		if p.isMealHeader(line) {
+			p.parseMeal(lineNo, line)
+			continue
+		}
+		p.parseItem(lineNo, line)
+	}
+
+	switch header {
+	case 0:
+		p.errorf(1, "missing restaurant line")
+	case 1:
+		p.errorf(len(lines), "missing week start date line")
+	}
+	p.finishDay()
+	if header == 2 && len(p.menu.Days) == 0 {
+		p.errorf(len(lines), "no days found (expected a line like \"Monday 2025-05-26\")")
+	}
+
+	sort.SliceStable(p.diagnostics, func(i, j int) bool {
+		return p.diagnostics[i].Line < p.diagnostics[j].Line
+	})
+	for _, diagnostic := range p.diagnostics {
+		if diagnostic.Severity == models.DiagnosticError {
+			return nil, nil, &Error{Diagnostics: p.diagnostics}
+		}
+	}
+	return p.menu, p.diagnostics, nil
+}
+
+func (p *parser) parseDay(lineNo int, dayName, dateText string) {
+	p.finishDay()
+
+	date, err := time.Parse("2006-01-02", dateText)
+	if err != nil {
+		p.errorf(lineNo, "invalid date: %s (expected YYYY-MM-DD)", dateText)
+		p.skipping = true
+		p.skippingDay = true
+		return
+	}
+	p.skipping = false
+	p.skippingDay = false
+
+	weekday, ok := weekdays[strings.ToLower(dayName)]
+	if !ok {
+		p.errorf(lineNo, "unknown weekday: %s", dayName)
+	} else if weekday != date.Weekday() {
+		p.errorf(lineNo, "%s is a %s, not %s", dateText, date.Weekday(), dayName)
+	}
+
+	if !p.menu.WeekStart.IsZero() {
+		weekEnd := p.menu.WeekStart.AddDate(0, 0, 6)
+		if date.Before(p.menu.WeekStart) || date.After(weekEnd) {
+			p.errorf(lineNo, "%s is outside the declared week (%s to %s)", dateText,
+				p.menu.WeekStart.Format("2006-01-02"), weekEnd.Format("2006-01-02"))
+		}
+	}
+
+	if previous, ok := p.seenDates[dateText]; ok {
+		p.errorf(lineNo, "duplicate day %s (first declared at line %d)", dateText, previous)
+	}
+	p.seenDates[dateText] = lineNo
+
+	p.day = &Day{Line: lineNo, Date: date, DayOfWeek: dayName}
+}
+
+func (p *parser) parseMeal(lineNo int, line string) {
+	p.finishMeal()
+	p.skipping = true
+	if p.skippingDay {
+		return
+	}
+
+	mealType, ok := p.lookupMealType(line)
+	if !ok {
+		p.errorf(lineNo, "unknown meal type: %s (expected %s)", line, strings.Join(p.restaurantMealTypes(), ", "))
+		return
+	}
+	if p.day == nil {
+		p.errorf(lineNo, "meal header %s before any day header", line)
+		return
+	}
+	for _, meal := range p.day.Meals {
+		if meal.MealType == mealType {
+			p.errorf(lineNo, "duplicate meal type %s on %s (first declared at line %d)", mealType, p.day.Date.Format("2006-01-02"), meal.Line)
+			return
+		}
+	}
+	p.skipping = false
+	p.meal = &Meal{Line: lineNo, MealType: mealType}
+}
+
+// 식당을 모르면(첫 줄 오류) 모든 식당의 코드를 허용합니다
+func (p *parser) restaurantMealTypes() []string {
+	if codes, ok := p.mealTypes[p.menu.Restaurant]; ok {
+		return codes
+	}
+	return p.mealTypes.All()
+}
+
+// 대소문자를 무시하고 저장할 코드를 찾습니다
+func (p *parser) lookupMealType(line string) (string, bool) {
+	for _, code := range p.restaurantMealTypes() {
+		if strings.EqualFold(code, line) {
+			return code, true
+		}
+	}
+	return "", false
+}
+
+// 다른 식당의 코드도 머리줄로 보고 오류를 냅니다 (메뉴로 저장되지 않도록)
+func (p *parser) isMealHeader(line string) bool {
+	if mealHeaderPattern.MatchString(line) {
+		return true
+	}
+	for _, code := range p.mealTypes.All() {
+		if strings.EqualFold(code, line) {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *parser) parseItem(lineNo int, line string) {
+	if p.skipping {
+		return
+	}
+	if p.day == nil {
+		p.errorf(lineNo, "menu item %q before any day header", line)
+		return
+	}
+	if p.meal == nil {
+		p.errorf(lineNo, "menu item %q before any meal header", line)
+		return
+	}
+	item := Item{Line: lineNo, Name: line}
+	if p.menu.Version >= Version2 {
+		item = p.parseItemV2(lineNo, line)
+	}
+	p.meal.Items = append(p.meal.Items, item)
+}
+
+// "#format v2"
+func (p *parser) parseFormatHeader(lineNo int, line string) {
+	version := strings.TrimSpace(line[len(formatHeaderPrefix):])
+	version = strings.TrimPrefix(strings.TrimSpace(strings.TrimPrefix(version, ":")), "v")
+	switch version {
+	case "1":
+		p.menu.Version = Version1
+	case "2":
+		p.menu.Version = Version2
+	default:
+		p.errorf(lineNo, "unsupported format version: %s (expected v1 or v2)", line)
+	}
+}
+
+// "한글 이름 | English name ; key=value ; ..."
+func (p *parser) parseItemV2(lineNo int, line string) Item {
+	parts := strings.Split(line, ";")
+	name, nameEn, _ := strings.Cut(parts[0], "|")
+	item := Item{Line: lineNo, Name: strings.TrimSpace(name), NameEn: strings.TrimSpace(nameEn)}
+	if item.Name == "" {
+		p.errorf(lineNo, "menu item has no Korean name")
+	}
+
+	for _, annotation := range parts[1:] {
+		annotation = strings.TrimSpace(annotation)
+		if annotation == "" {
+			continue
+		}
+		key, value, ok := strings.Cut(annotation, "=")
+		key, value = strings.ToLower(strings.TrimSpace(key)), strings.TrimSpace(value)
+		if !ok || value == "" {
+			p.errorf(lineNo, "invalid annotation %q (expected key=value)", annotation)
+			continue
+		}
+
+		switch key {
+		case "category":
+			item.Category = value
+		case "price":
+			price, err := strconv.ParseFloat(strings.ReplaceAll(value, ",", ""), 64)
+			if err != nil || price < 0 {
+				p.errorf(lineNo, "invalid price: %s", value)
+				continue
+			}
+			item.Price = &price
+		case "allergens":
+			item.Allergens = p.parseAllergens(lineNo, value)
+		default:
+			p.warnf(lineNo, "unknown annotation %q ignored", key)
+		}
+	}
+	return item
+}
+
+// "1,2,5" 또는 "1.2.5"
+func (p *parser) parseAllergens(lineNo int, value string) []string {
+	allergens := []string{}
+	seen := map[int]bool{}
+	for _, field := range strings.FieldsFunc(value, func(r rune) bool { return r == ',' || r == '.' || r == ' ' }) {
+		number, err := strconv.Atoi(field)
+		if err != nil || number < 1 || number > maxAllergenNumber {
+			p.errorf(lineNo, "invalid allergen %q (expected numbers 1-%d)", field, maxAllergenNumber)
+			continue
+		}
+		if !seen[number] {
+			seen[number] = true
+			allergens = append(allergens, strconv.Itoa(number))
+		}
+	}
+	return allergens
+}
+
+func (p *parser) finishMeal() {
+	if p.meal == nil {
+		return
+	}
+	if len(p.meal.Items) == 0 {
+		p.warnf(p.meal.Line, "%s on %s has no menu items", p.meal.MealType, p.day.Date.Format("2006-01-02"))
+	}
+	p.day.Meals = append(p.day.Meals, p.meal)
+	p.meal = nil
+}
+
+func (p *parser) finishDay() {
+	p.finishMeal()
+	if p.day == nil {
+		return
+	}
+	if len(p.day.Meals) == 0 {
+		p.warnf(p.day.Line, "%s has no meals", p.day.Date.Format("2006-01-02"))
+	}
+	p.menu.Days = append(p.menu.Days, p.day)
+	p.day = nil
+}
@@ -0,0 +1,162 @@
+package textformat
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/School-meal-lover/backend/internal/models"
+)
+
+var testMealTypes = models.MealTypeCodes{
+	models.Restaurant1: {"Breakfast", "Lunch_1", "Lunch_2", "Dinner"},
+	models.Restaurant2: {"Lunch", "Dinner"},
+}
+
+// 식당과 주차 시작 날짜(2025-05-26) 줄 뒤에 본문을 붙입니다 (본문은 3번째 줄부터)
+func testMenuText(body ...string) string {
+	return "RESTAURANT_1\n2025-05-26\n" + strings.Join(body, "\n") + "\n"
+}
+
+// "줄 심각도" 형식 (예: "4 error")
+func diagnosticLines(diagnostics []models.ParseDiagnostic) []string {
+	var lines []string
+	for _, diagnostic := range diagnostics {
+		lines = append(lines, fmt.Sprintf("%d %s", diagnostic.Line, diagnostic.Severity))
+	}
+	return lines
+}
+
+func TestParse(t *testing.T) {
+	text := "#format v2\n# 주석\nRESTAURANT_1\n2025-05-26\n\n" +
+		"Monday 2025-05-26\nlunch_2\n밥\n돈까스 | Pork Cutlet ; category=메인메뉴 ; price=5,000 ; allergens=1.2,2\n" +
+		"Dinner\n카레\n"
+	menu, diagnostics, err := Parse(text, testMealTypes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(diagnostics) != 0 {
+		t.Errorf("diagnostics = %+v, want none", diagnostics)
+	}
+	if menu.Version != Version2 || menu.Restaurant != models.Restaurant1 || menu.WeekStart.Format("2006-01-02") != "2025-05-26" {
+		t.Errorf("menu = v%d %s %s", menu.Version, menu.Restaurant, menu.WeekStart.Format("2006-01-02"))
+	}
+	if len(menu.Days) != 1 || len(menu.Days[0].Meals) != 2 {
+		t.Fatalf("got %+v, want one day with two meals", menu.Days)
+	}
+	lunch := menu.Days[0].Meals[0]
+	if lunch.MealType != "Lunch_2" || lunch.Line != 7 || len(lunch.Items) != 2 {
+		t.Fatalf("lunch = %+v", lunch)
+	}
+	item := lunch.Items[1]
+	if item.Line != 9 || item.Name != "돈까스" || item.NameEn != "Pork Cutlet" || item.Category != "메인메뉴" ||
+		item.Price == nil || *item.Price != 5000 || !slices.Equal(item.Allergens, []string{"1", "2"}) {
+		t.Errorf("item = %+v", item)
+	}
+}
+
+func TestParseDiagnostics(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		// 줄 순서대로 "줄 심각도"
+		want []string
+		// 첫 오류 메시지에 들어 있어야 하는 문구
+		message string
+	}{
+		{
+			name: "unknown meal type",
+			// 잘못된 식사 아래의 메뉴는 건너뛰고, 식사가 없는 요일은 경고합니다
+			text:    testMenuText("Monday 2025-05-26", "Lunch", "밥", "국"),
+			want:    []string{"3 warning", "4 error"},
+			message: "unknown meal type: Lunch",
+		},
+		{
+			name:    "date outside the declared week",
+			text:    testMenuText("Monday 2025-06-02", "Lunch_2", "밥"),
+			want:    []string{"3 error"},
+			message: "outside the declared week (2025-05-26 to 2025-06-01)",
+		},
+		{
+			name:    "weekday does not match the date",
+			text:    testMenuText("Tuesday 2025-05-26", "Lunch_2", "밥"),
+			want:    []string{"3 error"},
+			message: "2025-05-26 is a Monday, not Tuesday",
+		},
+		{
+			name:    "duplicate day",
+			text:    testMenuText("Monday 2025-05-26", "Lunch_2", "밥", "월요일 2025-05-26", "Dinner", "카레"),
+			want:    []string{"6 error"},
+			message: "duplicate day 2025-05-26 (first declared at line 3)",
+		},
+		{
+			name:    "item before any meal header",
+			text:    testMenuText("Monday 2025-05-26", "밥", "Lunch_2", "국"),
+			want:    []string{"4 error"},
+			message: `menu item "밥" before any meal header`,
+		},
+		{
+			name:    "item before any day header",
+			text:    testMenuText("밥", "Monday 2025-05-26", "Lunch_2", "국"),
+			want:    []string{"3 error"},
+			message: `menu item "밥" before any day header`,
+		},
+		{
+			name: "invalid date skips the day",
+			// 잘못된 날짜 아래의 식사와 메뉴는 다음 요일까지 오류 없이 건너뜁니다
+			text:    testMenuText("Monday 2025-02-30", "Lunch_2", "밥", "Dinner", "카레", "Tuesday 2025-05-27", "Lunch_2", "밥"),
+			want:    []string{"3 error"},
+			message: "invalid date: 2025-02-30",
+		},
+		{
+			name:    "invalid meal header skips its items",
+			text:    testMenuText("Monday 2025-05-26", "Lunch_2", "밥", "Lunch_2", "국", "Dinner", "카레"),
+			want:    []string{"6 error"},
+			message: "duplicate meal type Lunch_2 on 2025-05-26 (first declared at line 4)",
+		},
+		{
+			name:    "invalid v2 annotations",
+			text:    "#format v2\nRESTAURANT_1\n2025-05-26\nMonday 2025-05-26\nLunch_2\n밥 ; price=free ; spicy=yes\n",
+			want:    []string{"6 error", "6 warning"},
+			message: "invalid price: free",
+		},
+		{
+			name:    "missing week start date",
+			text:    "RESTAURANT_1\n",
+			want:    []string{"2 error"},
+			message: "missing week start date line",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, _, err := Parse(tt.text, testMealTypes)
+			var formatErr *Error
+			if !errors.As(err, &formatErr) {
+				t.Fatalf("err = %v, want *Error", err)
+			}
+			if got := diagnosticLines(formatErr.Diagnostics); !slices.Equal(got, tt.want) {
+				t.Errorf("diagnostics = %v, want %v (%+v)", got, tt.want, formatErr.Diagnostics)
+			}
+			if !strings.Contains(err.Error(), tt.message) {
+				t.Errorf("error = %q, want it to contain %q", err, tt.message)
+			}
+		})
+	}
+}
+
+func TestParseWarnings(t *testing.T) {
+	// 월요일이 아닌 시작 날짜와 메뉴가 없는 식사는 경고만 하고 식단을 반환합니다
+	text := "RESTAURANT_2\n2025-05-28\nWednesday 2025-05-28\nLunch\nDinner\n카레\n"
+	menu, diagnostics, err := Parse(text, testMealTypes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := diagnosticLines(diagnostics), []string{"2 warning", "4 warning"}; !slices.Equal(got, want) {
+		t.Errorf("diagnostics = %v, want %v (%+v)", got, want, diagnostics)
+	}
+	if len(menu.Days) != 1 || len(menu.Days[0].Meals) != 2 {
+		t.Errorf("days = %+v, want one day with two meals", menu.Days)
+	}
+}
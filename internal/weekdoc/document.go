@@ -0,0 +1,239 @@
+package weekdoc
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/School-meal-lover/backend/internal/models"
+	"github.com/School-meal-lover/backend/internal/textformat"
+	"gopkg.in/yaml.v3"
+)
+
+// 문서 인코딩
+type Format string
+
+const (
+	FormatJSON Format = "json"
+	FormatYAML Format = "yaml"
+)
+
+// 스키마를 통과한 문서 (schema.json과 같은 구조)
+type document struct {
+	Version    int    `json:"version"`
+	Restaurant string `json:"restaurant"`
+	StartDate  string `json:"start_date"`
+	Days       []struct {
+		Date      string            `json:"date"`
+		DayOfWeek string            `json:"day_of_week"`
+		Meals     map[string][]item `json:"meals"`
+	} `json:"days"`
+}
+
+type item struct {
+	Name      string   `json:"name"`
+	NameEn    string   `json:"name_en"`
+	Category  string   `json:"category"`
+	Price     *float64 `json:"price"`
+	Allergens []int    `json:"allergens"`
+}
+
+// JSON 또는 YAML 문서를 스키마로 검사하고 가져오기에 쓰는 공통 식단 구조로 변환합니다
+// 오류가 있으면 경로가 담긴 *textformat.Error를 반환합니다
+// mealTypes는 식당별로 허용하는 식사 종류 코드입니다
+func Parse(data []byte, format Format, mealTypes models.MealTypeCodes) (*models.WeekMenu, []models.ParseDiagnostic, error) {
+	value, err := decode(data, format)
+	if err != nil {
+		return nil, nil, &textformat.Error{Diagnostics: []models.ParseDiagnostic{{
+			Path: "/", Severity: models.DiagnosticError, Message: err.Error(),
+		}}}
+	}
+	root, err := loadSchema(mealTypes.All())
+	if err != nil {
+		return nil, nil, err
+	}
+	diagnostics, err := validate(root, value)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(diagnostics) > 0 {
+		return nil, nil, &textformat.Error{Diagnostics: diagnostics}
+	}
+
+	// 스키마를 통과했으므로 구조체로 옮깁니다
+	normalized, err := json.Marshal(value)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to normalize document: %w", err)
+	}
+	var doc document
+	if err := json.Unmarshal(normalized, &doc); err != nil {
+		return nil, nil, fmt.Errorf("failed to read document: %w", err)
+	}
+	return doc.toMenu(mealTypes)
+}
+
+// 스키마로 표현할 수 없는 검사 (주차 범위, 요일, 중복 날짜, 식당에 없는 식사 종류)
+func (doc *document) toMenu(mealTypes models.MealTypeCodes) (*models.WeekMenu, []models.ParseDiagnostic, error) {
+	var diagnostics, warnings []models.ParseDiagnostic
+	errorf := func(path, format string, args ...interface{}) {
+		diagnostics = append(diagnostics, models.ParseDiagnostic{
+			Path: path, Severity: models.DiagnosticError, Message: fmt.Sprintf(format, args...),
+		})
+	}
+	warnf := func(path, format string, args ...interface{}) {
+		warnings = append(warnings, models.ParseDiagnostic{
+			Path: path, Severity: models.DiagnosticWarning, Message: fmt.Sprintf(format, args...),
+		})
+	}
+
+	restaurant, _ := models.ParseRestaurantType(doc.Restaurant)
+	weekStart, _ := time.Parse("2006-01-02", doc.StartDate)
+	if weekStart.Weekday() != time.Monday {
+		warnf("/start_date", "week start date %s is a %s, not a Monday", doc.StartDate, weekStart.Weekday())
+	}
+	weekEnd := weekStart.AddDate(0, 0, 6)
+
+	menu := &models.WeekMenu{Restaurant: restaurant, WeekStart: weekStart}
+	seen := map[string]int{}
+	for i, day := range doc.Days {
+		path := fmt.Sprintf("/days/%d", i)
+		date, _ := time.Parse("2006-01-02", day.Date)
+		if date.Before(weekStart) || date.After(weekEnd) {
+			errorf(path+"/date", "%s is outside the declared week (%s to %s)", day.Date, doc.StartDate, weekEnd.Format("2006-01-02"))
+		}
+		if previous, ok := seen[day.Date]; ok {
+			errorf(path+"/date", "duplicate day %s (first declared at /days/%d)", day.Date, previous)
+		}
+		seen[day.Date] = i
+
+		dayOfWeek := date.Weekday().String()
+		if day.DayOfWeek != "" && day.DayOfWeek != dayOfWeek {
+			errorf(path+"/day_of_week", "%s is a %s, not %s", day.Date, dayOfWeek, day.DayOfWeek)
+		}
+
+		// 스키마는 모든 식당의 코드를 허용하므로 식당별로 다시 확인합니다
+		available := mealTypes[restaurant]
+		var codes []string
+		for code := range day.Meals {
+			codes = append(codes, code)
+		}
+		sort.Strings(codes)
+		for _, code := range codes {
+			if !slices.Contains(available, code) {
+				errorf(path+"/meals/"+code, "meal type %s is not available for %s", code, restaurant)
+			}
+		}
+
+		menuDay := &models.DayMenu{Date: date, DayOfWeek: dayOfWeek}
+		for _, mealType := range available {
+			items, ok := day.Meals[mealType]
+			if !ok {
+				continue
+			}
+			if len(items) == 0 {
+				warnf(path+"/meals/"+mealType, "%s on %s has no menu items", mealType, day.Date)
+			}
+			meal := &models.MealMenu{MealType: mealType}
+			for _, item := range items {
+				menuItem := models.MenuItem{
+					Name:     item.Name,
+					NameEn:   item.NameEn,
+					Category: item.Category,
+				}
+				if item.Price != nil {
+					menuItem.Price = *item.Price
+				}
+				if item.Allergens != nil {
+					menuItem.Allergens = []string{}
+					for _, allergen := range item.Allergens {
+						menuItem.Allergens = append(menuItem.Allergens, strconv.Itoa(allergen))
+					}
+				}
+				meal.Items = append(meal.Items, menuItem)
+			}
+			menuDay.Meals = append(menuDay.Meals, meal)
+		}
+		if len(menuDay.Meals) == 0 {
+			warnf(path+"/meals", "%s has no meals", day.Date)
+		}
+		menu.Days = append(menu.Days, menuDay)
+	}
+
+	if len(diagnostics) > 0 {
+		return nil, nil, &textformat.Error{Diagnostics: append(diagnostics, warnings...)}
+	}
+	return menu, warnings, nil
+}
+
+func decode(data []byte, format Format) (interface{}, error) {
+	if format == FormatYAML {
+		var node yaml.Node
+		if err := yaml.Unmarshal(data, &node); err != nil {
+			return nil, fmt.Errorf("invalid YAML: %v", err)
+		}
+		if len(node.Content) == 0 {
+			return nil, fmt.Errorf("empty document")
+		}
+		return yamlValue(node.Content[0])
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	var value interface{}
+	if err := decoder.Decode(&value); err != nil {
+		return nil, fmt.Errorf("invalid JSON: %v", err)
+	}
+	if decoder.More() {
+		return nil, fmt.Errorf("invalid JSON: unexpected data after the document")
+	}
+	return value, nil
+}
+
+// YAML 노드를 JSON 디코딩 결과와 같은 형태로 변환합니다
+// 따옴표 없는 날짜(2025-05-26)도 time.Time이 아닌 문자열로 남깁니다
+func yamlValue(node *yaml.Node) (interface{}, error) {
+	switch node.Kind {
+	case yaml.AliasNode:
+		return yamlValue(node.Alias)
+	case yaml.MappingNode:
+		value := make(map[string]interface{}, len(node.Content)/2)
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			child, err := yamlValue(node.Content[i+1])
+			if err != nil {
+				return nil, err
+			}
+			value[node.Content[i].Value] = child
+		}
+		return value, nil
+	case yaml.SequenceNode:
+		value := make([]interface{}, 0, len(node.Content))
+		for _, content := range node.Content {
+			child, err := yamlValue(content)
+			if err != nil {
+				return nil, err
+			}
+			value = append(value, child)
+		}
+		return value, nil
+	case yaml.ScalarNode:
+		switch node.ShortTag() {
+		case "!!null":
+			return nil, nil
+		case "!!bool":
+			var value bool
+			err := node.Decode(&value)
+			return value, err
+		case "!!int", "!!float":
+			var value float64
+			if err := node.Decode(&value); err != nil {
+				return nil, fmt.Errorf("line %d: invalid number %q", node.Line, node.Value)
+			}
+			return value, nil
+		}
+		return node.Value, nil
+	}
+	return nil, fmt.Errorf("line %d: unsupported YAML node", node.Line)
+}
@@ -0,0 +1,289 @@
+package weekdoc
+
+import (
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/School-meal-lover/backend/internal/models"
+)
+
+// 주간 식단 문서의 JSON Schema 틀 (식사 종류 코드는 Schema에서 채웁니다)
+//
+//go:embed schema.json
+var schemaTemplate []byte
+
+// 식사 종류 코드를 채운 JSON Schema (GET /api/v1/schemas/week-menu.json으로 공개)
+func Schema(mealTypes []string) ([]byte, error) {
+	var document map[string]interface{}
+	if err := json.Unmarshal(schemaTemplate, &document); err != nil {
+		return nil, fmt.Errorf("invalid week menu schema: %w", err)
+	}
+	properties := map[string]interface{}{}
+	for _, code := range mealTypes {
+		properties[code] = map[string]interface{}{"$ref": "#/$defs/items"}
+	}
+	day := document["$defs"].(map[string]interface{})["day"].(map[string]interface{})
+	meals := day["properties"].(map[string]interface{})["meals"].(map[string]interface{})
+	meals["properties"] = properties
+	return json.MarshalIndent(document, "", "  ")
+}
+
+// 이 패키지의 스키마가 쓰는 JSON Schema 키워드만 지원하는 검사기
+type schema struct {
+	Ref                  string             `json:"$ref"`
+	Defs                 map[string]*schema `json:"$defs"`
+	Type                 string             `json:"type"`
+	Required             []string           `json:"required"`
+	Properties           map[string]*schema `json:"properties"`
+	AdditionalProperties *bool              `json:"additionalProperties"`
+	Items                *schema            `json:"items"`
+	Enum                 []interface{}      `json:"enum"`
+	Pattern              string             `json:"pattern"`
+	Format               string             `json:"format"`
+	MinLength            *int               `json:"minLength"`
+	Minimum              *float64           `json:"minimum"`
+	Maximum              *float64           `json:"maximum"`
+	MinItems             *int               `json:"minItems"`
+	MaxItems             *int               `json:"maxItems"`
+	UniqueItems          bool               `json:"uniqueItems"`
+
+	pattern *regexp.Regexp
+}
+
+func loadSchema(mealTypes []string) (*schema, error) {
+	data, err := Schema(mealTypes)
+	if err != nil {
+		return nil, err
+	}
+	root := &schema{}
+	if err := json.Unmarshal(data, root); err != nil {
+		return nil, fmt.Errorf("invalid week menu schema: %w", err)
+	}
+	if err := root.compile(); err != nil {
+		return nil, err
+	}
+	for _, def := range root.Defs {
+		if err := def.compile(); err != nil {
+			return nil, err
+		}
+	}
+	return root, nil
+}
+
+func (s *schema) compile() error {
+	if s == nil {
+		return nil
+	}
+	if s.Pattern != "" {
+		pattern, err := regexp.Compile(s.Pattern)
+		if err != nil {
+			return fmt.Errorf("invalid week menu schema: %w", err)
+		}
+		s.pattern = pattern
+	}
+	for _, property := range s.Properties {
+		if err := property.compile(); err != nil {
+			return err
+		}
+	}
+	return s.Items.compile()
+}
+
+type validator struct {
+	root        *schema
+	diagnostics []models.ParseDiagnostic
+	// 스키마 자체의 오류 (문서의 오류가 아니므로 진단에 넣지 않습니다)
+	err error
+}
+
+func (v *validator) errorf(path, format string, args ...interface{}) {
+	if path == "" {
+		path = "/"
+	}
+	v.diagnostics = append(v.diagnostics, models.ParseDiagnostic{
+		Path: path, Severity: models.DiagnosticError, Message: fmt.Sprintf(format, args...),
+	})
+}
+
+// JSON으로 디코딩한 값(map, slice, float64, string, bool, nil)을 스키마로 검사합니다
+// 스키마의 참조를 찾을 수 없으면 오류를 반환합니다
+func validate(root *schema, value interface{}) ([]models.ParseDiagnostic, error) {
+	v := &validator{root: root}
+	v.validate(root, value, "")
+	if v.err != nil {
+		return nil, v.err
+	}
+	return v.diagnostics, nil
+}
+
+// $defs 참조를 따라갑니다 (순환 참조도 오류로 봅니다)
+func (v *validator) resolve(s *schema) (*schema, error) {
+	seen := map[string]bool{}
+	for s.Ref != "" {
+		if seen[s.Ref] {
+			return nil, fmt.Errorf("circular schema reference %s", s.Ref)
+		}
+		seen[s.Ref] = true
+		name, ok := strings.CutPrefix(s.Ref, "#/$defs/")
+		def, found := v.root.Defs[name]
+		if !ok || !found {
+			return nil, fmt.Errorf("unknown schema reference %s", s.Ref)
+		}
+		s = def
+	}
+	return s, nil
+}
+
+func (v *validator) validate(s *schema, value interface{}, path string) {
+	if v.err != nil {
+		return
+	}
+	s, err := v.resolve(s)
+	if err != nil {
+		v.err = err
+		return
+	}
+
+	if s.Type != "" && !matchesType(s.Type, value) {
+		v.errorf(path, "expected %s, got %s", s.Type, typeName(value))
+		return
+	}
+	if len(s.Enum) > 0 && !containsValue(s.Enum, value) {
+		v.errorf(path, "must be one of %s", formatEnum(s.Enum))
+		return
+	}
+
+	switch typed := value.(type) {
+	case map[string]interface{}:
+		for _, name := range s.Required {
+			if _, ok := typed[name]; !ok {
+				v.errorf(path, "missing required property %q", name)
+			}
+		}
+		keys := make([]string, 0, len(typed))
+		for key := range typed {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			childPath := path + "/" + escapePointer(key)
+			if property, ok := s.Properties[key]; ok {
+				v.validate(property, typed[key], childPath)
+			} else if s.AdditionalProperties != nil && !*s.AdditionalProperties {
+				v.errorf(childPath, "unknown property %q", key)
+			}
+		}
+
+	case []interface{}:
+		if s.MinItems != nil && len(typed) < *s.MinItems {
+			v.errorf(path, "must have at least %d items", *s.MinItems)
+		}
+		if s.MaxItems != nil && len(typed) > *s.MaxItems {
+			v.errorf(path, "must have at most %d items", *s.MaxItems)
+		}
+		if s.UniqueItems {
+			for i := range typed {
+				for j := 0; j < i; j++ {
+					if reflect.DeepEqual(typed[i], typed[j]) {
+						v.errorf(fmt.Sprintf("%s/%d", path, i), "duplicate of item %d", j)
+						break
+					}
+				}
+			}
+		}
+		if s.Items != nil {
+			for i, item := range typed {
+				v.validate(s.Items, item, fmt.Sprintf("%s/%d", path, i))
+			}
+		}
+
+	case string:
+		if s.MinLength != nil && len([]rune(typed)) < *s.MinLength {
+			v.errorf(path, "must be at least %d characters", *s.MinLength)
+		}
+		if s.pattern != nil && !s.pattern.MatchString(typed) {
+			v.errorf(path, "does not match pattern %s", s.Pattern)
+		} else if s.Format == "date" {
+			if _, err := time.Parse("2006-01-02", typed); err != nil {
+				v.errorf(path, "invalid date %q", typed)
+			}
+		}
+
+	case float64:
+		if s.Minimum != nil && typed < *s.Minimum {
+			v.errorf(path, "must be >= %v", *s.Minimum)
+		}
+		if s.Maximum != nil && typed > *s.Maximum {
+			v.errorf(path, "must be <= %v", *s.Maximum)
+		}
+	}
+}
+
+func matchesType(name string, value interface{}) bool {
+	switch typed := value.(type) {
+	case map[string]interface{}:
+		return name == "object"
+	case []interface{}:
+		return name == "array"
+	case string:
+		return name == "string"
+	case bool:
+		return name == "boolean"
+	case float64:
+		return name == "number" || (name == "integer" && typed == math.Trunc(typed))
+	case nil:
+		return name == "null"
+	}
+	return false
+}
+
+func typeName(value interface{}) string {
+	switch typed := value.(type) {
+	case map[string]interface{}:
+		return "object"
+	case []interface{}:
+		return "array"
+	case string:
+		return "string"
+	case bool:
+		return "boolean"
+	case float64:
+		if typed == math.Trunc(typed) {
+			return "integer"
+		}
+		return "number"
+	case nil:
+		return "null"
+	}
+	return fmt.Sprintf("%T", value)
+}
+
+func containsValue(values []interface{}, value interface{}) bool {
+	for _, candidate := range values {
+		if reflect.DeepEqual(candidate, value) {
+			return true
+		}
+	}
+	return false
+}
+
+func formatEnum(values []interface{}) string {
+	parts := make([]string, 0, len(values))
+	for _, value := range values {
+		encoded, _ := json.Marshal(value)
+		parts = append(parts, string(encoded))
+	}
+	return strings.Join(parts, ", ")
+}
+
+// JSON Pointer 경로 조각 이스케이프
+func escapePointer(key string) string {
+	return strings.NewReplacer("~", "~0", "/", "~1").Replace(key)
+}
@@ -0,0 +1,200 @@
+package weekdoc
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/School-meal-lover/backend/internal/models"
+	"github.com/School-meal-lover/backend/internal/textformat"
+)
+
+var testMealTypes = models.MealTypeCodes{
+	models.Restaurant1: {"Breakfast", "Lunch_1", "Lunch_2", "Dinner"},
+	models.Restaurant2: {"Lunch", "Dinner"},
+}
+
+const testDocument = `{
+  "version": 1,
+  "restaurant": "RESTAURANT_1",
+  "start_date": "2025-05-26",
+  "days": [
+    {
+      "date": "2025-05-26",
+      "day_of_week": "Monday",
+      "meals": {
+        "Lunch_2": [
+          {"name": "밥"},
+          {"name": "돈까스", "name_en": "Pork Cutlet", "category": "메인메뉴", "price": 5000, "allergens": [1, 2]}
+        ]
+      }
+    }
+  ]
+}`
+
+// testDocument의 path 위치에 value를 넣은 JSON 문서 (value가 nil이면 그 속성을 지웁니다)
+func modifiedDocument(t *testing.T, path string, value interface{}) []byte {
+	t.Helper()
+	var doc interface{}
+	if err := json.Unmarshal([]byte(testDocument), &doc); err != nil {
+		t.Fatal(err)
+	}
+	parts := strings.Split(strings.TrimPrefix(path, "/"), "/")
+	parent := doc
+	for _, part := range parts[:len(parts)-1] {
+		switch typed := parent.(type) {
+		case map[string]interface{}:
+			parent = typed[part]
+		case []interface{}:
+			index, _ := strconv.Atoi(part)
+			parent = typed[index]
+		}
+	}
+	last := parts[len(parts)-1]
+	switch typed := parent.(type) {
+	case map[string]interface{}:
+		if value == nil {
+			delete(typed, last)
+		} else {
+			typed[last] = value
+		}
+	case []interface{}:
+		index, _ := strconv.Atoi(last)
+		typed[index] = value
+	}
+	data, err := json.Marshal(doc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return data
+}
+
+func TestParseValidDocuments(t *testing.T) {
+	yamlDocument := `
+restaurant: RESTAURANT_2
+start_date: 2025-05-26
+days:
+  - date: 2025-05-28
+    meals:
+      Lunch:
+        - name: 카레
+          allergens: [5, 6]
+      Dinner: []
+`
+	tests := []struct {
+		name   string
+		data   string
+		format Format
+		// "날짜 식사 메뉴 수"
+		want     []string
+		warnings []string
+	}{
+		{"json", testDocument, FormatJSON, []string{"2025-05-26 Lunch_2 2"}, nil},
+		// 따옴표 없는 날짜와 식사 종류 설정 순서
+		{"yaml", yamlDocument, FormatYAML, []string{"2025-05-28 Lunch 1", "2025-05-28 Dinner 0"}, []string{"/days/0/meals/Dinner"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			menu, warnings, err := Parse([]byte(tt.data), tt.format, testMealTypes)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var got []string
+			for _, day := range menu.Days {
+				for _, meal := range day.Meals {
+					got = append(got, fmt.Sprintf("%s %s %d", day.Date.Format("2006-01-02"), meal.MealType, len(meal.Items)))
+				}
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("meals = %v, want %v", got, tt.want)
+			}
+			if paths := diagnosticPaths(warnings); !slices.Equal(paths, tt.warnings) {
+				t.Errorf("warnings = %v, want %v", paths, tt.warnings)
+			}
+		})
+	}
+
+	menu, _, _ := Parse([]byte(testDocument), FormatJSON, testMealTypes)
+	item := menu.Days[0].Meals[0].Items[1]
+	if item.NameEn != "Pork Cutlet" || item.Category != "메인메뉴" || item.Price != 5000 || !slices.Equal(item.Allergens, []string{"1", "2"}) {
+		t.Errorf("item = %+v", item)
+	}
+	if menu.Days[0].Meals[0].Items[0].Allergens != nil {
+		t.Errorf("missing allergens = %v, want nil", menu.Days[0].Meals[0].Items[0].Allergens)
+	}
+}
+
+func diagnosticPaths(diagnostics []models.ParseDiagnostic) []string {
+	var paths []string
+	for _, diagnostic := range diagnostics {
+		paths = append(paths, diagnostic.Path)
+	}
+	return paths
+}
+
+func TestParseInvalidDocuments(t *testing.T) {
+	meal := "/days/0/meals/Lunch_2"
+	tests := []struct {
+		name string
+		data []byte
+		// 오류가 나야 하는 JSON Pointer 경로 (순서대로)
+		want    []string
+		message string
+	}{
+		{"invalid JSON", []byte(`{"restaurant": `), []string{"/"}, "invalid JSON"},
+		{"trailing data", []byte(testDocument + `{}`), []string{"/"}, "unexpected data"},
+		{"missing property", modifiedDocument(t, "/restaurant", nil), []string{"/"}, `missing required property "restaurant"`},
+		{"unknown property", modifiedDocument(t, "/menu", "x"), []string{"/menu"}, `unknown property "menu"`},
+		{"wrong type", modifiedDocument(t, "/days", map[string]interface{}{}), []string{"/days"}, "expected array, got object"},
+		{"restaurant enum", modifiedDocument(t, "/restaurant", "RESTAURANT_3"), []string{"/restaurant"}, `must be one of "RESTAURANT_1", "RESTAURANT_2"`},
+		{"version", modifiedDocument(t, "/version", 1.5), []string{"/version"}, "expected integer, got number"},
+		{"date pattern", modifiedDocument(t, "/start_date", "2025-5-26"), []string{"/start_date"}, "does not match pattern"},
+		{"date format", modifiedDocument(t, "/days/0/date", "2025-02-30"), []string{"/days/0/date"}, `invalid date "2025-02-30"`},
+		{"no days", modifiedDocument(t, "/days", []interface{}{}), []string{"/days"}, "at least 1 items"},
+		{"unknown meal type", modifiedDocument(t, "/days/0/meals/Brunch", []interface{}{}), []string{"/days/0/meals/Brunch"}, `unknown property "Brunch"`},
+		{"escaped key", modifiedDocument(t, "/days/0/meals/a~b", []interface{}{}), []string{"/days/0/meals/a~0b"}, "unknown property"},
+		{"missing name", modifiedDocument(t, meal+"/0", map[string]interface{}{"name_en": "Rice"}), []string{meal + "/0"}, `missing required property "name"`},
+		{"empty name", modifiedDocument(t, meal+"/0/name", ""), []string{meal + "/0/name"}, "at least 1 characters"},
+		{"negative price", modifiedDocument(t, meal+"/1/price", -1), []string{meal + "/1/price"}, "must be >= 0"},
+		{"allergens", modifiedDocument(t, meal+"/1/allergens", []interface{}{20, 2, 2}), []string{meal + "/1/allergens/2", meal + "/1/allergens/0"}, ""},
+		// 스키마 다음의 검사
+		{"outside the week", modifiedDocument(t, "/days/0/date", "2025-06-02"), []string{"/days/0/date"}, "outside the declared week"},
+		{"weekday", modifiedDocument(t, "/days/0/day_of_week", "Tuesday"), []string{"/days/0/day_of_week"}, "2025-05-26 is a Monday, not Tuesday"},
+		{"other restaurant", modifiedDocument(t, "/days/0/meals/Lunch", []interface{}{}), []string{"/days/0/meals/Lunch"}, "not available for RESTAURANT_1"},
+		{"duplicate day", modifiedDocument(t, "/days", []interface{}{
+			map[string]interface{}{"date": "2025-05-26", "meals": map[string]interface{}{}},
+			map[string]interface{}{"date": "2025-05-26", "meals": map[string]interface{}{}},
+		}), []string{"/days/1/date", "/days/0/meals", "/days/1/meals"}, "duplicate day 2025-05-26 (first declared at /days/0)"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, _, err := Parse(tt.data, FormatJSON, testMealTypes)
+			var formatErr *textformat.Error
+			if !errors.As(err, &formatErr) {
+				t.Fatalf("err = %v, want *textformat.Error", err)
+			}
+			if paths := diagnosticPaths(formatErr.Diagnostics); !slices.Equal(paths, tt.want) {
+				t.Errorf("paths = %v, want %v (%+v)", paths, tt.want, formatErr.Diagnostics)
+			}
+			if !strings.Contains(err.Error(), tt.message) {
+				t.Errorf("error = %q, want it to contain %q", err, tt.message)
+			}
+		})
+	}
+}
+
+func TestValidateUnknownReference(t *testing.T) {
+	root := &schema{Properties: map[string]*schema{"a": {Ref: "#/$defs/missing"}}}
+	if _, err := validate(root, map[string]interface{}{"a": 1.0}); err == nil || !strings.Contains(err.Error(), "#/$defs/missing") {
+		t.Errorf("err = %v, want an unknown reference error", err)
+	}
+
+	loop := &schema{Defs: map[string]*schema{"a": {Ref: "#/$defs/b"}, "b": {Ref: "#/$defs/a"}}, Ref: "#/$defs/a"}
+	if _, err := validate(loop, "x"); err == nil {
+		t.Error("circular reference succeeded, want error")
+	}
+}
@@ -0,0 +1,5 @@
+DROP TABLE "notification_deliveries";
+
+DROP TABLE "favorite_dishes";
+
+DROP TABLE "notification_subscribers";
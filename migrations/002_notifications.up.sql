@@ -0,0 +1,35 @@
+CREATE TABLE "notification_subscribers" (
+  "id" uuid UNIQUE PRIMARY KEY DEFAULT (gen_random_uuid()),
+  "device_token" varchar NOT NULL UNIQUE,
+  "platform" varchar NOT NULL,
+  "restaurant" restaurant_type,
+  "created_at" timestamp DEFAULT (now()),
+  "updated_at" timestamp DEFAULT (now())
+);
+
+CREATE TABLE "favorite_dishes" (
+  "subscriber_id" uuid NOT NULL REFERENCES "notification_subscribers"("id") ON DELETE CASCADE,
+  "dish_name" varchar NOT NULL,
+  PRIMARY KEY ("subscriber_id", "dish_name")
+);
+
+CREATE TABLE "notification_deliveries" (
+  "id" uuid UNIQUE PRIMARY KEY DEFAULT (gen_random_uuid()),
+  "subscriber_id" uuid REFERENCES "notification_subscribers"("id") ON DELETE CASCADE,
+  "kind" varchar NOT NULL,
+  "title" varchar NOT NULL,
+  "body" text NOT NULL,
+  "status" varchar NOT NULL DEFAULT 'pending',
+  "attempts" int NOT NULL DEFAULT 0,
+  "last_error" text,
+  "created_at" timestamp DEFAULT (now()),
+  "updated_at" timestamp DEFAULT (now()),
+  "delivered_at" timestamp
+);
+
+COMMENT ON COLUMN "notification_subscribers"."platform" IS 'fcm, apns';
+COMMENT ON COLUMN "notification_subscribers"."restaurant" IS '식단 변경 알림을 받을 식당 (NULL이면 즐겨찾기 알림만)';
+COMMENT ON COLUMN "notification_deliveries"."kind" IS 'favorite_dish, menu_changed';
+COMMENT ON COLUMN "notification_deliveries"."status" IS 'pending, delivered, failed';
+
+CREATE INDEX "idx_notification_deliveries_status" ON "notification_deliveries" ("status");
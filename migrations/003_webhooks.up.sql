@@ -0,0 +1,28 @@
+CREATE TABLE "webhook_subscriptions" (
+  "id" uuid UNIQUE PRIMARY KEY DEFAULT (gen_random_uuid()),
+  "url" varchar NOT NULL,
+  "secret" varchar NOT NULL,
+  "event_types" varchar[] NOT NULL,
+  "active" boolean NOT NULL DEFAULT true,
+  "created_at" timestamp DEFAULT (now()),
+  "updated_at" timestamp DEFAULT (now())
+);
+
+CREATE TABLE "webhook_deliveries" (
+  "id" uuid UNIQUE PRIMARY KEY DEFAULT (gen_random_uuid()),
+  "subscription_id" uuid NOT NULL REFERENCES "webhook_subscriptions"("id") ON DELETE CASCADE,
+  "event_type" varchar NOT NULL,
+  "payload" jsonb NOT NULL,
+  "status" varchar NOT NULL DEFAULT 'pending',
+  "attempts" int NOT NULL DEFAULT 0,
+  "response_status" int,
+  "last_error" text,
+  "created_at" timestamp DEFAULT (now()),
+  "updated_at" timestamp DEFAULT (now()),
+  "delivered_at" timestamp
+);
+
+COMMENT ON COLUMN "webhook_subscriptions"."event_types" IS 'week.published, week.updated, image.updated';
+COMMENT ON COLUMN "webhook_deliveries"."status" IS 'pending, delivered, failed';
+
+CREATE INDEX "idx_webhook_deliveries_subscription" ON "webhook_deliveries" ("subscription_id", "created_at");
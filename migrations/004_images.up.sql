@@ -0,0 +1,13 @@
+CREATE TABLE "images" (
+  "id" uuid UNIQUE PRIMARY KEY DEFAULT (gen_random_uuid()),
+  "restaurant" varchar NOT NULL,
+  "week_id" uuid REFERENCES "weeks"("id") ON DELETE SET NULL,
+  "image_name" varchar NOT NULL,
+  "valid_from" date NOT NULL,
+  "created_at" timestamp DEFAULT (now())
+);
+
+COMMENT ON COLUMN "images"."restaurant" IS 'RESTAURANT_1, RESTAURANT_2, RESTAURANT_3';
+COMMENT ON COLUMN "images"."valid_from" IS '연결된 주차의 시작일 (주차가 없으면 업로드 기준 날짜)';
+
+CREATE INDEX "idx_images_restaurant_valid_from" ON "images" ("restaurant", "valid_from", "created_at");
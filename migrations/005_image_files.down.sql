@@ -0,0 +1,5 @@
+ALTER TABLE "images"
+  DROP COLUMN "size_bytes",
+  DROP COLUMN "content_type",
+  DROP COLUMN "thumbnail_key",
+  DROP COLUMN "blob_key";
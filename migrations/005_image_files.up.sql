@@ -0,0 +1,7 @@
+ALTER TABLE "images"
+  ADD COLUMN "blob_key" varchar,
+  ADD COLUMN "thumbnail_key" varchar,
+  ADD COLUMN "content_type" varchar,
+  ADD COLUMN "size_bytes" bigint;
+
+COMMENT ON COLUMN "images"."blob_key" IS '업로드한 원본 파일의 저장소 키 (이름만 등록한 경우 NULL)';
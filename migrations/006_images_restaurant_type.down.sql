@@ -0,0 +1,12 @@
+ALTER TABLE "images"
+  ALTER COLUMN "restaurant" TYPE varchar USING "restaurant"::varchar;
+
+INSERT INTO "images" ("id", "restaurant", "week_id", "image_name", "valid_from", "created_at",
+  "blob_key", "thumbnail_key", "content_type", "size_bytes")
+SELECT u."id", u."restaurant", w."id", u."image_name", u."valid_from", u."created_at",
+  u."blob_key", u."thumbnail_key", u."content_type", u."size_bytes"
+FROM "images_unassigned" u
+LEFT JOIN "weeks" w ON w."id" = u."week_id";
+DROP TABLE "images_unassigned";
+
+COMMENT ON COLUMN "images"."restaurant" IS 'RESTAURANT_1, RESTAURANT_2, RESTAURANT_3';
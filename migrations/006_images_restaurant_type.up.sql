@@ -0,0 +1,23 @@
+-- 이전 API가 받던 RESTAURANT_3 등 실제 식당이 아닌 기록은 지우지 않고 "images_unassigned"로 옮깁니다
+-- 식당이 정해지면 restaurant를 고쳐서 "images"로 되돌려 넣을 수 있습니다
+CREATE TABLE "images_unassigned" AS
+  SELECT * FROM "images" WHERE "restaurant" NOT IN ('RESTAURANT_1', 'RESTAURANT_2');
+
+COMMENT ON TABLE "images_unassigned" IS '식당 종류로 바꿀 수 없어 006 마이그레이션에서 옮긴 이미지 기록';
+
+DO $$
+DECLARE
+  moved integer;
+BEGIN
+  SELECT count(*) INTO moved FROM "images_unassigned";
+  IF moved > 0 THEN
+    RAISE WARNING '% image rows with an unknown restaurant were moved to images_unassigned', moved;
+  END IF;
+END $$;
+
+DELETE FROM "images" WHERE "restaurant" NOT IN ('RESTAURANT_1', 'RESTAURANT_2');
+
+ALTER TABLE "images"
+  ALTER COLUMN "restaurant" TYPE restaurant_type USING "restaurant"::restaurant_type;
+
+COMMENT ON COLUMN "images"."restaurant" IS NULL;
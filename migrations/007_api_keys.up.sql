@@ -0,0 +1,15 @@
+CREATE TABLE "api_keys" (
+  "id" uuid UNIQUE PRIMARY KEY DEFAULT (gen_random_uuid()),
+  "name" varchar NOT NULL,
+  "prefix" varchar UNIQUE NOT NULL,
+  "key_hash" varchar NOT NULL,
+  "scopes" varchar[] NOT NULL,
+  "created_at" timestamp DEFAULT (now()),
+  "last_used_at" timestamp,
+  "expires_at" timestamp,
+  "revoked_at" timestamp
+);
+
+COMMENT ON COLUMN "api_keys"."prefix" IS '키 앞부분 (조회와 표시용)';
+COMMENT ON COLUMN "api_keys"."key_hash" IS 'SHA-256 hex, 원본 키는 저장하지 않음';
+COMMENT ON COLUMN "api_keys"."scopes" IS 'admin, staff@RESTAURANT_1, read_only';
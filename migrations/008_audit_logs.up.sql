@@ -0,0 +1,21 @@
+CREATE TABLE "audit_logs" (
+  "id" uuid UNIQUE PRIMARY KEY DEFAULT (gen_random_uuid()),
+  "actor" varchar NOT NULL,
+  "actor_role" varchar NOT NULL,
+  "action" varchar NOT NULL,
+  "method" varchar NOT NULL,
+  "endpoint" varchar NOT NULL,
+  "restaurant" restaurant_type,
+  "week_id" uuid,
+  "summary" jsonb NOT NULL DEFAULT '{}',
+  "files" jsonb NOT NULL DEFAULT '[]',
+  "created_at" timestamp DEFAULT (now())
+);
+
+COMMENT ON COLUMN "audit_logs"."action" IS 'upload.excel, upload.text, image.upload, image.upload_file, api_key.issue, api_key.revoke, webhook.create, webhook.delete';
+COMMENT ON COLUMN "audit_logs"."week_id" IS '주차가 삭제되어도 기록은 남도록 외래 키를 두지 않음';
+COMMENT ON COLUMN "audit_logs"."files" IS '[{name, sha256, size}]';
+
+CREATE INDEX "idx_audit_logs_created_at" ON "audit_logs" ("created_at");
+CREATE INDEX "idx_audit_logs_actor" ON "audit_logs" ("actor", "created_at");
+CREATE INDEX "idx_audit_logs_week" ON "audit_logs" ("week_id");
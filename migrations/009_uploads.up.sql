@@ -0,0 +1,25 @@
+CREATE TABLE "uploads" (
+  "id" uuid UNIQUE PRIMARY KEY DEFAULT (gen_random_uuid()),
+  "kind" varchar NOT NULL,
+  "restaurant" restaurant_type NOT NULL,
+  "week_id" uuid REFERENCES "weeks"("id") ON DELETE SET NULL,
+  "uploaded_by" varchar NOT NULL,
+  "created_at" timestamp DEFAULT (now())
+);
+
+CREATE TABLE "upload_files" (
+  "id" uuid UNIQUE PRIMARY KEY DEFAULT (gen_random_uuid()),
+  "upload_id" uuid NOT NULL REFERENCES "uploads"("id") ON DELETE CASCADE,
+  "role" varchar NOT NULL,
+  "file_name" varchar NOT NULL,
+  "sha256" varchar NOT NULL,
+  "size_bytes" bigint NOT NULL,
+  "blob_key" varchar NOT NULL
+);
+
+COMMENT ON COLUMN "uploads"."kind" IS 'excel, text, json';
+COMMENT ON COLUMN "upload_files"."role" IS 'ko, en, text';
+COMMENT ON COLUMN "upload_files"."blob_key" IS '내용 해시로 정한 저장소 키 (같은 파일은 한 번만 저장)';
+
+CREATE INDEX "idx_uploads_week" ON "uploads" ("week_id", "created_at");
+CREATE INDEX "idx_upload_files_upload" ON "upload_files" ("upload_id");
@@ -0,0 +1,3 @@
+ALTER TABLE "menu_items" ADD COLUMN "allergens" varchar[];
+
+COMMENT ON COLUMN "menu_items"."allergens" IS '알레르기 유발 식품 번호 (1 난류 ~ 19 잣)';
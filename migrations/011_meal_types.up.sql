@@ -0,0 +1,27 @@
+CREATE TABLE "meal_types" (
+  "restaurant" restaurant_type NOT NULL,
+  "code" varchar NOT NULL,
+  "label_ko" varchar NOT NULL,
+  "label_en" varchar NOT NULL,
+  "display_order" int NOT NULL,
+  "serving_start" time NOT NULL,
+  "serving_end" time NOT NULL,
+  "excel_start_row" int,
+  "excel_end_row" int,
+  "created_at" timestamp DEFAULT (now()),
+  "updated_at" timestamp DEFAULT (now()),
+  PRIMARY KEY ("restaurant", "code")
+);
+
+COMMENT ON COLUMN "meal_types"."code" IS 'meals.meal_type에 저장되는 코드 (Breakfast, Lunch_1, ...)';
+COMMENT ON COLUMN "meal_types"."excel_start_row" IS '엑셀 식단표에서 메뉴를 읽을 행 범위 (없으면 엑셀에서 읽지 않음)';
+
+INSERT INTO "meal_types" ("restaurant", "code", "label_ko", "label_en", "display_order", "serving_start", "serving_end", "excel_start_row", "excel_end_row")
+SELECT r.restaurant, t.code, t.label_ko, t.label_en, t.display_order, t.serving_start, t.serving_end, t.excel_start_row, t.excel_end_row
+FROM (VALUES ('RESTAURANT_1'::restaurant_type), ('RESTAURANT_2'::restaurant_type)) AS r(restaurant)
+CROSS JOIN (VALUES
+  ('Breakfast', '아침', 'Breakfast', 1, '07:30'::time, '09:00'::time, 7, 16),
+  ('Lunch_1', '점심 (일품)', 'Lunch (Special)', 2, '11:30'::time, '13:30'::time, 18, 18),
+  ('Lunch_2', '점심', 'Lunch', 3, '11:30'::time, '13:30'::time, 21, 26),
+  ('Dinner', '저녁', 'Dinner', 4, '17:30'::time, '19:00'::time, 27, 32)
+) AS t(code, label_ko, label_en, display_order, serving_start, serving_end, excel_start_row, excel_end_row);
@@ -0,0 +1,17 @@
+CREATE TABLE "closures" (
+  "id" uuid PRIMARY KEY DEFAULT (gen_random_uuid()),
+  "restaurant" restaurant_type NOT NULL,
+  "start_date" date NOT NULL,
+  "end_date" date NOT NULL,
+  "meal_type" varchar,
+  "reason_ko" varchar NOT NULL DEFAULT '',
+  "reason_en" varchar NOT NULL DEFAULT '',
+  "created_by" varchar,
+  "created_at" timestamp DEFAULT (now()),
+  CHECK ("end_date" >= "start_date")
+);
+
+COMMENT ON COLUMN "closures"."meal_type" IS 'meal_types.code (비어 있으면 하루 전체 휴무)';
+COMMENT ON COLUMN "closures"."reason_ko" IS '휴무 사유 (공휴일, 시험 기간, 방학 등)';
+
+CREATE INDEX "closures_restaurant_dates_idx" ON "closures" ("restaurant", "start_date", "end_date");
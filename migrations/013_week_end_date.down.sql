@@ -0,0 +1,4 @@
+-- up에서 하나로 합친 주차는 다시 나누지 않습니다
+DROP INDEX "meals_date_idx";
+DROP INDEX "weeks_restaurant_dates_idx";
+ALTER TABLE "weeks" DROP COLUMN "end_date";
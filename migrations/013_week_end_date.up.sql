@@ -0,0 +1,65 @@
+ALTER TABLE "weeks" ADD COLUMN "end_date" date;
+
+-- 기존 주차는 시작일이 속한 주의 마지막 운영일(RESTAURANT_1 금요일, RESTAURANT_2 일요일)까지로 하되,
+-- 그 뒤 날짜의 식단이 있으면 마지막 식단 날짜까지로 합니다
+UPDATE "weeks" w SET "end_date" = GREATEST(
+  w."start_date" + GREATEST(0, (CASE w."restaurant" WHEN 'RESTAURANT_1' THEN 5 ELSE 7 END) - EXTRACT(ISODOW FROM w."start_date")::int),
+  COALESCE((SELECT MAX(m."date") FROM "meals" m WHERE m."weeks_id" = w."id"), w."start_date")
+);
+
+-- 기간이 겹치는 기존 주차(같은 시작일로 두 번 만들어진 주차 등)는 하나로 합칩니다
+-- 식당별로 시작일 순으로 이어서 겹치는 주차를 한 묶음으로 보고, 시작일이 가장 늦은 주차(이전 조회가 보여 주던 주차)를 남깁니다
+CREATE TEMP TABLE "week_merges" AS
+WITH ordered AS (
+  SELECT "id", "restaurant", "start_date", "end_date", "created_at",
+    MAX("end_date") OVER (PARTITION BY "restaurant" ORDER BY "start_date", "created_at", "id"
+      ROWS BETWEEN UNBOUNDED PRECEDING AND 1 PRECEDING) AS "prev_end"
+  FROM "weeks"
+), grouped AS (
+  SELECT *, COUNT(*) FILTER (WHERE "prev_end" IS NULL OR "start_date" > "prev_end")
+    OVER (PARTITION BY "restaurant" ORDER BY "start_date", "created_at", "id") AS "grp"
+  FROM ordered
+)
+SELECT "id" AS "week_id",
+  FIRST_VALUE("id") OVER (PARTITION BY "restaurant", "grp" ORDER BY "start_date" DESC, "created_at" DESC NULLS LAST, "id" DESC) AS "keep_id",
+  MIN("start_date") OVER (PARTITION BY "restaurant", "grp") AS "start_date",
+  MAX("end_date") OVER (PARTITION BY "restaurant", "grp") AS "end_date"
+FROM grouped;
+
+-- 같은 날짜, 같은 식사가 여러 주차에 있으면 남길 주차의 식사를, 없으면 시작일이 늦은 주차의 식사를 남깁니다
+CREATE TEMP TABLE "meal_merges" AS
+SELECT m."id", m."weeks_id" <> wm."keep_id" AS "moved",
+  ROW_NUMBER() OVER (PARTITION BY wm."keep_id", m."date", m."meal_type"
+    ORDER BY m."weeks_id" = wm."keep_id" DESC, w."start_date" DESC, w."created_at" DESC NULLS LAST, m."updated_at" DESC NULLS LAST) AS "rank"
+FROM "meals" m
+JOIN "week_merges" wm ON wm."week_id" = m."weeks_id"
+JOIN "weeks" w ON w."id" = m."weeks_id";
+
+DELETE FROM "menu_items" WHERE "meals_id" IN (SELECT "id" FROM "meal_merges" WHERE "moved" AND "rank" > 1);
+DELETE FROM "meals" WHERE "id" IN (SELECT "id" FROM "meal_merges" WHERE "moved" AND "rank" > 1);
+
+UPDATE "meals" m SET "weeks_id" = wm."keep_id"
+FROM "week_merges" wm WHERE m."weeks_id" = wm."week_id" AND wm."week_id" <> wm."keep_id";
+UPDATE "images" i SET "week_id" = wm."keep_id"
+FROM "week_merges" wm WHERE i."week_id" = wm."week_id" AND wm."week_id" <> wm."keep_id";
+UPDATE "uploads" u SET "week_id" = wm."keep_id"
+FROM "week_merges" wm WHERE u."week_id" = wm."week_id" AND wm."week_id" <> wm."keep_id";
+UPDATE "images_unassigned" i SET "week_id" = wm."keep_id"
+FROM "week_merges" wm WHERE i."week_id" = wm."week_id" AND wm."week_id" <> wm."keep_id";
+
+UPDATE "weeks" w SET "start_date" = wm."start_date", "end_date" = wm."end_date", "updated_at" = now()
+FROM "week_merges" wm
+WHERE w."id" = wm."week_id" AND wm."week_id" = wm."keep_id"
+  AND (w."start_date" <> wm."start_date" OR w."end_date" <> wm."end_date");
+DELETE FROM "weeks" w USING "week_merges" wm WHERE w."id" = wm."week_id" AND wm."week_id" <> wm."keep_id";
+
+DROP TABLE "meal_merges";
+DROP TABLE "week_merges";
+
+ALTER TABLE "weeks" ALTER COLUMN "end_date" SET NOT NULL;
+ALTER TABLE "weeks" ADD CONSTRAINT "weeks_end_date_check" CHECK ("end_date" >= "start_date");
+
+COMMENT ON COLUMN "weeks"."end_date" IS '주차의 마지막 날짜 (월요일이 아닌 날 시작하는 주, 일부만 있는 주 포함)';
+
+CREATE INDEX "weeks_restaurant_dates_idx" ON "weeks" ("restaurant", "start_date", "end_date");
+CREATE INDEX "meals_date_idx" ON "meals" ("date");
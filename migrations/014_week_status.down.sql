@@ -0,0 +1,10 @@
+DROP INDEX "weeks_restaurant_status_idx";
+DELETE FROM "menu_items" WHERE "meals_id" IN (
+  SELECT m."id" FROM "meals" m JOIN "weeks" w ON w."id" = m."weeks_id" WHERE w."status" <> 'published'
+);
+DELETE FROM "meals" WHERE "weeks_id" IN (SELECT "id" FROM "weeks" WHERE "status" <> 'published');
+DELETE FROM "weeks" WHERE "status" <> 'published';
+ALTER TABLE "weeks" DROP COLUMN "status_changed_at";
+ALTER TABLE "weeks" DROP COLUMN "status_changed_by";
+ALTER TABLE "weeks" DROP COLUMN "published_at";
+ALTER TABLE "weeks" DROP COLUMN "status";
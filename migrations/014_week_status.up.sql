@@ -0,0 +1,13 @@
+-- 기존 주차는 이미 공개된 식단이므로 published로 두고, 이후 업로드는 draft로 만듭니다
+ALTER TABLE "weeks" ADD COLUMN "status" varchar NOT NULL DEFAULT 'published';
+ALTER TABLE "weeks" ALTER COLUMN "status" SET DEFAULT 'draft';
+ALTER TABLE "weeks" ADD CONSTRAINT "weeks_status_check" CHECK ("status" IN ('draft', 'in_review', 'published', 'retracted'));
+ALTER TABLE "weeks" ADD COLUMN "published_at" timestamp;
+ALTER TABLE "weeks" ADD COLUMN "status_changed_by" varchar;
+ALTER TABLE "weeks" ADD COLUMN "status_changed_at" timestamp;
+
+UPDATE "weeks" SET "published_at" = "created_at";
+
+COMMENT ON COLUMN "weeks"."status" IS 'draft, in_review, published, retracted (조회 API는 published만 제공)';
+
+CREATE INDEX "weeks_restaurant_status_idx" ON "weeks" ("restaurant", "status", "start_date");